@@ -173,6 +173,22 @@ const (
 	LogSinkRateLimitBurst      = "LOGSINK_RATELIMIT_BURST"
 	LogSinkRateLimitRefill     = "LOGSINK_RATELIMIT_REFILL"
 
+	// CharmStoreQuota is the maximum number of bytes a model object store
+	// may hold for charms to still be stored. Unset or zero means unlimited.
+	CharmStoreQuota = "CHARM_STORE_QUOTA"
+
+	// CharmTempFilePoolSize is the number of unused temporary files kept to
+	// back charm readers. Unset or zero disables the pool.
+	CharmTempFilePoolSize = "CHARM_TEMP_FILE_POOL_SIZE"
+
+	// CharmDigestAlgorithms is a comma separated list of the hash algorithms
+	// used to compute the digest of charms, for example "sha256,sha512".
+	CharmDigestAlgorithms = "CHARM_DIGEST_ALGORITHMS"
+
+	// MaxAPIConnections is the maximum number of concurrent connections the
+	// controller's API server accepts. Unset or zero means unlimited.
+	MaxAPIConnections = "MAX_API_CONNECTIONS"
+
 	// These values are used to override various aspects of worker behaviour.
 	// They are used for debugging or testing purposes.
 
@@ -66,3 +66,21 @@ func (c *Client) Machine(ctx context.Context, tag names.MachineTag) (MutaterMach
 	}
 	return &Machine{c.facade, tag, life}, nil
 }
+
+// MachinesLife returns the life of each of the given machines, in the same
+// order, using a single API call. Errors for individual machines are
+// reported in the corresponding result.
+func (c *Client) MachinesLife(ctx context.Context, tags []names.MachineTag) ([]params.LifeResult, error) {
+	entities := make([]names.Tag, len(tags))
+	for i, tag := range tags {
+		entities[i] = tag
+	}
+	results, err := common.Life(ctx, c.facade, entities)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results) != len(tags) {
+		return nil, errors.Errorf("expected %d results, got %d", len(tags), len(results))
+	}
+	return results, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/api/agent/instancemutater"
 	"github.com/juju/juju/api/agent/instancemutater/mocks"
 	apitesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/core/life"
 	jujutesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -55,6 +56,49 @@ func (s *instanceMutaterSuite) TestMachineCallsLife(c *gc.C) {
 	c.Assert(m.Tag().String(), gc.Equals, s.tag.String())
 }
 
+func (s *instanceMutaterSuite) TestMachinesLife(c *gc.C) {
+	expectedResults := params.LifeResults{
+		Results: []params.LifeResult{
+			{Life: life.Dead},
+			{Error: &params.Error{Code: params.CodeNotFound, Message: "machine 1 not found"}},
+		},
+	}
+	entitiesArgs := params.Entities{
+		Entities: []params.Entity{
+			{Tag: "machine-0"},
+			{Tag: "machine-1"},
+		},
+	}
+	apiCaller := successAPICaller(c, "Life", entitiesArgs, expectedResults)
+	api := instancemutater.NewClient(apiCaller)
+	results, err := api.MachinesLife(context.Background(), []names.MachineTag{
+		names.NewMachineTag("0"),
+		names.NewMachineTag("1"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(apiCaller.CallCount, gc.Equals, 1)
+	c.Assert(results, jc.DeepEquals, expectedResults.Results)
+}
+
+func (s *instanceMutaterSuite) TestMachinesLifeWrongResultCount(c *gc.C) {
+	expectedResults := params.LifeResults{
+		Results: []params.LifeResult{{Life: life.Dead}},
+	}
+	entitiesArgs := params.Entities{
+		Entities: []params.Entity{
+			{Tag: "machine-0"},
+			{Tag: "machine-1"},
+		},
+	}
+	apiCaller := successAPICaller(c, "Life", entitiesArgs, expectedResults)
+	api := instancemutater.NewClient(apiCaller)
+	_, err := api.MachinesLife(context.Background(), []names.MachineTag{
+		names.NewMachineTag("0"),
+		names.NewMachineTag("1"),
+	})
+	c.Assert(err, gc.ErrorMatches, "expected 2 results, got 1")
+}
+
 func (s *instanceMutaterSuite) TestWatchMachines(c *gc.C) {
 	defer s.setup(c).Finish()
 
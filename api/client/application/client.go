@@ -654,6 +654,24 @@ func (c *Client) SetConstraints(ctx context.Context, application string, constra
 	return c.facade.FacadeCall(ctx, "SetConstraints", args, nil)
 }
 
+// MergeConstraints merges the given constraints over the existing
+// constraints for the given application, and returns the effective merged
+// constraints.
+func (c *Client) MergeConstraints(ctx context.Context, application string, cons constraints.Value) (constraints.Value, error) {
+	if c.facade.BestAPIVersion() < 21 {
+		return constraints.Value{}, errors.NotSupportedf("merging constraints")
+	}
+	args := params.SetConstraints{
+		ApplicationName: application,
+		Constraints:     cons,
+	}
+	var result params.GetConstraintsResults
+	if err := c.facade.FacadeCall(ctx, "MergeConstraints", args, &result); err != nil {
+		return result.Constraints, errors.Trace(err)
+	}
+	return result.Constraints, nil
+}
+
 // Expose changes the juju-managed firewall to expose any ports that
 // were also explicitly marked by units as open. The exposedEndpoints argument
 // can be used to restrict the set of ports that get exposed and at the same
@@ -898,6 +916,31 @@ func (c *Client) MergeBindings(ctx context.Context, req params.ApplicationMergeB
 	return results.OneError()
 }
 
+// CheckBindingConsistency returns warnings for the units of the application
+// whose machine has no address in a space that one of the application's
+// endpoints is bound to. Such units need to be relocated for the bindings to
+// take effect.
+func (c *Client) CheckBindingConsistency(ctx context.Context, application string) ([]params.BindingWarning, error) {
+	if c.facade.BestAPIVersion() < 21 {
+		return nil, errors.NotSupportedf("checking binding consistency")
+	}
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: names.NewApplicationTag(application).String()},
+	}}
+	var results params.BindingConsistencyResults
+	if err := c.facade.FacadeCall(ctx, "CheckBindingConsistency", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Warnings, nil
+}
+
 // UnitInfo holds information about a unit.
 type UnitInfo struct {
 	Error error
@@ -786,6 +786,39 @@ func (s *applicationSuite) TestGetConstraints(c *gc.C) {
 	})
 }
 
+func (s *applicationSuite) TestMergeConstraints(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	merged := constraints.MustParse("mem=4G", "cores=2")
+	args := params.SetConstraints{
+		ApplicationName: "foo",
+		Constraints:     constraints.MustParse("mem=4G"),
+	}
+	result := new(params.GetConstraintsResults)
+	results := params.GetConstraintsResults{Constraints: merged}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "MergeConstraints", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	res, err := client.MergeConstraints(context.Background(), "foo", constraints.MustParse("mem=4G"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res, jc.DeepEquals, merged)
+}
+
+func (s *applicationSuite) TestMergeConstraintsNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(20)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.MergeConstraints(context.Background(), "foo", constraints.MustParse("mem=4G"))
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
 func (s *applicationSuite) TestGetConstraintsError(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -1206,6 +1239,62 @@ func (s *applicationSuite) TestApplicationsInfoResultMismatch(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "expected 2 results, got 3")
 }
 
+func (s *applicationSuite) TestCheckBindingConsistency(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}}
+	warnings := []params.BindingWarning{{
+		UnitTag:    "unit-foo-0",
+		MachineTag: "machine-0",
+		Endpoint:   "db",
+		Space:      "internal",
+		Message:    "boom",
+	}}
+	result := new(params.BindingConsistencyResults)
+	results := params.BindingConsistencyResults{
+		Results: []params.BindingConsistencyResult{{Warnings: warnings}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CheckBindingConsistency", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.CheckBindingConsistency(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, jc.DeepEquals, warnings)
+}
+
+func (s *applicationSuite) TestCheckBindingConsistencyError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}}
+	result := new(params.BindingConsistencyResults)
+	results := params.BindingConsistencyResults{
+		Results: []params.BindingConsistencyResult{{Error: &params.Error{Message: "boom"}}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CheckBindingConsistency", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.CheckBindingConsistency(context.Background(), "foo")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *applicationSuite) TestCheckBindingConsistencyNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(20)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.CheckBindingConsistency(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
 func (s *applicationSuite) TestUnitsInfoCallError(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
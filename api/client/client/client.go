@@ -72,6 +72,19 @@ func (c *Client) Status(ctx context.Context, args *StatusArgs) (*params.FullStat
 	return &result, nil
 }
 
+// StatusSummary returns the number of applications and units in the model
+// in each status.
+func (c *Client) StatusSummary(ctx context.Context) (params.StatusSummary, error) {
+	if c.BestAPIVersion() < 9 {
+		return params.StatusSummary{}, errors.NotSupportedf("status summary")
+	}
+	var result params.StatusSummary
+	if err := c.facade.FacadeCall(ctx, "StatusSummary", nil, &result); err != nil {
+		return params.StatusSummary{}, err
+	}
+	return result, nil
+}
+
 // StatusHistory retrieves the last <size> results of
 // <kind:combined|agent|workload|machine|machineinstance|container|containerinstance> status
 // for <name> unit
@@ -26,7 +26,7 @@ var facadeVersions = facades.FacadeVersions{
 	"AgentLifeFlag":                {1},
 	"AgentTools":                   {1},
 	"Annotations":                  {2},
-	"Application":                  {19, 20},
+	"Application":                  {19, 20, 21},
 	"ApplicationOffers":            {5},
 	"Backups":                      {3},
 	"Block":                        {2},
@@ -42,7 +42,7 @@ var facadeVersions = facades.FacadeVersions{
 	"CAASUnitProvisioner":          {2},
 	"Charms":                       {7},
 	"Cleaner":                      {2},
-	"Client":                       {8},
+	"Client":                       {8, 9},
 	"Cloud":                        {7},
 	"Controller":                   {12},
 	"CredentialManager":            {1},
@@ -105,7 +105,7 @@ var facadeVersions = facades.FacadeVersions{
 	"UserSecretsManager":           {1},
 	"Spaces":                       {6},
 	"SSHClient":                    {4, 5},
-	"Storage":                      {6},
+	"Storage":                      {6, 7},
 	"StorageProvisioner":           {4},
 	"StringsWatcher":               {1},
 	"Subnets":                      {5},
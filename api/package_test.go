@@ -28,6 +28,7 @@ func (*ImportSuite) TestImports(c *gc.C) {
 		"api/base",
 		"api/watcher",
 		"core/arch",
+		"core/assumes",
 		"core/backups",
 		"core/base",
 		"core/constraints",
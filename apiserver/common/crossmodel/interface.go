@@ -52,6 +52,11 @@ type Backend interface {
 	// OfferConnectionForRelation get the offer connection for a cross model relation.
 	OfferConnectionForRelation(string) (OfferConnection, error)
 
+	// OfferConnectionsForRelations gets the offer connections for the cross
+	// model relations with the given keys, keyed by relation key. Relations
+	// without an offer connection are omitted from the result.
+	OfferConnectionsForRelations([]string) (map[string]OfferConnection, error)
+
 	// AddRemoteApplication creates a new remote application record, having the supplied relation endpoints,
 	// with the supplied name (which must be unique across all applications, local and remote).
 	AddRemoteApplication(AddRemoteApplicationParams) (RemoteApplication, error)
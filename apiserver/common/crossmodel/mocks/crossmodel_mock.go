@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/crossmodel_mock.go github.com/juju/juju/apiserver/common/crossmodel OfferBakeryInterface,Backend,BakeryConfigService,AccessService,ApplicationService,RelationNetworks,StatusService
+//	mockgen -typed -package mocks -destination apiserver/common/crossmodel/mocks/crossmodel_mock.go github.com/juju/juju/apiserver/common/crossmodel OfferBakeryInterface,Backend,BakeryConfigService,AccessService,ApplicationService,RelationNetworks,StatusService
 //
 
 // Package mocks is a generated GoMock package.
@@ -971,6 +971,45 @@ func (c *MockBackendOfferConnectionForRelationCall) DoAndReturn(f func(string) (
 	return c
 }
 
+// OfferConnectionsForRelations mocks base method.
+func (m *MockBackend) OfferConnectionsForRelations(arg0 []string) (map[string]crossmodel.OfferConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferConnectionsForRelations", arg0)
+	ret0, _ := ret[0].(map[string]crossmodel.OfferConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferConnectionsForRelations indicates an expected call of OfferConnectionsForRelations.
+func (mr *MockBackendMockRecorder) OfferConnectionsForRelations(arg0 any) *MockBackendOfferConnectionsForRelationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferConnectionsForRelations", reflect.TypeOf((*MockBackend)(nil).OfferConnectionsForRelations), arg0)
+	return &MockBackendOfferConnectionsForRelationsCall{Call: call}
+}
+
+// MockBackendOfferConnectionsForRelationsCall wrap *gomock.Call
+type MockBackendOfferConnectionsForRelationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendOfferConnectionsForRelationsCall) Return(arg0 map[string]crossmodel.OfferConnection, arg1 error) *MockBackendOfferConnectionsForRelationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendOfferConnectionsForRelationsCall) Do(f func([]string) (map[string]crossmodel.OfferConnection, error)) *MockBackendOfferConnectionsForRelationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendOfferConnectionsForRelationsCall) DoAndReturn(f func([]string) (map[string]crossmodel.OfferConnection, error)) *MockBackendOfferConnectionsForRelationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // OfferUUIDForRelation mocks base method.
 func (m *MockBackend) OfferUUIDForRelation(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
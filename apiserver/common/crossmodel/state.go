@@ -49,6 +49,17 @@ func (st stateShim) OfferConnectionForRelation(relationKey string) (OfferConnect
 		"backend functionality is moved to domain")
 }
 
+// OfferConnectionsForRelations returns the offer connections for the
+// relations with the given keys in a single lookup, rather than one lookup per
+// relation. Relations without an offer connection are skipped.
+func (st stateShim) OfferConnectionsForRelations(relationKeys []string) (map[string]OfferConnection, error) {
+	if len(relationKeys) == 0 {
+		return map[string]OfferConnection{}, nil
+	}
+	return nil, errors.NotImplementedf("cross model relations are disabled until " +
+		"backend functionality is moved to domain")
+}
+
 // ControllerTag returns the tag of the controller in which we are operating.
 // This is a temporary transitional step. Eventually code using
 // crossmodel.Backend will only need to be passed a state.Model.
@@ -0,0 +1,28 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package crossmodel
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type stateShimSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&stateShimSuite{})
+
+func (s *stateShimSuite) TestOfferConnectionsForRelationsNoKeys(c *gc.C) {
+	result, err := stateShim{}.OfferConnectionsForRelations(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.HasLen, 0)
+}
+
+func (s *stateShimSuite) TestOfferConnectionsForRelationsNotImplemented(c *gc.C) {
+	_, err := stateShim{}.OfferConnectionsForRelations([]string{"app:db remote:db"})
+	c.Assert(err, jc.ErrorIs, errors.NotImplemented)
+}
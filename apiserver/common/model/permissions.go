@@ -39,3 +39,51 @@ func HasModelAdmin(
 	}
 	return err == nil, nil
 }
+
+// HasModelRead reports whether a user has read access to the input model.
+// A user has read access if they are a controller superuser, if they are
+// a controller agent, or if they have been granted read access or higher
+// to the model.
+func HasModelRead(
+	ctx context.Context,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+	modelTag names.ModelTag,
+) (bool, error) {
+	// superusers have read for all models.
+	err := authorizer.HasPermission(ctx, permission.SuperuserAccess, controllerTag)
+	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return false, err
+	}
+
+	if err == nil || authorizer.AuthController() {
+		return true, nil
+	}
+
+	err = authorizer.HasPermission(ctx, permission.ReadAccess, modelTag)
+	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return false, err
+	}
+	return err == nil, nil
+}
+
+// HasPermissionForTargets reports, for each of the input targets, whether
+// the authenticated user has the given access to that target.
+// A missing permission is reported as false for the target; any other error
+// stops the evaluation and is returned to the caller.
+func HasPermissionForTargets(
+	ctx context.Context,
+	authorizer facade.Authorizer,
+	access permission.Access,
+	targets []names.Tag,
+) (map[names.Tag]bool, error) {
+	result := make(map[names.Tag]bool, len(targets))
+	for _, target := range targets {
+		err := authorizer.HasPermission(ctx, access, target)
+		if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+			return nil, err
+		}
+		result[target] = err == nil
+	}
+	return result, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
@@ -22,6 +23,8 @@ type PermissionSuite struct {
 	testing.BaseSuite
 }
 
+var _ = gc.Suite(&PermissionSuite{})
+
 func (r *PermissionSuite) TestHasModelAdminSuperUser(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -73,3 +76,108 @@ func (r *PermissionSuite) TestHasModelAdminError(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, someError)
 	c.Assert(has, jc.IsFalse)
 }
+
+func (r *PermissionSuite) TestHasModelReadSuperUser(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	auth := mocks.NewMockAuthorizer(ctrl)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(nil)
+
+	has, err := model.HasModelRead(context.Background(), auth, testing.ControllerTag, testing.ModelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(has, jc.IsTrue)
+}
+
+func (r *PermissionSuite) TestHasModelReadController(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	auth := mocks.NewMockAuthorizer(ctrl)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(authentication.ErrorEntityMissingPermission)
+	auth.EXPECT().AuthController().Return(true)
+
+	has, err := model.HasModelRead(context.Background(), auth, testing.ControllerTag, testing.ModelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(has, jc.IsTrue)
+}
+
+func (r *PermissionSuite) TestHasModelReadYes(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	auth := mocks.NewMockAuthorizer(ctrl)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(authentication.ErrorEntityMissingPermission)
+	auth.EXPECT().AuthController().Return(false)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, testing.ModelTag).Return(nil)
+
+	has, err := model.HasModelRead(context.Background(), auth, testing.ControllerTag, testing.ModelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(has, jc.IsTrue)
+}
+
+func (r *PermissionSuite) TestHasModelReadNo(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	auth := mocks.NewMockAuthorizer(ctrl)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(authentication.ErrorEntityMissingPermission)
+	auth.EXPECT().AuthController().Return(false)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, testing.ModelTag).Return(authentication.ErrorEntityMissingPermission)
+
+	has, err := model.HasModelRead(context.Background(), auth, testing.ControllerTag, testing.ModelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(has, jc.IsFalse)
+}
+
+func (r *PermissionSuite) TestHasModelReadError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	auth := mocks.NewMockAuthorizer(ctrl)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(authentication.ErrorEntityMissingPermission)
+	auth.EXPECT().AuthController().Return(false)
+	someError := errors.New("error")
+	auth.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, testing.ModelTag).Return(someError)
+
+	has, err := model.HasModelRead(context.Background(), auth, testing.ControllerTag, testing.ModelTag)
+	c.Assert(err, jc.ErrorIs, someError)
+	c.Assert(has, jc.IsFalse)
+}
+
+func (r *PermissionSuite) TestHasPermissionForTargets(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	appTag := names.NewApplicationTag("foo")
+
+	auth := mocks.NewMockAuthorizer(ctrl)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, testing.ModelTag).Return(nil)
+	auth.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, appTag).Return(authentication.ErrorEntityMissingPermission)
+
+	has, err := model.HasPermissionForTargets(context.Background(), auth, permission.WriteAccess, []names.Tag{
+		testing.ModelTag, appTag,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(has, jc.DeepEquals, map[names.Tag]bool{
+		testing.ModelTag: true,
+		appTag:           false,
+	})
+}
+
+func (r *PermissionSuite) TestHasPermissionForTargetsError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	appTag := names.NewApplicationTag("foo")
+
+	auth := mocks.NewMockAuthorizer(ctrl)
+	someError := errors.New("error")
+	auth.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, testing.ModelTag).Return(someError)
+
+	has, err := model.HasPermissionForTargets(context.Background(), auth, permission.WriteAccess, []names.Tag{
+		testing.ModelTag, appTag,
+	})
+	c.Assert(err, jc.ErrorIs, someError)
+	c.Check(has, gc.IsNil)
+}
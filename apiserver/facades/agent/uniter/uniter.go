@@ -5,6 +5,7 @@ package uniter
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -881,6 +882,9 @@ func (u *UniterAPI) SetWorkloadVersion(ctx context.Context, args params.EntityWo
 		if err := u.applicationService.SetUnitWorkloadVersion(ctx, unitName, entity.WorkloadVersion); errors.Is(err, applicationerrors.UnitNotFound) {
 			resultItem.Error = apiservererrors.ServerError(errors.NotFoundf("unit %q", unitName))
 			continue
+		} else if errors.Is(err, applicationerrors.WorkloadVersionNotValid) {
+			resultItem.Error = apiservererrors.ServerError(errors.NewNotValid(err, fmt.Sprintf("workload version for unit %q", unitName)))
+			continue
 		} else if err != nil {
 			resultItem.Error = apiservererrors.ServerError(err)
 			continue
@@ -598,6 +598,25 @@ func (s *uniterSuite) expectedGetAvailabilityZone(machineUUID coremachine.UUID,
 	s.machineService.EXPECT().AvailabilityZone(gomock.Any(), machineUUID).Return(az, err)
 }
 
+func (s *uniterSuite) TestSetWorkloadVersionNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitName := coreunit.Name("foo/0")
+	s.applicationService.EXPECT().SetUnitWorkloadVersion(gomock.Any(), unitName, "v1\n").
+		Return(applicationerrors.WorkloadVersionNotValid)
+
+	res, err := s.uniter.SetWorkloadVersion(context.Background(), params.EntityWorkloadVersions{
+		Entities: []params.EntityWorkloadVersion{{
+			Tag:             names.NewUnitTag(unitName.String()).String(),
+			WorkloadVersion: "v1\n",
+		}},
+	})
+
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 1)
+	c.Assert(res.Results[0].Error, jc.Satisfies, params.IsCodeNotValid)
+}
+
 func (s *uniterSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
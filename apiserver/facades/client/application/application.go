@@ -6,7 +6,9 @@ package application
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -33,6 +35,7 @@ import (
 	"github.com/juju/juju/core/leadership"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/os/ostype"
 	"github.com/juju/juju/core/permission"
@@ -63,9 +66,14 @@ import (
 
 var ClassifyDetachedStorage = storagecommon.ClassifyDetachedStorage
 
+// APIv21 provides the Application API facade for version 21.
+type APIv21 struct {
+	*APIBase
+}
+
 // APIv20 provides the Application API facade for version 20.
 type APIv20 struct {
-	*APIBase
+	*APIv21
 }
 
 // APIv19 provides the Application API facade for version 19.
@@ -160,6 +168,7 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 		modelInfo:          modelInfo,
 		modelConfigService: domainServices.Config(),
 		machineService:     domainServices.Machine(),
+		networkService:     domainServices.Network(),
 		applicationService: applicationService,
 		registry:           registry,
 		state:              state,
@@ -299,6 +308,9 @@ func (api *APIBase) Deploy(ctx context.Context, args params.ApplicationsDeploy)
 	if err := api.check.ChangeAllowed(ctx); err != nil {
 		return result, errors.Trace(err)
 	}
+	if err := api.checkBackendAvailable(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
 
 	for i, arg := range args.Applications {
 		if err := apiservercharms.ValidateCharmOrigin(arg.CharmOrigin); err != nil {
@@ -327,6 +339,19 @@ func (api *APIBase) Deploy(ctx context.Context, args params.ApplicationsDeploy)
 	return result, nil
 }
 
+// checkBackendAvailable pings the backend and does a trivial read of the
+// model database, so that operations which write to state fail fast, with
+// a clear error, if either of them is unreachable.
+func (api *APIBase) checkBackendAvailable(ctx context.Context) error {
+	if err := api.backend.Ping(); err != nil {
+		return errors.Annotate(err, "state unavailable")
+	}
+	if _, err := api.modelConfigService.ModelConfig(ctx); err != nil {
+		return errors.Annotate(err, "model database unavailable")
+	}
+	return nil
+}
+
 // cleanupResourcesAddedBeforeApp deletes any resources added before the
 // application. Errors will be logged but not reported to the user. These
 // errors mask the real deployment failure.
@@ -936,6 +961,12 @@ func (api *APIBase) setCharmWithAgentValidation(
 		return errors.Trace(err)
 	}
 
+	if !params.Force.ForceBase {
+		if err := validateCharmOriginPlatform(params.CharmOrigin, newCharm); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	if api.modelType == model.CAAS {
 		locator, err := api.getCharmLocatorByApplicationName(ctx, params.AppName)
 		if err != nil {
@@ -972,6 +1003,62 @@ func (api *APIBase) setCharmWithAgentValidation(
 	return api.applicationSetCharm(ctx, params, newCharm, origin)
 }
 
+// validateCharmOriginPlatform checks that the base, including its channel
+// risk, and architecture of the origin are declared by one of the bases in
+// the charm manifest. Charms
+// without a manifest, or origins without a base, are not checked.
+func validateCharmOriginPlatform(origin *params.CharmOrigin, ch CharmMeta) error {
+	manifest := ch.Manifest()
+	if origin == nil || origin.Base.Name == "" || manifest == nil || len(manifest.Bases) == 0 {
+		return nil
+	}
+	requested, err := corebase.ParseBase(origin.Base.Name, origin.Base.Channel)
+	if err != nil {
+		return errors.NewNotValid(err, fmt.Sprintf("base %s@%s", origin.Base.Name, origin.Base.Channel))
+	}
+
+	supported := make([]string, 0, len(manifest.Bases))
+	for _, mb := range manifest.Bases {
+		channel := mb.Channel.Track
+		if mb.Channel.Risk != "" {
+			channel += "/" + string(mb.Channel.Risk)
+		}
+		base, err := corebase.ParseBase(mb.Name, channel)
+		if err != nil {
+			return errors.NewNotValid(err, fmt.Sprintf("charm manifest base %s@%s", mb.Name, channel))
+		}
+		archSupported := origin.Architecture == "" || len(mb.Architectures) == 0 ||
+			set.NewStrings(mb.Architectures...).Contains(origin.Architecture)
+		// A base without a risk is normalised to stable, so the risks can
+		// be compared directly.
+		riskSupported := base.Channel.Risk == requested.Channel.Risk
+		if base.IsCompatible(requested) && riskSupported && archSupported {
+			return nil
+		}
+		supported = append(supported, formatBasePlatform(base, mb.Architectures))
+	}
+	return errors.NewNotSupported(nil, fmt.Sprintf(
+		"charm does not support base %s, supported bases are: %s",
+		formatBasePlatform(requested, []string{origin.Architecture}),
+		strings.Join(supported, ", "),
+	))
+}
+
+// formatBasePlatform returns a display string for the base and any of the
+// given architectures.
+func formatBasePlatform(base corebase.Base, arches []string) string {
+	var nonEmpty []string
+	for _, a := range arches {
+		if a != "" {
+			nonEmpty = append(nonEmpty, a)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return base.DisplayString()
+	}
+	return fmt.Sprintf("%s (%s)", base.DisplayString(), strings.Join(nonEmpty, ", "))
+}
+
 // applicationSetCharm sets the charm and updated config
 // for the given application.
 func (api *APIBase) applicationSetCharm(
@@ -1496,12 +1583,12 @@ func (api *APIBase) DestroyUnit(ctx context.Context, args params.DestroyUnitsPar
 		}
 
 		// TODO(units) - remove dual write to state
-		op := unit.DestroyOperation(api.store)
-		op.DestroyStorage = arg.DestroyStorage
-		op.Force = arg.Force
+		var maxWait time.Duration
 		if arg.Force {
-			op.MaxWait = common.MaxWait(arg.MaxWait)
+			maxWait = common.MaxWait(arg.MaxWait)
 		}
+		op := unit.DestroyOperationWithForce(api.store, arg.Force, maxWait)
+		op.DestroyStorage = arg.DestroyStorage
 		if err := api.backend.ApplyOperation(op); err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -1791,6 +1878,48 @@ func (api *APIBase) SetConstraints(ctx context.Context, args params.SetConstrain
 	return app.SetConstraints(args.Constraints)
 }
 
+// MergeConstraints isn't implemented in the APIv20 facade.
+func (*APIv20) MergeConstraints(_, _ struct{}) {}
+
+// MergeConstraints merges the given constraints over the existing
+// constraints for a given application, and returns the effective merged
+// constraints. The merged constraints are validated against the model's
+// cloud before they are set.
+func (api *APIBase) MergeConstraints(ctx context.Context, args params.SetConstraints) (params.GetConstraintsResults, error) {
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.GetConstraintsResults{}, err
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return params.GetConstraintsResults{}, errors.Trace(err)
+	}
+
+	appID, err := api.applicationService.GetApplicationIDByName(ctx, args.ApplicationName)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return params.GetConstraintsResults{}, errors.NotFoundf("application %s", args.ApplicationName)
+	} else if err != nil {
+		return params.GetConstraintsResults{}, errors.Trace(err)
+	}
+	merged, err := api.applicationService.MergeApplicationConstraints(ctx, appID, args.Constraints)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return params.GetConstraintsResults{}, errors.NotFoundf("application %s", args.ApplicationName)
+	} else if err != nil {
+		return params.GetConstraintsResults{}, errors.Trace(err)
+	}
+
+	// TODO(nvinuesa): Remove the double-write to mongodb once machines
+	// are fully migrated to dqlite domain. We need the application
+	// constraints to be available for machines, which still read from
+	// mongodb.
+	app, err := api.backend.Application(args.ApplicationName)
+	if err != nil {
+		return params.GetConstraintsResults{}, err
+	}
+	if err := app.SetConstraints(merged); err != nil {
+		return params.GetConstraintsResults{}, err
+	}
+	return params.GetConstraintsResults{Constraints: merged}, nil
+}
+
 // AddRelation adds a relation between the specified endpoints and returns the relation info.
 func (api *APIBase) AddRelation(ctx context.Context, args params.AddRelation) (_ params.AddRelationResults, err error) {
 	if err := api.checkCanWrite(ctx); err != nil {
@@ -2068,6 +2197,10 @@ func (api *APIBase) ResolveUnitErrors(ctx context.Context, p params.UnitsResolve
 	}
 
 	result.Results = make([]params.ErrorResult, len(p.Tags.Entities))
+	var (
+		unitNames []coreunit.Name
+		indices   []int
+	)
 	for i, entity := range p.Tags.Entities {
 		tag, err := names.ParseUnitTag(entity.Tag)
 		if err != nil {
@@ -2079,13 +2212,23 @@ func (api *APIBase) ResolveUnitErrors(ctx context.Context, p params.UnitsResolve
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
 		}
-		err = api.resolveService.ResolveUnit(ctx, unitName, resolveMode)
+		unitNames = append(unitNames, unitName)
+		indices = append(indices, i)
+	}
+	if len(unitNames) == 0 {
+		return result, nil
+	}
+
+	errs, err := api.resolveService.ResolveUnits(ctx, unitNames, resolveMode)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	for j, err := range errs {
+		i := indices[j]
 		if errors.Is(err, resolveerrors.UnitNotFound) {
-			result.Results[i].Error = apiservererrors.ServerError(errors.NotFoundf("unit %q", unitName))
-			continue
+			result.Results[i].Error = apiservererrors.ServerError(errors.NotFoundf("unit %q", unitNames[j]))
 		} else if err != nil {
 			result.Results[i].Error = apiservererrors.ServerError(err)
-			continue
 		}
 	}
 	return result, nil
@@ -2275,6 +2418,13 @@ func (api *APIBase) MergeBindings(ctx context.Context, in params.ApplicationMerg
 			res[i].Error = apiservererrors.ServerError(err)
 			continue
 		}
+		if !arg.Force {
+			if err := api.checkMergedBindingSubnets(ctx, app, bindingsWithSpaceIDs); err != nil {
+				res[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+		}
+
 		bindings, err := state.NewBindings(api.backend, bindingsWithSpaceIDs)
 		if err != nil {
 			res[i].Error = apiservererrors.ServerError(err)
@@ -2311,6 +2461,230 @@ func (api *APIBase) convertSpacesToIDInBindings(ctx context.Context, bindings ma
 	return newMap, nil
 }
 
+// checkMergedBindingSubnets checks the subnets of the bindings that the
+// application would end up with once the input bindings (which contain space
+// IDs) are merged into the ones already stored for it.
+func (api *APIBase) checkMergedBindingSubnets(ctx context.Context, app Application, bindings map[string]string) error {
+	current, err := app.EndpointBindings()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	merged := make(map[string]string)
+	for endpoint, spaceID := range current.Map() {
+		merged[endpoint] = spaceID
+	}
+	for endpoint, spaceID := range bindings {
+		merged[endpoint] = spaceID
+	}
+	return api.checkBindingSubnets(ctx, merged)
+}
+
+// checkBindingSubnets ensures that the subnets of the spaces referenced by
+// the input bindings (which contain space IDs) don't have overlapping CIDRs,
+// as that would leave the routing for the bound endpoints ambiguous.
+func (api *APIBase) checkBindingSubnets(ctx context.Context, bindings map[string]string) error {
+	spaceIDs := set.NewStrings()
+	for _, spaceID := range bindings {
+		spaceIDs.Add(spaceID)
+	}
+	if spaceIDs.Size() < 2 {
+		return nil
+	}
+
+	spaceInfos, err := api.networkService.GetAllSpaces(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	subnetsBySpace := make(map[string][]Subnet)
+	for _, space := range spaceInfos {
+		if !spaceIDs.Contains(space.ID) {
+			continue
+		}
+		for _, subnet := range space.Subnets {
+			subnetsBySpace[space.ID] = append(subnetsBySpace[space.ID], subnetInfoShim{info: subnet})
+		}
+	}
+
+	overlapping, err := overlappingSubnets(subnetsBySpace)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(overlapping) == 0 {
+		return nil
+	}
+	cidrs := make([]string, len(overlapping))
+	for i, subnet := range overlapping {
+		cidrs[i] = subnet.CIDR()
+	}
+	return errors.NotValidf("bindings to spaces with overlapping subnets %s", strings.Join(cidrs, ", "))
+}
+
+// CheckBindingConsistency isn't implemented in the APIv20 facade.
+func (*APIv20) CheckBindingConsistency(_, _ struct{}) {}
+
+// CheckBindingConsistency reports, for each of the given applications, the
+// units whose current machine has no address in a space that one of the
+// application's endpoints is bound to. Such units need to be relocated for
+// the bindings to take effect, for example after a rebind.
+func (api *APIBase) CheckBindingConsistency(ctx context.Context, args params.Entities) (params.BindingConsistencyResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.BindingConsistencyResults{}, err
+	}
+
+	results := make([]params.BindingConsistencyResult, len(args.Entities))
+	for i, entity := range args.Entities {
+		tag, err := names.ParseApplicationTag(entity.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		warnings, err := api.bindingWarnings(ctx, tag.Name)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Warnings = warnings
+	}
+	return params.BindingConsistencyResults{Results: results}, nil
+}
+
+// bindingWarnings returns a warning for every endpoint binding of the
+// application that can't be satisfied by the machine a unit is assigned to.
+// Endpoints bound to the alpha space are not checked, as every machine is
+// able to reach it. Units not assigned to a machine are skipped.
+func (api *APIBase) bindingWarnings(ctx context.Context, appName string) ([]params.BindingWarning, error) {
+	app, err := api.backend.Application(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	bindings, err := app.EndpointBindings()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	endpointsBySpace := make(map[string][]string)
+	for endpoint, spaceID := range bindings.Map() {
+		if spaceID == network.AlphaSpaceId {
+			continue
+		}
+		endpointsBySpace[spaceID] = append(endpointsBySpace[spaceID], endpoint)
+	}
+	if len(endpointsBySpace) == 0 {
+		return nil, nil
+	}
+	spaceIDs := make([]string, 0, len(endpointsBySpace))
+	for spaceID, endpoints := range endpointsBySpace {
+		sort.Strings(endpoints)
+		spaceIDs = append(spaceIDs, spaceID)
+	}
+	sort.Strings(spaceIDs)
+
+	spaceInfos, err := api.networkService.GetAllSpaces(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	spaceName := func(spaceID string) string {
+		if space := spaceInfos.GetByID(spaceID); space != nil {
+			return string(space.Name)
+		}
+		return spaceID
+	}
+
+	unitNames, err := api.applicationService.GetUnitNamesForApplication(ctx, appName)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %s", appName)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sort.Slice(unitNames, func(i, j int) bool { return unitNames[i] < unitNames[j] })
+
+	var warnings []params.BindingWarning
+	for _, unitName := range unitNames {
+		machineName, err := api.applicationService.GetUnitMachineName(ctx, unitName)
+		if errors.Is(err, applicationerrors.UnitMachineNotAssigned) {
+			continue
+		} else if err != nil {
+			return nil, errors.Annotatef(err, "getting machine for unit %q", unitName)
+		}
+		machine, err := api.backend.Machine(machineName.String())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		for _, spaceID := range spaceIDs {
+			_, err := machine.PublicAddressInSpace(spaceID)
+			if err == nil {
+				continue
+			} else if !errors.Is(err, errors.NotFound) {
+				return nil, errors.Trace(err)
+			}
+			name := spaceName(spaceID)
+			for _, endpoint := range endpointsBySpace[spaceID] {
+				warnings = append(warnings, params.BindingWarning{
+					UnitTag:    names.NewUnitTag(unitName.String()).String(),
+					MachineTag: names.NewMachineTag(machineName.String()).String(),
+					Endpoint:   endpoint,
+					Space:      name,
+					Message: fmt.Sprintf("machine %q has no address in space %q; unit %q needs to be relocated",
+						machineName, name, unitName),
+				})
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// overlappingSubnets returns the subnets, sorted by CIDR, that overlap with a
+// subnet in a different space. The input subnets are keyed by space. Both
+// IPv4 and IPv6 CIDRs are supported; subnets of different address families
+// never overlap.
+func overlappingSubnets(subnetsBySpace map[string][]Subnet) ([]Subnet, error) {
+	type spaceSubnet struct {
+		space  string
+		subnet Subnet
+		prefix netip.Prefix
+	}
+
+	var all []spaceSubnet
+	for space, subnets := range subnetsBySpace {
+		for _, subnet := range subnets {
+			prefix, err := netip.ParsePrefix(subnet.CIDR())
+			if err != nil {
+				return nil, errors.NotValidf("CIDR %q for subnet in space %q", subnet.CIDR(), space)
+			}
+			all = append(all, spaceSubnet{
+				space:  space,
+				subnet: subnet,
+				prefix: prefix.Masked(),
+			})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if a, b := all[i].subnet.CIDR(), all[j].subnet.CIDR(); a != b {
+			return a < b
+		}
+		return all[i].space < all[j].space
+	})
+
+	overlaps := make([]bool, len(all))
+	for i := range all {
+		for j := i + 1; j < len(all); j++ {
+			if all[i].space == all[j].space || !all[i].prefix.Overlaps(all[j].prefix) {
+				continue
+			}
+			overlaps[i], overlaps[j] = true, true
+		}
+	}
+
+	var result []Subnet
+	for i, overlap := range overlaps {
+		if overlap {
+			result = append(result, all[i].subnet)
+		}
+	}
+	return result, nil
+}
+
 // AgentTools is a point of use agent tools requester.
 type AgentTools interface {
 	AgentTools() (*tools.Tools, error)
@@ -2543,6 +2917,40 @@ func (api *APIBase) Leader(ctx context.Context, entity params.Entity) (params.St
 	return result, nil
 }
 
+// ApplicationsInSpace isn't implemented in the APIv20 facade.
+func (*APIv20) ApplicationsInSpace(_, _ struct{}) {}
+
+// ApplicationsInSpace returns, for each of the given space tags, the sorted
+// names of the applications with an endpoint bound to the space, either
+// explicitly or through the application's default space. A not found error
+// is returned for any space that doesn't exist.
+func (api *APIBase) ApplicationsInSpace(ctx context.Context, args params.Entities) (params.StringsResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.StringsResults{}, errors.Trace(err)
+	}
+
+	results := params.StringsResults{
+		Results: make([]params.StringsResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		tag, err := names.ParseSpaceTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		apps, err := api.applicationService.GetApplicationsBoundToSpace(ctx, network.SpaceName(tag.Id()))
+		if errors.Is(err, applicationerrors.SpaceNotFound) {
+			results.Results[i].Error = apiservererrors.ServerError(errors.NotFoundf("space %q", tag.Id()))
+			continue
+		} else if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = apps
+	}
+	return results, nil
+}
+
 // DeployFromRepository is a one-stop deployment method for repository
 // charms. Only a charm name is required to deploy. If argument validation
 // fails, a list of all errors found in validation will be returned. If a
@@ -2555,6 +2963,9 @@ func (api *APIBase) DeployFromRepository(ctx context.Context, args params.Deploy
 	if err := api.check.ChangeAllowed(ctx); err != nil {
 		return params.DeployFromRepositoryResults{}, errors.Trace(err)
 	}
+	if err := api.checkBackendAvailable(ctx); err != nil {
+		return params.DeployFromRepositoryResults{}, errors.Trace(err)
+	}
 
 	results := make([]params.DeployFromRepositoryResult, len(args.Args))
 	for i, entity := range args.Args {
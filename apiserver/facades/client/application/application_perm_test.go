@@ -275,6 +275,31 @@ func (s *permBaseSuite) TestSetConstraintsBlocked(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestMergeConstraintsPermission(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasIncorrectPermission()
+
+	s.newAPI(c)
+
+	_, err := s.api.MergeConstraints(context.Background(), params.SetConstraints{})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *permBaseSuite) TestMergeConstraintsBlocked(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.expectDisallowBlockChange()
+
+	s.newAPI(c)
+
+	_, err := s.api.MergeConstraints(context.Background(), params.SetConstraints{})
+	c.Assert(err, gc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestAddRelationPermission(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -15,12 +15,16 @@ import (
 	gomock "go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/core/application"
 	applicationtesting "github.com/juju/juju/core/application/testing"
 	corearch "github.com/juju/juju/core/arch"
 	coreassumes "github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/constraints"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/instance"
+	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
 	corerelation "github.com/juju/juju/core/relation"
@@ -42,6 +46,7 @@ import (
 	internalcharm "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charm/assumes"
 	charmresource "github.com/juju/juju/internal/charm/resource"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 )
@@ -51,6 +56,7 @@ type applicationSuite struct {
 
 	application *MockApplication
 	charm       *MockCharm
+	unit        *MockUnit
 }
 
 var _ = gc.Suite(&applicationSuite{})
@@ -65,6 +71,7 @@ func (s *applicationSuite) TestSetCharm(c *gc.C) {
 	s.setupAPI(c)
 	s.expectApplication(c, "foo")
 	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
 	s.expectCharmConfig(c, 1)
 	s.expectCharmAssumes(c)
 	s.expectCharmFormatCheck(c, "foo")
@@ -160,6 +167,7 @@ func (s *applicationSuite) TestSetCharmEndpointBindings(c *gc.C) {
 	s.expectApplication(c, "foo")
 	s.expectSpaceName(c, "bar")
 	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
 	s.expectCharmConfig(c, 1)
 	s.expectCharmAssumes(c)
 	s.expectCharmFormatCheck(c, "foo")
@@ -277,12 +285,155 @@ func (s *applicationSuite) TestSetCharmGetCharmNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, errors.NotFound)
 }
 
+func (s *applicationSuite) TestSetCharmUnsupportedBase(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
+
+	err := s.api.SetCharm(context.Background(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "local:foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "local",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "22.04",
+			},
+			Architecture: "amd64",
+			Revision:     ptr(42),
+		},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+	c.Check(err, gc.ErrorMatches, `charm does not support base ubuntu@22.04 \(amd64\), supported bases are: ubuntu@24.04 \(amd64\)`)
+}
+
+func (s *applicationSuite) TestSetCharmUnsupportedArchitecture(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
+
+	err := s.api.SetCharm(context.Background(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "local:foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "local",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "24.04",
+			},
+			Architecture: "arm64",
+			Revision:     ptr(42),
+		},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+	c.Check(err, gc.ErrorMatches, `charm does not support base ubuntu@24.04 \(arm64\), supported bases are: ubuntu@24.04 \(amd64\)`)
+}
+
+func (s *applicationSuite) TestSetCharmUnsupportedRisk(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
+
+	err := s.api.SetCharm(context.Background(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "local:foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "local",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "24.04/edge",
+			},
+			Architecture: "amd64",
+			Revision:     ptr(42),
+		},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+	c.Check(err, gc.ErrorMatches, `charm does not support base ubuntu@24.04/edge \(amd64\), supported bases are: ubuntu@24.04 \(amd64\)`)
+}
+
+func (s *applicationSuite) TestSetCharmInvalidManifestBase(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	s.expectCharm(c, "foo")
+	s.charm.EXPECT().Manifest().Return(&internalcharm.Manifest{
+		Bases: []internalcharm.Base{{
+			Name:          "ubuntu",
+			Channel:       internalcharm.Channel{Track: "24.04", Risk: "bogus"},
+			Architectures: []string{"amd64"},
+		}},
+	})
+
+	err := s.api.SetCharm(context.Background(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "local:foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "local",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "24.04",
+			},
+			Architecture: "amd64",
+			Revision:     ptr(42),
+		},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Check(err, gc.ErrorMatches, `charm manifest base ubuntu@24.04/bogus: .*`)
+}
+
+func (s *applicationSuite) TestSetCharmUnsupportedBaseForceBase(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	s.expectCharm(c, "foo")
+	s.expectCharmConfig(c, 1)
+	s.expectCharmAssumes(c)
+	s.expectCharmFormatCheck(c, "foo")
+	s.expectSetCharm(c, "foo", func(c *gc.C, config state.SetCharmConfig) {
+		c.Check(config.ForceBase, jc.IsTrue)
+	})
+
+	err := s.api.SetCharm(context.Background(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "local:foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "local",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "22.04",
+			},
+			Architecture: "amd64",
+			Revision:     ptr(42),
+		},
+		ForceBase:  true,
+		ForceUnits: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *applicationSuite) TestSetCharmInvalidConfig(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
 	s.expectApplication(c, "foo")
 	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
 	s.expectCharmConfig(c, 1)
 
 	err := s.api.SetCharm(context.Background(), params.ApplicationSetCharmV2{
@@ -315,6 +466,7 @@ func (s *applicationSuite) TestSetCharmWithoutTrust(c *gc.C) {
 	s.setupAPI(c)
 	s.expectApplication(c, "foo")
 	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
 	s.expectCharmConfig(c, 1)
 	s.expectCharmAssumes(c)
 	s.expectCharmFormatCheck(c, "foo")
@@ -371,6 +523,7 @@ func (s *applicationSuite) TestSetCharmFormatDowngrade(c *gc.C) {
 	s.setupAPI(c)
 	s.expectApplication(c, "foo")
 	s.expectCharm(c, "foo")
+	s.expectCharmManifest(c)
 	s.expectCharmConfig(c, 1)
 	s.expectCharmAssumes(c)
 	s.expectCharmFormatCheckDowngrade(c, "foo")
@@ -403,6 +556,7 @@ func (s *applicationSuite) TestDeploy(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectBackendAvailable(c)
 	s.expectCharm(c, "foo")
 	s.expectCharmConfig(c, 2)
 	s.expectCharmMeta("foo", nil, 8)
@@ -434,6 +588,37 @@ func (s *applicationSuite) TestDeploy(c *gc.C) {
 	c.Assert(errorResults.Results[0].Error, gc.IsNil)
 }
 
+func (s *applicationSuite) TestDeployStateUnavailable(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectPing(errors.New("boom"))
+
+	_, err := s.api.Deploy(context.Background(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{{
+			ApplicationName: "foo",
+			CharmURL:        "local:foo-42",
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "state unavailable: boom")
+}
+
+func (s *applicationSuite) TestDeployModelDatabaseUnavailable(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectPing(nil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, err := s.api.Deploy(context.Background(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{{
+			ApplicationName: "foo",
+			CharmURL:        "local:foo-42",
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "model database unavailable: boom")
+}
+
 // TestDeployWithResources test the scenario of deploying
 // local charms, or charms via bundles that have resources.
 // Deploy rather than DeployFromRepository is called by the
@@ -443,6 +628,7 @@ func (s *applicationSuite) TestDeployWithPendingResources(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectBackendAvailable(c)
 	resourceUUID := testing.GenResourceUUID(c)
 	s.expectCharm(c, "foo")
 	s.expectCharmConfig(c, 2)
@@ -484,6 +670,7 @@ func (s *applicationSuite) TestDeployFailureDeletesPendingResources(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectBackendAvailable(c)
 	s.expectCharm(c, "foo")
 	s.expectCharmConfig(c, 2)
 	s.expectCharmMeta("foo", map[string]charmresource.Meta{
@@ -528,6 +715,7 @@ func (s *applicationSuite) TestDeployMismatchedResources(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectBackendAvailable(c)
 	s.expectCharm(c, "foo")
 	s.expectCharmMeta("foo", map[string]charmresource.Meta{
 		"bar": {
@@ -570,6 +758,7 @@ func (s *applicationSuite) TestDeployInvalidSource(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectBackendAvailable(c)
 
 	errorResults, err := s.api.Deploy(context.Background(), params.ApplicationsDeploy{
 		Applications: []params.ApplicationDeploy{
@@ -784,6 +973,56 @@ func (s *applicationSuite) TestSetApplicationConstraints(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *applicationSuite) TestMergeApplicationConstraintsAppNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(application.ID(""), applicationerrors.ApplicationNotFound)
+
+	_, err := s.api.MergeConstraints(context.Background(), params.SetConstraints{
+		ApplicationName: "foo",
+		Constraints:     constraints.Value{Mem: ptr(uint64(42))},
+	})
+	c.Assert(err, gc.ErrorMatches, "application foo not found")
+}
+
+func (s *applicationSuite) TestMergeApplicationConstraintsError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(application.ID("app-foo"), nil)
+	s.applicationService.EXPECT().MergeApplicationConstraints(gomock.Any(), application.ID("app-foo"), constraints.Value{InstanceType: ptr("bad")}).Return(constraints.Value{}, errors.New("boom"))
+
+	_, err := s.api.MergeConstraints(context.Background(), params.SetConstraints{
+		ApplicationName: "foo",
+		Constraints:     constraints.Value{InstanceType: ptr("bad")},
+	})
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *applicationSuite) TestMergeApplicationConstraints(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	merged := constraints.Value{Arch: ptr("amd64"), Mem: ptr(uint64(42))}
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(application.ID("app-foo"), nil)
+	s.applicationService.EXPECT().MergeApplicationConstraints(gomock.Any(), application.ID("app-foo"), constraints.Value{Mem: ptr(uint64(42))}).Return(merged, nil)
+	// TODO(nvinuesa): Remove the double-write to mongodb once machines
+	// are fully migrated to dqlite domain.
+	s.expectApplication(c, "foo")
+	s.application.EXPECT().SetConstraints(merged).Return(nil)
+
+	result, err := s.api.MergeConstraints(context.Background(), params.SetConstraints{
+		ApplicationName: "foo",
+		Constraints:     constraints.Value{Mem: ptr(uint64(42))},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Constraints, gc.DeepEquals, merged)
+}
+
 func (s *applicationSuite) TestAddRelation(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1109,7 +1348,7 @@ func (s *applicationSuite) TestResolveUnitErrorsSpecificNoRetry(c *gc.C) {
 	s.setupAPI(c)
 
 	unitName := coreunit.Name("foo/1")
-	s.resolveService.EXPECT().ResolveUnit(gomock.Any(), unitName, resolve.ResolveModeNoHooks).Return(nil)
+	s.resolveService.EXPECT().ResolveUnits(gomock.Any(), []coreunit.Name{unitName}, resolve.ResolveModeNoHooks).Return([]error{nil}, nil)
 
 	res, err := s.api.ResolveUnitErrors(context.Background(), params.UnitsResolved{
 		Tags: params.Entities{
@@ -1127,7 +1366,7 @@ func (s *applicationSuite) TestResolveUnitErrorsSpecificRetryHooks(c *gc.C) {
 	s.setupAPI(c)
 
 	unitName := coreunit.Name("foo/1")
-	s.resolveService.EXPECT().ResolveUnit(gomock.Any(), unitName, resolve.ResolveModeRetryHooks).Return(nil)
+	s.resolveService.EXPECT().ResolveUnits(gomock.Any(), []coreunit.Name{unitName}, resolve.ResolveModeRetryHooks).Return([]error{nil}, nil)
 
 	res, err := s.api.ResolveUnitErrors(context.Background(), params.UnitsResolved{
 		Tags: params.Entities{
@@ -1146,7 +1385,7 @@ func (s *applicationSuite) TestResolveUnitErrorsUnitNotFound(c *gc.C) {
 	s.setupAPI(c)
 
 	unitName := coreunit.Name("foo/1")
-	s.resolveService.EXPECT().ResolveUnit(gomock.Any(), unitName, resolve.ResolveModeNoHooks).Return(resolveerrors.UnitNotFound)
+	s.resolveService.EXPECT().ResolveUnits(gomock.Any(), []coreunit.Name{unitName}, resolve.ResolveModeNoHooks).Return([]error{resolveerrors.UnitNotFound}, nil)
 
 	res, err := s.api.ResolveUnitErrors(context.Background(), params.UnitsResolved{
 		Tags: params.Entities{
@@ -1158,6 +1397,32 @@ func (s *applicationSuite) TestResolveUnitErrorsUnitNotFound(c *gc.C) {
 	c.Assert(res.Results[0].Error, jc.Satisfies, params.IsCodeNotFound)
 }
 
+func (s *applicationSuite) TestResolveUnitErrorsMultipleUnits(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	unitNames := []coreunit.Name{"foo/1", "foo/2"}
+	s.resolveService.EXPECT().ResolveUnits(gomock.Any(), unitNames, resolve.ResolveModeNoHooks).Return([]error{
+		resolveerrors.UnitNotInErrorState, nil,
+	}, nil)
+
+	res, err := s.api.ResolveUnitErrors(context.Background(), params.UnitsResolved{
+		Tags: params.Entities{
+			Entities: []params.Entity{
+				{Tag: names.NewUnitTag("foo/1").String()},
+				{Tag: "application-foo"},
+				{Tag: names.NewUnitTag("foo/2").String()},
+			},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 3)
+	c.Check(res.Results[0].Error, gc.ErrorMatches, ".*unit is not in error state.*")
+	c.Check(res.Results[1].Error, gc.ErrorMatches, `"application-foo" is not a valid unit tag`)
+	c.Check(res.Results[2].Error, gc.IsNil)
+}
+
 func (s *applicationSuite) TestDestroyRelationByEndpoints(c *gc.C) {
 	// Arrange
 	defer s.setupMocks(c).Finish()
@@ -1257,11 +1522,414 @@ func (s *applicationSuite) TestSetRelationsSuspendedStub(c *gc.C) {
 		"- TestSetRelationsSuspendedNoOffer")
 }
 
+func (s *applicationSuite) TestMergeBindingsOverlappingSubnets(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	bindings := NewMockBindings(ctrl)
+	bindings.EXPECT().Map().Return(map[string]string{
+		"": network.AlphaSpaceId,
+	})
+	s.application.EXPECT().EndpointBindings().Return(bindings, nil)
+	s.networkService.EXPECT().SpaceByName(gomock.Any(), "alpha").Return(&network.SpaceInfo{ID: "space-1"}, nil)
+	s.networkService.EXPECT().SpaceByName(gomock.Any(), "beta").Return(&network.SpaceInfo{ID: "space-2"}, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{{
+		ID:      "space-1",
+		Subnets: network.SubnetInfos{{CIDR: "10.0.0.0/16"}},
+	}, {
+		ID:      "space-2",
+		Subnets: network.SubnetInfos{{CIDR: "10.0.1.0/24"}, {CIDR: "192.168.0.0/24"}},
+	}}, nil)
+
+	results, err := s.api.MergeBindings(context.Background(), params.ApplicationMergeBindingsArgs{
+		Args: []params.ApplicationMergeBindings{{
+			ApplicationTag: names.NewApplicationTag("foo").String(),
+			Bindings: map[string]string{
+				"db":    "alpha",
+				"admin": "beta",
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Check(results.Results[0].Error, gc.ErrorMatches, `bindings to spaces with overlapping subnets 10.0.0.0/16, 10.0.1.0/24 not valid`)
+}
+
+func (s *applicationSuite) TestMergeBindingsOverlappingExistingBinding(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	s.networkService.EXPECT().SpaceByName(gomock.Any(), "beta").Return(&network.SpaceInfo{ID: "space-2"}, nil)
+
+	// The stored binding of "db" overlaps with the new binding of "admin".
+	bindings := NewMockBindings(ctrl)
+	bindings.EXPECT().Map().Return(map[string]string{
+		"":   network.AlphaSpaceId,
+		"db": "space-1",
+	})
+	s.application.EXPECT().EndpointBindings().Return(bindings, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{{
+		ID:      network.AlphaSpaceId,
+		Subnets: network.SubnetInfos{{CIDR: "172.16.0.0/24"}},
+	}, {
+		ID:      "space-1",
+		Subnets: network.SubnetInfos{{CIDR: "10.0.0.0/16"}},
+	}, {
+		ID:      "space-2",
+		Subnets: network.SubnetInfos{{CIDR: "10.0.1.0/24"}},
+	}}, nil)
+
+	results, err := s.api.MergeBindings(context.Background(), params.ApplicationMergeBindingsArgs{
+		Args: []params.ApplicationMergeBindings{{
+			ApplicationTag: names.NewApplicationTag("foo").String(),
+			Bindings: map[string]string{
+				"admin": "beta",
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Check(results.Results[0].Error, gc.ErrorMatches, `bindings to spaces with overlapping subnets 10.0.0.0/16, 10.0.1.0/24 not valid`)
+}
+
+func (s *applicationSuite) TestCheckBindingConsistency(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	bindings := NewMockBindings(ctrl)
+	bindings.EXPECT().Map().Return(map[string]string{
+		"":      network.AlphaSpaceId,
+		"db":    "space-1",
+		"admin": "space-1",
+		"web":   "space-2",
+	})
+	s.application.EXPECT().EndpointBindings().Return(bindings, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{
+		{ID: "space-1", Name: "internal"},
+		{ID: "space-2", Name: "public"},
+	}, nil)
+	s.applicationService.EXPECT().GetUnitNamesForApplication(gomock.Any(), "foo").Return([]coreunit.Name{"foo/1", "foo/0"}, nil)
+
+	s.applicationService.EXPECT().GetUnitMachineName(gomock.Any(), coreunit.Name("foo/0")).Return(coremachine.Name("0"), nil)
+	machine0 := NewMockMachine(ctrl)
+	s.backend.EXPECT().Machine("0").Return(machine0, nil)
+	machine0.EXPECT().PublicAddressInSpace("space-1").Return(network.SpaceAddress{}, errors.NotFoundf("address"))
+	machine0.EXPECT().PublicAddressInSpace("space-2").Return(network.NewSpaceAddress("10.0.0.1"), nil)
+
+	s.applicationService.EXPECT().GetUnitMachineName(gomock.Any(), coreunit.Name("foo/1")).Return(coremachine.Name("1"), nil)
+	machine1 := NewMockMachine(ctrl)
+	s.backend.EXPECT().Machine("1").Return(machine1, nil)
+	machine1.EXPECT().PublicAddressInSpace("space-1").Return(network.NewSpaceAddress("10.0.0.2"), nil)
+	machine1.EXPECT().PublicAddressInSpace("space-2").Return(network.NewSpaceAddress("10.0.0.3"), nil)
+
+	results, err := s.api.CheckBindingConsistency(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag("foo").String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[0].Warnings, jc.DeepEquals, []params.BindingWarning{{
+		UnitTag:    "unit-foo-0",
+		MachineTag: "machine-0",
+		Endpoint:   "admin",
+		Space:      "internal",
+		Message:    `machine "0" has no address in space "internal"; unit "foo/0" needs to be relocated`,
+	}, {
+		UnitTag:    "unit-foo-0",
+		MachineTag: "machine-0",
+		Endpoint:   "db",
+		Space:      "internal",
+		Message:    `machine "0" has no address in space "internal"; unit "foo/0" needs to be relocated`,
+	}})
+}
+
+func (s *applicationSuite) TestCheckBindingConsistencySkipsUnassignedUnits(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.setupAPI(c)
+	s.expectApplication(c, "foo")
+	bindings := NewMockBindings(ctrl)
+	bindings.EXPECT().Map().Return(map[string]string{"db": "space-1"})
+	s.application.EXPECT().EndpointBindings().Return(bindings, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{{ID: "space-1", Name: "internal"}}, nil)
+	s.applicationService.EXPECT().GetUnitNamesForApplication(gomock.Any(), "foo").Return([]coreunit.Name{"foo/0"}, nil)
+	s.applicationService.EXPECT().GetUnitMachineName(gomock.Any(), coreunit.Name("foo/0")).Return("", applicationerrors.UnitMachineNotAssigned)
+
+	results, err := s.api.CheckBindingConsistency(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag("foo").String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Check(results.Results[0], jc.DeepEquals, params.BindingConsistencyResult{})
+}
+
+func (s *applicationSuite) TestCheckBindingConsistencyApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectApplicationNotFound(c, "foo")
+
+	results, err := s.api.CheckBindingConsistency(context.Background(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewApplicationTag("foo").String()},
+			{Tag: "unit-foo-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Check(results.Results[0].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(results.Results[1].Error, gc.ErrorMatches, `"unit-foo-0" is not a valid application tag`)
+}
+
+func (s *applicationSuite) TestApplicationsInSpace(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.applicationService.EXPECT().GetApplicationsBoundToSpace(gomock.Any(), network.SpaceName("internal")).Return([]string{"bar", "foo"}, nil)
+	s.applicationService.EXPECT().GetApplicationsBoundToSpace(gomock.Any(), network.SpaceName("unknown")).Return(nil, applicationerrors.SpaceNotFound)
+
+	results, err := s.api.ApplicationsInSpace(context.Background(), params.Entities{Entities: []params.Entity{
+		{Tag: "space-internal"},
+		{Tag: "space-unknown"},
+		{Tag: "application-foo"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 3)
+	c.Check(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[0].Result, jc.DeepEquals, []string{"bar", "foo"})
+	c.Check(results.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(results.Results[1].Error, gc.ErrorMatches, `space "unknown" not found`)
+	c.Check(results.Results[2].Error, gc.ErrorMatches, `"application-foo" is not a valid space tag`)
+}
+
+func (s *applicationSuite) TestExportBindings(c *gc.C) {
+	bindings := exportBindings(map[string]string{
+		"":      network.AlphaSpaceName,
+		"db":    "db-space",
+		"admin": network.AlphaSpaceName,
+	})
+	c.Check(bindings, jc.DeepEquals, map[string]string{
+		"db": "db-space",
+	})
+}
+
+func (s *applicationSuite) TestExportBindingsNonAlphaDefault(c *gc.C) {
+	bindings := exportBindings(map[string]string{
+		"":      "public",
+		"db":    "db-space",
+		"admin": network.AlphaSpaceName,
+	})
+	c.Check(bindings, jc.DeepEquals, map[string]string{
+		"":      "public",
+		"db":    "db-space",
+		"admin": network.AlphaSpaceName,
+	})
+}
+
+func (s *applicationSuite) TestExportBindingsEmpty(c *gc.C) {
+	bindings := exportBindings(nil)
+	c.Check(bindings, gc.HasLen, 0)
+}
+
+func (s *applicationSuite) TestValidateBindings(c *gc.C) {
+	meta := &internalcharm.Meta{
+		Provides:      map[string]internalcharm.Relation{"db": {Name: "db"}},
+		ExtraBindings: map[string]internalcharm.ExtraBinding{"admin": {Name: "admin"}},
+	}
+	knownSpaces := network.SpaceInfos{
+		{ID: network.AlphaSpaceId, Name: network.AlphaSpaceName},
+		{ID: "1", Name: "db-space"},
+	}
+
+	err := validateBindings(map[string]string{
+		"":      network.AlphaSpaceId,
+		"db":    "1",
+		"admin": network.AlphaSpaceId,
+	}, knownSpaces, meta)
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestValidateBindingsUnknownEndpointsAndSpaces(c *gc.C) {
+	meta := &internalcharm.Meta{
+		Provides: map[string]internalcharm.Relation{"db": {Name: "db"}},
+	}
+	knownSpaces := network.SpaceInfos{
+		{ID: network.AlphaSpaceId, Name: network.AlphaSpaceName},
+	}
+
+	err := validateBindings(map[string]string{
+		"":      "2",
+		"db":    network.AlphaSpaceId,
+		"admin": "1",
+		"web":   network.AlphaSpaceId,
+	}, knownSpaces, meta)
+	c.Check(err, jc.ErrorIs, applicationerrors.CharmRelationNotFound)
+	c.Check(err, jc.ErrorIs, applicationerrors.SpaceNotFound)
+	c.Check(err, gc.ErrorMatches, `charm relation\(s\) or extra binding "admin,web" not found\nspace\(s\) "1,2" not found`)
+}
+
+func (s *applicationSuite) TestBindingsShimValidateNilCharmMeta(c *gc.C) {
+	bindings, err := state.NewBindings(nil, map[string]string{"": network.AlphaSpaceId})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = stateBindingsShim{Bindings: bindings}.Validate(network.SpaceInfos{}, nil)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *applicationSuite) TestCharmURLString(c *gc.C) {
+	curl := "ch:amd64/foo-1"
+	result, err := charmURLString("foo", &curl)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.Equals, curl)
+}
+
+func (s *applicationSuite) TestCharmURLStringUnset(c *gc.C) {
+	_, err := charmURLString("foo", nil)
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+
+	empty := ""
+	_, err = charmURLString("foo", &empty)
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *applicationSuite) TestCharmURLStringInvalid(c *gc.C) {
+	curl := "ch:foo/bar/baz/qux-1"
+	_, err := charmURLString("foo", &curl)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+	c.Check(err, gc.ErrorMatches, `charm URL "ch:foo/bar/baz/qux-1" for application "foo" not valid`)
+}
+
+func (s *applicationSuite) TestOverlappingSubnets(c *gc.C) {
+	overlapping, err := overlappingSubnets(map[string][]Subnet{
+		"space-1": {
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "10.0.0.0/16"}},
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "2001:db8::/32"}},
+		},
+		"space-2": {
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "10.0.10.0/24"}},
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "2001:db8:1::/48"}},
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "172.16.0.0/12"}},
+		},
+		"space-3": {
+			// Overlaps only with a subnet in the same space, which is fine.
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "192.168.0.0/16"}},
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "192.168.1.0/24"}},
+			// IPv4-mapped addresses don't overlap with IPv4 subnets.
+			subnetInfoShim{info: network.SubnetInfo{CIDR: "::ffff:10.0.0.0/104"}},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	cidrs := make([]string, len(overlapping))
+	for i, subnet := range overlapping {
+		cidrs[i] = subnet.CIDR()
+	}
+	c.Check(cidrs, jc.DeepEquals, []string{
+		"10.0.0.0/16",
+		"10.0.10.0/24",
+		"2001:db8:1::/48",
+		"2001:db8::/32",
+	})
+}
+
+func (s *applicationSuite) TestOverlappingSubnetsNone(c *gc.C) {
+	overlapping, err := overlappingSubnets(map[string][]Subnet{
+		"space-1": {subnetInfoShim{info: network.SubnetInfo{CIDR: "10.0.0.0/24"}}},
+		"space-2": {subnetInfoShim{info: network.SubnetInfo{CIDR: "10.0.1.0/24"}}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(overlapping, gc.HasLen, 0)
+}
+
+func (s *applicationSuite) TestOverlappingSubnetsInvalidCIDR(c *gc.C) {
+	_, err := overlappingSubnets(map[string][]Subnet{
+		"space-1": {subnetInfoShim{info: network.SubnetInfo{CIDR: "10.0.0.0"}}},
+	})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *applicationSuite) TestZonePlacement(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.newIAASAPI(c)
+
+	modelScope := s.modelUUID.String()
+	for _, t := range []struct {
+		placement *instance.Placement
+		zone      string
+		ok        bool
+	}{
+		{placement: nil},
+		{placement: &instance.Placement{Scope: modelScope, Directive: "zone=a"}, zone: "a", ok: true},
+		{placement: &instance.Placement{Scope: modelScope, Directive: "zone="}},
+		{placement: &instance.Placement{Scope: modelScope, Directive: "zone=a,system-id=b"}},
+		{placement: &instance.Placement{Scope: modelScope, Directive: "system-id=b"}},
+		{placement: &instance.Placement{Scope: instance.MachineScope, Directive: "0"}},
+	} {
+		c.Logf("placement %v", t.placement)
+		zone, ok := s.api.zonePlacement(t.placement)
+		c.Check(zone, gc.Equals, t.zone)
+		c.Check(ok, gc.Equals, t.ok)
+	}
+}
+
+func (s *applicationSuite) TestAssignUnitWithZone(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.newIAASAPI(c)
+
+	allSpaces := network.SpaceInfos{{ID: "space-1"}}
+	s.networkService.EXPECT().GetProviderAvailabilityZones(gomock.Any()).Return(network.AvailabilityZones{
+		&apiservertesting.FakeZone{ZoneName: "zone-a", ZoneAvailable: true},
+	}, nil)
+	s.unit.EXPECT().AssignWithZone("zone-a", allSpaces).Return(nil)
+
+	err := s.api.assignUnitWithZone(context.Background(), s.unit, "zone-a", allSpaces)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestAssignUnitWithZoneNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.newIAASAPI(c)
+
+	s.networkService.EXPECT().GetProviderAvailabilityZones(gomock.Any()).Return(network.AvailabilityZones{}, nil)
+
+	err := s.api.assignUnitWithZone(context.Background(), s.unit, "zone-a", nil)
+	c.Assert(err, jc.ErrorIs, coreerrors.NotSupported)
+	c.Check(err, gc.ErrorMatches, "availability zones not supported for this cloud")
+}
+
+func (s *applicationSuite) TestAssignUnitWithZoneUnknownZone(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.newIAASAPI(c)
+
+	s.networkService.EXPECT().GetProviderAvailabilityZones(gomock.Any()).Return(network.AvailabilityZones{
+		&apiservertesting.FakeZone{ZoneName: "zone-a", ZoneAvailable: true},
+	}, nil)
+
+	err := s.api.assignUnitWithZone(context.Background(), s.unit, "zone-b", nil)
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *applicationSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := s.baseSuite.setupMocks(c)
 
 	s.application = NewMockApplication(ctrl)
 	s.charm = NewMockCharm(ctrl)
+	s.unit = NewMockUnit(ctrl)
 
 	return ctrl
 }
@@ -1282,6 +1950,15 @@ func (s *applicationSuite) expectApplicationNotFound(c *gc.C, name string) {
 	s.backend.EXPECT().Application(name).Return(nil, errors.NotFoundf("application %q", name))
 }
 
+func (s *applicationSuite) expectPing(err error) {
+	s.backend.EXPECT().Ping().Return(err)
+}
+
+func (s *applicationSuite) expectBackendAvailable(c *gc.C) {
+	s.expectPing(nil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(coretesting.ModelConfig(c), nil)
+}
+
 func (s *applicationSuite) expectAddApplication() {
 	s.backend.EXPECT().AddApplication(gomock.Any(), s.objectStore).Return(s.application, nil)
 }
@@ -1323,6 +2000,16 @@ func (s *applicationSuite) expectCharm(c *gc.C, name string) {
 	s.applicationService.EXPECT().IsCharmAvailable(gomock.Any(), locator).Return(true, nil)
 }
 
+func (s *applicationSuite) expectCharmManifest(c *gc.C) {
+	s.charm.EXPECT().Manifest().Return(&internalcharm.Manifest{
+		Bases: []internalcharm.Base{{
+			Name:          "ubuntu",
+			Channel:       internalcharm.Channel{Track: "24.04"},
+			Architectures: []string{"amd64"},
+		}},
+	})
+}
+
 func (s *applicationSuite) expectCharmNotFound(c *gc.C, name string) {
 	locator := applicationcharm.CharmLocator{
 		Name:     name,
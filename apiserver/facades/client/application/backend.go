@@ -4,6 +4,11 @@
 package application
 
 import (
+	"strings"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/schema"
 
@@ -14,9 +19,12 @@ import (
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/relation"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/configschema"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/tools"
 	"github.com/juju/juju/state"
 )
 
@@ -24,6 +32,10 @@ import (
 // facade. For details on the methods, see the methods on state.State
 // with the same names.
 type Backend interface {
+	// Ping checks that the underlying state can be reached, returning an
+	// error if it can't.
+	Ping() error
+
 	Application(string) (Application, error)
 	ApplyOperation(state.ModelOperation) error
 	AddApplication(state.AddApplicationArgs, objectstore.ObjectStore) (Application, error)
@@ -38,9 +50,12 @@ type Backend interface {
 type Application interface {
 	AddUnit(state.AddUnitParams) (Unit, error)
 	AllUnits() ([]Unit, error)
+	AllUnitsAgentTools() (map[string]*tools.Tools, error)
+	CharmURLString() (string, error)
 	DestroyOperation(objectstore.ObjectStore) *state.DestroyApplicationOperation
 	EndpointBindings() (Bindings, error)
 	Endpoints() ([]relation.Endpoint, error)
+	ExportBindings(network.SpaceInfos) (map[string]string, error)
 	IsRemote() bool
 	SetCharm(state.SetCharmConfig, objectstore.ObjectStore) error
 	SetConstraints(constraints.Value) error
@@ -56,6 +71,17 @@ type Application interface {
 type Bindings interface {
 	Map() map[string]string
 	MapWithSpaceNames(network.SpaceInfos) (map[string]string, error)
+
+	// Validate checks that every bound endpoint exists in the charm, and
+	// that every space the bindings refer to is one of the known spaces.
+	// All problems found are returned together.
+	Validate(knownSpaces network.SpaceInfos, charmMeta CharmMeta) error
+}
+
+// Subnet defines a subset of the functionality provided by a subnet, as
+// required by the application facade.
+type Subnet interface {
+	CIDR() string
 }
 
 // Charm defines a subset of the functionality provided by the
@@ -85,6 +111,7 @@ type Machine interface {
 	Base() state.Base
 	Id() string
 	PublicAddress() (network.SpaceAddress, error)
+	PublicAddressInSpace(spaceID string) (network.SpaceAddress, error)
 }
 
 // Unit defines a subset of the functionality provided by the
@@ -94,10 +121,12 @@ type Machine interface {
 type Unit interface {
 	UnitTag() names.UnitTag
 	DestroyOperation(objectstore.ObjectStore) *state.DestroyUnitOperation
+	DestroyOperationWithForce(objectstore.ObjectStore, bool, time.Duration) *state.DestroyUnitOperation
 	IsPrincipal() bool
 
 	AssignUnit() error
 	AssignWithPlacement(*instance.Placement, network.SpaceInfos) error
+	AssignWithZone(string, network.SpaceInfos) error
 	ContainerInfo() (state.CloudContainer, error)
 }
 
@@ -224,8 +253,124 @@ func (a stateApplicationShim) AllUnits() ([]Unit, error) {
 	return out, nil
 }
 
+// CharmURLString returns the application's charm URL. An error satisfying
+// [errors.NotFound] is returned if the application has no charm URL, and an
+// error satisfying [errors.NotValid] if it doesn't parse.
+func (a stateApplicationShim) CharmURLString() (string, error) {
+	curl, _ := a.Application.CharmURL()
+	return charmURLString(a.Name(), curl)
+}
+
+// charmURLString validates and dereferences the charm URL of the named
+// application.
+func charmURLString(appName string, curl *string) (string, error) {
+	if curl == nil || *curl == "" {
+		return "", errors.NotFoundf("charm URL for application %q", appName)
+	}
+	if _, err := charm.ParseURL(*curl); err != nil {
+		return "", errors.NotValidf("charm URL %q for application %q", *curl, appName)
+	}
+	return *curl, nil
+}
+
 func (a stateApplicationShim) EndpointBindings() (Bindings, error) {
-	return a.Application.EndpointBindings()
+	bindings, err := a.Application.EndpointBindings()
+	if err != nil {
+		return nil, err
+	}
+	return stateBindingsShim{Bindings: bindings}, nil
+}
+
+// ExportBindings returns the application's endpoint bindings keyed by space
+// name, in the minimal form used when exporting a bundle.
+func (a stateApplicationShim) ExportBindings(lookup network.SpaceInfos) (map[string]string, error) {
+	bindings, err := a.Application.EndpointBindings()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	bindingsMap, err := bindings.MapWithSpaceNames(lookup)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return exportBindings(bindingsMap), nil
+}
+
+type stateBindingsShim struct {
+	*state.Bindings
+}
+
+// Validate checks that every bound endpoint exists in the charm, and that
+// every space the bindings refer to is one of the known spaces. Unknown
+// endpoints are reported with an error satisfying
+// [applicationerrors.CharmRelationNotFound], and unknown spaces with one
+// satisfying [applicationerrors.SpaceNotFound], as they are when the
+// bindings are inserted into the database.
+func (b stateBindingsShim) Validate(knownSpaces network.SpaceInfos, charmMeta CharmMeta) error {
+	if charmMeta == nil || charmMeta.Meta() == nil {
+		return errors.NotValidf("nil charm metadata")
+	}
+	return validateBindings(b.Map(), knownSpaces, charmMeta.Meta())
+}
+
+// validateBindings checks the supplied endpoint to space ID bindings against
+// the charm metadata and the known spaces, returning every problem found.
+// The default binding, keyed by the empty endpoint name, is not an endpoint
+// of the charm, but its space must still be known.
+func validateBindings(bindings map[string]string, knownSpaces network.SpaceInfos, meta *charm.Meta) error {
+	unknownEndpoints := set.NewStrings()
+	unknownSpaces := set.NewStrings()
+	relations := meta.CombinedRelations()
+	for endpoint, spaceID := range bindings {
+		if endpoint != "" {
+			_, isRelation := relations[endpoint]
+			_, isExtraBinding := meta.ExtraBindings[endpoint]
+			if !isRelation && !isExtraBinding {
+				unknownEndpoints.Add(endpoint)
+			}
+		}
+		if knownSpaces.GetByID(spaceID) == nil {
+			unknownSpaces.Add(spaceID)
+		}
+	}
+
+	var errs []error
+	if !unknownEndpoints.IsEmpty() {
+		errs = append(errs, internalerrors.
+			Errorf("charm relation(s) or extra binding %q not found", strings.Join(unknownEndpoints.SortedValues(), ",")).
+			Add(applicationerrors.CharmRelationNotFound))
+	}
+	if !unknownSpaces.IsEmpty() {
+		errs = append(errs, internalerrors.
+			Errorf("space(s) %q not found", strings.Join(unknownSpaces.SortedValues(), ",")).
+			Add(applicationerrors.SpaceNotFound))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internalerrors.Join(errs...)
+}
+
+// exportBindings returns a copy of the supplied endpoint to space name
+// bindings, without the bindings to the alpha space. Bundles treat alpha as
+// the default, so such bindings are redundant. The exception is an endpoint
+// explicitly bound to alpha when the application's default binding is
+// another space; dropping it would change the binding on import.
+func exportBindings(bindings map[string]string) map[string]string {
+	defaultSpace, ok := bindings[""]
+	if !ok {
+		defaultSpace = network.AlphaSpaceName
+	}
+	result := make(map[string]string)
+	for endpoint, space := range bindings {
+		if space != network.AlphaSpaceName {
+			result[endpoint] = space
+			continue
+		}
+		if endpoint != "" && defaultSpace != network.AlphaSpaceName {
+			result[endpoint] = space
+		}
+	}
+	return result
 }
 
 func (a stateApplicationShim) SetCharm(
@@ -235,6 +380,15 @@ func (a stateApplicationShim) SetCharm(
 	return a.Application.SetCharm(config, objStore)
 }
 
+// subnetInfoShim adapts a network.SubnetInfo to the Subnet interface.
+type subnetInfoShim struct {
+	info network.SubnetInfo
+}
+
+func (s subnetInfoShim) CIDR() string {
+	return s.info.CIDR
+}
+
 type stateMachineShim struct {
 	*state.Machine
 }
@@ -251,3 +405,13 @@ func (u stateUnitShim) AssignUnit() error {
 func (u stateUnitShim) AssignWithPlacement(placement *instance.Placement, allSpaces network.SpaceInfos) error {
 	return u.st.AssignUnitWithPlacement(u.Unit, placement, allSpaces)
 }
+
+// AssignWithZone assigns the unit to a new machine in the input availability
+// zone.
+func (u stateUnitShim) AssignWithZone(zone string, allSpaces network.SpaceInfos) error {
+	placement := &instance.Placement{
+		Scope:     u.st.ModelUUID(),
+		Directive: zonePlacementPrefix + zone,
+	}
+	return u.st.AssignUnitWithPlacement(u.Unit, placement, allSpaces)
+}
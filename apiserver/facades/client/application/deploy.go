@@ -6,6 +6,7 @@ package application
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
@@ -17,6 +18,7 @@ import (
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/instance"
 	corelogger "github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
@@ -283,6 +285,10 @@ func (api *APIBase) addUnits(
 			if err := unit.AssignUnit(); err != nil {
 				return nil, internalerrors.Errorf("acquiring new machine to host unit: %w", err)
 			}
+		} else if zone, ok := api.zonePlacement(placement[i]); ok {
+			if err := api.assignUnitWithZone(ctx, unit, zone, allSpaces); err != nil {
+				return nil, internalerrors.Errorf("acquiring machine in zone %q to host unit: %w", zone, err)
+			}
 		} else {
 			if err := unit.AssignWithPlacement(placement[i], allSpaces); err != nil {
 				return nil, internalerrors.Errorf("acquiring machine for placement %q to host unit: %w", placement[i], err)
@@ -293,6 +299,40 @@ func (api *APIBase) addUnits(
 	return units, nil
 }
 
+// zonePlacementPrefix is the prefix of a model scoped placement directive
+// that targets an availability zone.
+const zonePlacementPrefix = "zone="
+
+// zonePlacement returns the availability zone targeted by the input
+// placement, if it is a model scoped directive for a single zone.
+func (api *APIBase) zonePlacement(placement *instance.Placement) (string, bool) {
+	if placement == nil || placement.Scope != api.modelUUID.String() {
+		return "", false
+	}
+	zone, ok := strings.CutPrefix(placement.Directive, zonePlacementPrefix)
+	if !ok || zone == "" || strings.Contains(zone, ",") {
+		return "", false
+	}
+	return zone, true
+}
+
+// assignUnitWithZone assigns the unit to a new machine in the input
+// availability zone, after checking that the model's cloud supports
+// availability zones and that the zone is available.
+func (api *APIBase) assignUnitWithZone(ctx context.Context, unit Unit, zone string, allSpaces network.SpaceInfos) error {
+	zones, err := api.networkService.GetProviderAvailabilityZones(ctx)
+	if err != nil {
+		return internalerrors.Errorf("getting availability zones: %w", err)
+	}
+	if len(zones) == 0 {
+		return internalerrors.Errorf("availability zones %w for this cloud", coreerrors.NotSupported)
+	}
+	if err := zones.Validate(zone); err != nil {
+		return internalerrors.Capture(err)
+	}
+	return unit.AssignWithZone(zone, allSpaces)
+}
+
 func stateStorageDirectives(cons map[string]storage.Directive) map[string]state.StorageConstraints {
 	result := make(map[string]state.StorageConstraints)
 	for name, cons := range cons {
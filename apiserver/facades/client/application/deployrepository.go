@@ -271,6 +271,7 @@ type validatorConfig struct {
 	modelConfigService ModelConfigService
 	applicationService ApplicationService
 	machineService     MachineService
+	networkService     NetworkService
 	registry           storage.ProviderRegistry
 	state              DeployFromRepositoryState
 	storageService     StorageService
@@ -284,12 +285,17 @@ func makeDeployFromRepositoryValidator(ctx context.Context, cfg validatorConfig)
 		modelConfigService: cfg.modelConfigService,
 		applicationService: cfg.applicationService,
 		machineService:     cfg.machineService,
+		networkService:     cfg.networkService,
 		state:              cfg.state,
 		newCharmHubRepository: func(cfg repository.CharmHubRepositoryConfig) (corecharm.Repository, error) {
 			return repository.NewCharmHubRepository(cfg)
 		},
 		newStateBindings: func(st any, givenMap map[string]string) (Bindings, error) {
-			return state.NewBindings(st, givenMap)
+			bindings, err := state.NewBindings(st, givenMap)
+			if err != nil {
+				return nil, err
+			}
+			return stateBindingsShim{Bindings: bindings}, nil
 		},
 		logger: cfg.logger,
 	}
@@ -326,6 +332,7 @@ type deployFromRepositoryValidator struct {
 	modelConfigService ModelConfigService
 	applicationService ApplicationService
 	machineService     MachineService
+	networkService     NetworkService
 	state              DeployFromRepositoryState
 
 	// For testing using mocks.
@@ -395,11 +402,11 @@ func (v *deployFromRepositoryValidator) validate(ctx context.Context, arg params
 	dt.placement = arg.Placement
 	dt.storage = arg.Storage
 	if len(arg.EndpointBindings) > 0 {
-		bindings, err := v.newStateBindings(v.state, arg.EndpointBindings)
+		endpoints, err := v.resolveBindings(ctx, charmResult.Charm, arg.EndpointBindings)
 		if err != nil {
 			errs = append(errs, err)
 		} else {
-			dt.endpoints = bindings.Map()
+			dt.endpoints = endpoints
 		}
 	}
 
@@ -419,6 +426,24 @@ func (v *deployFromRepositoryValidator) validate(ctx context.Context, arg params
 	return dt, errs
 }
 
+// resolveBindings resolves the given endpoint bindings, checking them
+// against the charm's endpoints and the model's spaces. The resolved
+// bindings are returned keyed by endpoint name.
+func (v *deployFromRepositoryValidator) resolveBindings(ctx context.Context, resolvedCharm charm.Charm, givenMap map[string]string) (map[string]string, error) {
+	bindings, err := v.newStateBindings(v.state, givenMap)
+	if err != nil {
+		return nil, err
+	}
+	knownSpaces, err := v.networkService.GetAllSpaces(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := bindings.Validate(knownSpaces, resolvedCharm); err != nil {
+		return nil, err
+	}
+	return bindings.Map(), nil
+}
+
 func validateAndParseAttachStorage(input []string, numUnits int) ([]names.StorageTag, []error) {
 	// Parse storage tags in AttachStorage.
 	if len(input) > 0 && numUnits != 1 {
@@ -12,12 +12,15 @@ import (
 	gc "gopkg.in/check.v1"
 
 	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/network"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	applicationservice "github.com/juju/juju/domain/application/service"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charm/repository"
 	"github.com/juju/juju/internal/charm/resource"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
 )
 
 type deployRepositorySuite struct {
@@ -158,6 +161,62 @@ func (s *deployRepositorySuite) TestResolveResourcesWithResourcesErrorWhileCharm
 		gc.Commentf("(Assert) should return the same error as returned when resolving resources on charm repository"))
 }
 
+func (s *deployRepositorySuite) TestResolveBindings(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	ch := charm.NewCharmBase(&charm.Meta{
+		Provides: map[string]charm.Relation{"db": {Name: "db"}},
+	}, nil, nil, nil, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{
+		{ID: network.AlphaSpaceId, Name: network.AlphaSpaceName},
+		{ID: "1", Name: "db-space"},
+	}, nil)
+	validator := s.bindingsValidator()
+
+	// Act
+	endpoints, err := validator.resolveBindings(context.Background(), ch, map[string]string{"db": "1"})
+
+	// Assert
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(endpoints, gc.DeepEquals, map[string]string{"db": "1"})
+}
+
+func (s *deployRepositorySuite) TestResolveBindingsNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	ch := charm.NewCharmBase(&charm.Meta{
+		Provides: map[string]charm.Relation{"db": {Name: "db"}},
+	}, nil, nil, nil, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{
+		{ID: network.AlphaSpaceId, Name: network.AlphaSpaceName},
+	}, nil)
+	validator := s.bindingsValidator()
+
+	// Act
+	_, err := validator.resolveBindings(context.Background(), ch, map[string]string{"db": "1", "web": network.AlphaSpaceId})
+
+	// Assert
+	c.Check(err, jc.ErrorIs, applicationerrors.CharmRelationNotFound)
+	c.Check(err, jc.ErrorIs, applicationerrors.SpaceNotFound)
+}
+
+// bindingsValidator returns a deployFromRepositoryValidator which resolves
+// bindings given as space IDs, without a backing state.
+func (s *deployRepositorySuite) bindingsValidator() deployFromRepositoryValidator {
+	return deployFromRepositoryValidator{
+		networkService: s.networkService,
+		newStateBindings: func(st any, givenMap map[string]string) (Bindings, error) {
+			bindings, err := state.NewBindings(st, givenMap)
+			if err != nil {
+				return nil, err
+			}
+			return stateBindingsShim{Bindings: bindings}, nil
+		},
+	}
+}
+
 // expectValidator sets up a mock deployFromRepositoryValidator with predefined expectations for testing purposes.
 func (s *deployRepositorySuite) expectValidator() deployFromRepositoryValidator {
 	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(&config.Config{}, nil)
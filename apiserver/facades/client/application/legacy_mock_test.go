@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: Backend,Application,CaasBrokerInterface)
+// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: Backend,Application,Unit,Machine,Bindings,CaasBrokerInterface)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package application -destination legacy_mock_test.go github.com/juju/juju/apiserver/facades/client/application Backend,Application,CaasBrokerInterface
+//	mockgen -typed -package application -destination apiserver/facades/client/application/legacy_mock_test.go github.com/juju/juju/apiserver/facades/client/application Backend,Application,Unit,Machine,Bindings,CaasBrokerInterface
 //
 
 // Package application is a generated GoMock package.
@@ -12,14 +12,19 @@ package application
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	config "github.com/juju/juju/core/config"
 	constraints "github.com/juju/juju/core/constraints"
+	instance "github.com/juju/juju/core/instance"
+	network "github.com/juju/juju/core/network"
 	objectstore "github.com/juju/juju/core/objectstore"
 	relation "github.com/juju/juju/domain/relation"
 	charm "github.com/juju/juju/internal/charm"
 	configschema "github.com/juju/juju/internal/configschema"
+	tools "github.com/juju/juju/internal/tools"
 	state "github.com/juju/juju/state"
+	names "github.com/juju/names/v6"
 	schema "github.com/juju/schema"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -202,6 +207,44 @@ func (c *MockBackendMachineCall) DoAndReturn(f func(string) (Machine, error)) *M
 	return c
 }
 
+// Ping mocks base method.
+func (m *MockBackend) Ping() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockBackendMockRecorder) Ping() *MockBackendPingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockBackend)(nil).Ping))
+	return &MockBackendPingCall{Call: call}
+}
+
+// MockBackendPingCall wrap *gomock.Call
+type MockBackendPingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendPingCall) Return(arg0 error) *MockBackendPingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendPingCall) Do(f func() error) *MockBackendPingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendPingCall) DoAndReturn(f func() error) *MockBackendPingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Unit mocks base method.
 func (m *MockBackend) Unit(arg0 string) (Unit, error) {
 	m.ctrl.T.Helper()
@@ -342,6 +385,84 @@ func (c *MockApplicationAllUnitsCall) DoAndReturn(f func() ([]Unit, error)) *Moc
 	return c
 }
 
+// AllUnitsAgentTools mocks base method.
+func (m *MockApplication) AllUnitsAgentTools() (map[string]*tools.Tools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllUnitsAgentTools")
+	ret0, _ := ret[0].(map[string]*tools.Tools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllUnitsAgentTools indicates an expected call of AllUnitsAgentTools.
+func (mr *MockApplicationMockRecorder) AllUnitsAgentTools() *MockApplicationAllUnitsAgentToolsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllUnitsAgentTools", reflect.TypeOf((*MockApplication)(nil).AllUnitsAgentTools))
+	return &MockApplicationAllUnitsAgentToolsCall{Call: call}
+}
+
+// MockApplicationAllUnitsAgentToolsCall wrap *gomock.Call
+type MockApplicationAllUnitsAgentToolsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationAllUnitsAgentToolsCall) Return(arg0 map[string]*tools.Tools, arg1 error) *MockApplicationAllUnitsAgentToolsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationAllUnitsAgentToolsCall) Do(f func() (map[string]*tools.Tools, error)) *MockApplicationAllUnitsAgentToolsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationAllUnitsAgentToolsCall) DoAndReturn(f func() (map[string]*tools.Tools, error)) *MockApplicationAllUnitsAgentToolsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CharmURLString mocks base method.
+func (m *MockApplication) CharmURLString() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CharmURLString")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CharmURLString indicates an expected call of CharmURLString.
+func (mr *MockApplicationMockRecorder) CharmURLString() *MockApplicationCharmURLStringCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CharmURLString", reflect.TypeOf((*MockApplication)(nil).CharmURLString))
+	return &MockApplicationCharmURLStringCall{Call: call}
+}
+
+// MockApplicationCharmURLStringCall wrap *gomock.Call
+type MockApplicationCharmURLStringCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationCharmURLStringCall) Return(arg0 string, arg1 error) *MockApplicationCharmURLStringCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationCharmURLStringCall) Do(f func() (string, error)) *MockApplicationCharmURLStringCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationCharmURLStringCall) DoAndReturn(f func() (string, error)) *MockApplicationCharmURLStringCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DestroyOperation mocks base method.
 func (m *MockApplication) DestroyOperation(arg0 objectstore.ObjectStore) *state.DestroyApplicationOperation {
 	m.ctrl.T.Helper()
@@ -458,6 +579,45 @@ func (c *MockApplicationEndpointsCall) DoAndReturn(f func() ([]relation.Endpoint
 	return c
 }
 
+// ExportBindings mocks base method.
+func (m *MockApplication) ExportBindings(arg0 network.SpaceInfos) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBindings", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBindings indicates an expected call of ExportBindings.
+func (mr *MockApplicationMockRecorder) ExportBindings(arg0 any) *MockApplicationExportBindingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBindings", reflect.TypeOf((*MockApplication)(nil).ExportBindings), arg0)
+	return &MockApplicationExportBindingsCall{Call: call}
+}
+
+// MockApplicationExportBindingsCall wrap *gomock.Call
+type MockApplicationExportBindingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationExportBindingsCall) Return(arg0 map[string]string, arg1 error) *MockApplicationExportBindingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationExportBindingsCall) Do(f func(network.SpaceInfos) (map[string]string, error)) *MockApplicationExportBindingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationExportBindingsCall) DoAndReturn(f func(network.SpaceInfos) (map[string]string, error)) *MockApplicationExportBindingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsRemote mocks base method.
 func (m *MockApplication) IsRemote() bool {
 	m.ctrl.T.Helper()
@@ -686,6 +846,649 @@ func (c *MockApplicationUpdateCharmConfigCall) DoAndReturn(f func(charm.Settings
 	return c
 }
 
+// MockUnit is a mock of Unit interface.
+type MockUnit struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitMockRecorder
+}
+
+// MockUnitMockRecorder is the mock recorder for MockUnit.
+type MockUnitMockRecorder struct {
+	mock *MockUnit
+}
+
+// NewMockUnit creates a new mock instance.
+func NewMockUnit(ctrl *gomock.Controller) *MockUnit {
+	mock := &MockUnit{ctrl: ctrl}
+	mock.recorder = &MockUnitMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnit) EXPECT() *MockUnitMockRecorder {
+	return m.recorder
+}
+
+// AssignUnit mocks base method.
+func (m *MockUnit) AssignUnit() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignUnit")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignUnit indicates an expected call of AssignUnit.
+func (mr *MockUnitMockRecorder) AssignUnit() *MockUnitAssignUnitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignUnit", reflect.TypeOf((*MockUnit)(nil).AssignUnit))
+	return &MockUnitAssignUnitCall{Call: call}
+}
+
+// MockUnitAssignUnitCall wrap *gomock.Call
+type MockUnitAssignUnitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitAssignUnitCall) Return(arg0 error) *MockUnitAssignUnitCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitAssignUnitCall) Do(f func() error) *MockUnitAssignUnitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitAssignUnitCall) DoAndReturn(f func() error) *MockUnitAssignUnitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AssignWithPlacement mocks base method.
+func (m *MockUnit) AssignWithPlacement(arg0 *instance.Placement, arg1 network.SpaceInfos) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignWithPlacement", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignWithPlacement indicates an expected call of AssignWithPlacement.
+func (mr *MockUnitMockRecorder) AssignWithPlacement(arg0, arg1 any) *MockUnitAssignWithPlacementCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignWithPlacement", reflect.TypeOf((*MockUnit)(nil).AssignWithPlacement), arg0, arg1)
+	return &MockUnitAssignWithPlacementCall{Call: call}
+}
+
+// MockUnitAssignWithPlacementCall wrap *gomock.Call
+type MockUnitAssignWithPlacementCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitAssignWithPlacementCall) Return(arg0 error) *MockUnitAssignWithPlacementCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitAssignWithPlacementCall) Do(f func(*instance.Placement, network.SpaceInfos) error) *MockUnitAssignWithPlacementCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitAssignWithPlacementCall) DoAndReturn(f func(*instance.Placement, network.SpaceInfos) error) *MockUnitAssignWithPlacementCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AssignWithZone mocks base method.
+func (m *MockUnit) AssignWithZone(arg0 string, arg1 network.SpaceInfos) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignWithZone", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignWithZone indicates an expected call of AssignWithZone.
+func (mr *MockUnitMockRecorder) AssignWithZone(arg0, arg1 any) *MockUnitAssignWithZoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignWithZone", reflect.TypeOf((*MockUnit)(nil).AssignWithZone), arg0, arg1)
+	return &MockUnitAssignWithZoneCall{Call: call}
+}
+
+// MockUnitAssignWithZoneCall wrap *gomock.Call
+type MockUnitAssignWithZoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitAssignWithZoneCall) Return(arg0 error) *MockUnitAssignWithZoneCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitAssignWithZoneCall) Do(f func(string, network.SpaceInfos) error) *MockUnitAssignWithZoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitAssignWithZoneCall) DoAndReturn(f func(string, network.SpaceInfos) error) *MockUnitAssignWithZoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ContainerInfo mocks base method.
+func (m *MockUnit) ContainerInfo() (state.CloudContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerInfo")
+	ret0, _ := ret[0].(state.CloudContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerInfo indicates an expected call of ContainerInfo.
+func (mr *MockUnitMockRecorder) ContainerInfo() *MockUnitContainerInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerInfo", reflect.TypeOf((*MockUnit)(nil).ContainerInfo))
+	return &MockUnitContainerInfoCall{Call: call}
+}
+
+// MockUnitContainerInfoCall wrap *gomock.Call
+type MockUnitContainerInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitContainerInfoCall) Return(arg0 state.CloudContainer, arg1 error) *MockUnitContainerInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitContainerInfoCall) Do(f func() (state.CloudContainer, error)) *MockUnitContainerInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitContainerInfoCall) DoAndReturn(f func() (state.CloudContainer, error)) *MockUnitContainerInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DestroyOperation mocks base method.
+func (m *MockUnit) DestroyOperation(arg0 objectstore.ObjectStore) *state.DestroyUnitOperation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyOperation", arg0)
+	ret0, _ := ret[0].(*state.DestroyUnitOperation)
+	return ret0
+}
+
+// DestroyOperation indicates an expected call of DestroyOperation.
+func (mr *MockUnitMockRecorder) DestroyOperation(arg0 any) *MockUnitDestroyOperationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyOperation", reflect.TypeOf((*MockUnit)(nil).DestroyOperation), arg0)
+	return &MockUnitDestroyOperationCall{Call: call}
+}
+
+// MockUnitDestroyOperationCall wrap *gomock.Call
+type MockUnitDestroyOperationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitDestroyOperationCall) Return(arg0 *state.DestroyUnitOperation) *MockUnitDestroyOperationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitDestroyOperationCall) Do(f func(objectstore.ObjectStore) *state.DestroyUnitOperation) *MockUnitDestroyOperationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitDestroyOperationCall) DoAndReturn(f func(objectstore.ObjectStore) *state.DestroyUnitOperation) *MockUnitDestroyOperationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DestroyOperationWithForce mocks base method.
+func (m *MockUnit) DestroyOperationWithForce(arg0 objectstore.ObjectStore, arg1 bool, arg2 time.Duration) *state.DestroyUnitOperation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyOperationWithForce", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*state.DestroyUnitOperation)
+	return ret0
+}
+
+// DestroyOperationWithForce indicates an expected call of DestroyOperationWithForce.
+func (mr *MockUnitMockRecorder) DestroyOperationWithForce(arg0, arg1, arg2 any) *MockUnitDestroyOperationWithForceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyOperationWithForce", reflect.TypeOf((*MockUnit)(nil).DestroyOperationWithForce), arg0, arg1, arg2)
+	return &MockUnitDestroyOperationWithForceCall{Call: call}
+}
+
+// MockUnitDestroyOperationWithForceCall wrap *gomock.Call
+type MockUnitDestroyOperationWithForceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitDestroyOperationWithForceCall) Return(arg0 *state.DestroyUnitOperation) *MockUnitDestroyOperationWithForceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitDestroyOperationWithForceCall) Do(f func(objectstore.ObjectStore, bool, time.Duration) *state.DestroyUnitOperation) *MockUnitDestroyOperationWithForceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitDestroyOperationWithForceCall) DoAndReturn(f func(objectstore.ObjectStore, bool, time.Duration) *state.DestroyUnitOperation) *MockUnitDestroyOperationWithForceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsPrincipal mocks base method.
+func (m *MockUnit) IsPrincipal() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrincipal")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPrincipal indicates an expected call of IsPrincipal.
+func (mr *MockUnitMockRecorder) IsPrincipal() *MockUnitIsPrincipalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrincipal", reflect.TypeOf((*MockUnit)(nil).IsPrincipal))
+	return &MockUnitIsPrincipalCall{Call: call}
+}
+
+// MockUnitIsPrincipalCall wrap *gomock.Call
+type MockUnitIsPrincipalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitIsPrincipalCall) Return(arg0 bool) *MockUnitIsPrincipalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitIsPrincipalCall) Do(f func() bool) *MockUnitIsPrincipalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitIsPrincipalCall) DoAndReturn(f func() bool) *MockUnitIsPrincipalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnitTag mocks base method.
+func (m *MockUnit) UnitTag() names.UnitTag {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnitTag")
+	ret0, _ := ret[0].(names.UnitTag)
+	return ret0
+}
+
+// UnitTag indicates an expected call of UnitTag.
+func (mr *MockUnitMockRecorder) UnitTag() *MockUnitUnitTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnitTag", reflect.TypeOf((*MockUnit)(nil).UnitTag))
+	return &MockUnitUnitTagCall{Call: call}
+}
+
+// MockUnitUnitTagCall wrap *gomock.Call
+type MockUnitUnitTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitUnitTagCall) Return(arg0 names.UnitTag) *MockUnitUnitTagCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitUnitTagCall) Do(f func() names.UnitTag) *MockUnitUnitTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitUnitTagCall) DoAndReturn(f func() names.UnitTag) *MockUnitUnitTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockMachine is a mock of Machine interface.
+type MockMachine struct {
+	ctrl     *gomock.Controller
+	recorder *MockMachineMockRecorder
+}
+
+// MockMachineMockRecorder is the mock recorder for MockMachine.
+type MockMachineMockRecorder struct {
+	mock *MockMachine
+}
+
+// NewMockMachine creates a new mock instance.
+func NewMockMachine(ctrl *gomock.Controller) *MockMachine {
+	mock := &MockMachine{ctrl: ctrl}
+	mock.recorder = &MockMachineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMachine) EXPECT() *MockMachineMockRecorder {
+	return m.recorder
+}
+
+// Base mocks base method.
+func (m *MockMachine) Base() state.Base {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Base")
+	ret0, _ := ret[0].(state.Base)
+	return ret0
+}
+
+// Base indicates an expected call of Base.
+func (mr *MockMachineMockRecorder) Base() *MockMachineBaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Base", reflect.TypeOf((*MockMachine)(nil).Base))
+	return &MockMachineBaseCall{Call: call}
+}
+
+// MockMachineBaseCall wrap *gomock.Call
+type MockMachineBaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineBaseCall) Return(arg0 state.Base) *MockMachineBaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineBaseCall) Do(f func() state.Base) *MockMachineBaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineBaseCall) DoAndReturn(f func() state.Base) *MockMachineBaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Id mocks base method.
+func (m *MockMachine) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockMachineMockRecorder) Id() *MockMachineIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockMachine)(nil).Id))
+	return &MockMachineIdCall{Call: call}
+}
+
+// MockMachineIdCall wrap *gomock.Call
+type MockMachineIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineIdCall) Return(arg0 string) *MockMachineIdCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineIdCall) Do(f func() string) *MockMachineIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineIdCall) DoAndReturn(f func() string) *MockMachineIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PublicAddress mocks base method.
+func (m *MockMachine) PublicAddress() (network.SpaceAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicAddress")
+	ret0, _ := ret[0].(network.SpaceAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicAddress indicates an expected call of PublicAddress.
+func (mr *MockMachineMockRecorder) PublicAddress() *MockMachinePublicAddressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicAddress", reflect.TypeOf((*MockMachine)(nil).PublicAddress))
+	return &MockMachinePublicAddressCall{Call: call}
+}
+
+// MockMachinePublicAddressCall wrap *gomock.Call
+type MockMachinePublicAddressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachinePublicAddressCall) Return(arg0 network.SpaceAddress, arg1 error) *MockMachinePublicAddressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachinePublicAddressCall) Do(f func() (network.SpaceAddress, error)) *MockMachinePublicAddressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachinePublicAddressCall) DoAndReturn(f func() (network.SpaceAddress, error)) *MockMachinePublicAddressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PublicAddressInSpace mocks base method.
+func (m *MockMachine) PublicAddressInSpace(arg0 string) (network.SpaceAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicAddressInSpace", arg0)
+	ret0, _ := ret[0].(network.SpaceAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicAddressInSpace indicates an expected call of PublicAddressInSpace.
+func (mr *MockMachineMockRecorder) PublicAddressInSpace(arg0 any) *MockMachinePublicAddressInSpaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicAddressInSpace", reflect.TypeOf((*MockMachine)(nil).PublicAddressInSpace), arg0)
+	return &MockMachinePublicAddressInSpaceCall{Call: call}
+}
+
+// MockMachinePublicAddressInSpaceCall wrap *gomock.Call
+type MockMachinePublicAddressInSpaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachinePublicAddressInSpaceCall) Return(arg0 network.SpaceAddress, arg1 error) *MockMachinePublicAddressInSpaceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachinePublicAddressInSpaceCall) Do(f func(string) (network.SpaceAddress, error)) *MockMachinePublicAddressInSpaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachinePublicAddressInSpaceCall) DoAndReturn(f func(string) (network.SpaceAddress, error)) *MockMachinePublicAddressInSpaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockBindings is a mock of Bindings interface.
+type MockBindings struct {
+	ctrl     *gomock.Controller
+	recorder *MockBindingsMockRecorder
+}
+
+// MockBindingsMockRecorder is the mock recorder for MockBindings.
+type MockBindingsMockRecorder struct {
+	mock *MockBindings
+}
+
+// NewMockBindings creates a new mock instance.
+func NewMockBindings(ctrl *gomock.Controller) *MockBindings {
+	mock := &MockBindings{ctrl: ctrl}
+	mock.recorder = &MockBindingsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBindings) EXPECT() *MockBindingsMockRecorder {
+	return m.recorder
+}
+
+// Map mocks base method.
+func (m *MockBindings) Map() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Map")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Map indicates an expected call of Map.
+func (mr *MockBindingsMockRecorder) Map() *MockBindingsMapCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockBindings)(nil).Map))
+	return &MockBindingsMapCall{Call: call}
+}
+
+// MockBindingsMapCall wrap *gomock.Call
+type MockBindingsMapCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBindingsMapCall) Return(arg0 map[string]string) *MockBindingsMapCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBindingsMapCall) Do(f func() map[string]string) *MockBindingsMapCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBindingsMapCall) DoAndReturn(f func() map[string]string) *MockBindingsMapCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MapWithSpaceNames mocks base method.
+func (m *MockBindings) MapWithSpaceNames(arg0 network.SpaceInfos) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MapWithSpaceNames", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MapWithSpaceNames indicates an expected call of MapWithSpaceNames.
+func (mr *MockBindingsMockRecorder) MapWithSpaceNames(arg0 any) *MockBindingsMapWithSpaceNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapWithSpaceNames", reflect.TypeOf((*MockBindings)(nil).MapWithSpaceNames), arg0)
+	return &MockBindingsMapWithSpaceNamesCall{Call: call}
+}
+
+// MockBindingsMapWithSpaceNamesCall wrap *gomock.Call
+type MockBindingsMapWithSpaceNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBindingsMapWithSpaceNamesCall) Return(arg0 map[string]string, arg1 error) *MockBindingsMapWithSpaceNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBindingsMapWithSpaceNamesCall) Do(f func(network.SpaceInfos) (map[string]string, error)) *MockBindingsMapWithSpaceNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBindingsMapWithSpaceNamesCall) DoAndReturn(f func(network.SpaceInfos) (map[string]string, error)) *MockBindingsMapWithSpaceNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Validate mocks base method.
+func (m *MockBindings) Validate(arg0 network.SpaceInfos, arg1 CharmMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockBindingsMockRecorder) Validate(arg0, arg1 any) *MockBindingsValidateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockBindings)(nil).Validate), arg0, arg1)
+	return &MockBindingsValidateCall{Call: call}
+}
+
+// MockBindingsValidateCall wrap *gomock.Call
+type MockBindingsValidateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBindingsValidateCall) Return(arg0 error) *MockBindingsValidateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBindingsValidateCall) Do(f func(network.SpaceInfos, CharmMeta) error) *MockBindingsValidateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBindingsValidateCall) DoAndReturn(f func(network.SpaceInfos, CharmMeta) error) *MockBindingsValidateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockCaasBrokerInterface is a mock of CaasBrokerInterface interface.
 type MockCaasBrokerInterface struct {
 	ctrl     *gomock.Controller
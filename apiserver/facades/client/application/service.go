@@ -106,6 +106,9 @@ type NetworkService interface {
 	SpaceByName(ctx context.Context, name string) (*network.SpaceInfo, error)
 	// GetAllSpaces returns all spaces for the model.
 	GetAllSpaces(ctx context.Context) (network.SpaceInfos, error)
+	// GetProviderAvailabilityZones returns all the availability zones
+	// retrieved from the model's cloud provider.
+	GetProviderAvailabilityZones(ctx context.Context) (network.AvailabilityZones, error)
 }
 
 // MachineService defines the methods that the facade assumes from the Machine
@@ -169,6 +172,13 @@ type ApplicationService interface {
 	// - [applicationerrors.ApplicationNotFound] if the application does not exist
 	GetUnitNamesForApplication(context.Context, string) ([]unit.Name, error)
 
+	// GetApplicationsBoundToSpace returns the sorted names of the
+	// applications with an endpoint bound to the named space, either
+	// explicitly or through the application's default space.
+	// The following errors may be returned:
+	// - [applicationerrors.SpaceNotFound] if the space does not exist
+	GetApplicationsBoundToSpace(ctx context.Context, spaceName network.SpaceName) ([]string, error)
+
 	// GetSupportedFeatures returns the set of features that the model makes
 	// available for charms to use.
 	GetSupportedFeatures(context.Context) (assumes.FeatureSet, error)
@@ -238,6 +248,15 @@ type ApplicationService interface {
 	// [applicationerrors.ApplicationNotFound] is returned.
 	SetApplicationConstraints(ctx context.Context, appID coreapplication.ID, cons constraints.Value) error
 
+	// MergeApplicationConstraints merges the given constraints over the
+	// existing constraints of the specified application ID, and sets the
+	// result. The merged constraints are returned.
+	// If the merged constraints are invalid (e.g. an instance type unknown to
+	// the provider), an error is returned.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	MergeApplicationConstraints(ctx context.Context, appID coreapplication.ID, delta constraints.Value) (constraints.Value, error)
+
 	// UpdateApplicationConfig updates the application config with the specified
 	// values. If the key does not exist, it is created. If the key already exists,
 	// it is updated, if there is no value it is removed. With the caveat that
@@ -295,6 +314,11 @@ type ResolveService interface {
 	// satisfying [resolveerrors.UnitNotFound] is returned.
 	ResolveUnit(context.Context, unit.Name, resolve.ResolveMode) error
 
+	// ResolveUnits marks each of the given units as resolved, continuing past
+	// individual failures. The returned slice holds the error, if any, from
+	// resolving the unit at the same index.
+	ResolveUnits(context.Context, []unit.Name, resolve.ResolveMode) ([]error, error)
+
 	// ResolveAllUnits marks all units as resolved.
 	ResolveAllUnits(context.Context, resolve.ResolveMode) error
 }
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService
+//	mockgen -typed -package application -destination apiserver/facades/client/application/services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService
 //
 
 // Package application is a generated GoMock package.
@@ -104,6 +104,45 @@ func (c *MockNetworkServiceGetAllSpacesCall) DoAndReturn(f func(context.Context)
 	return c
 }
 
+// GetProviderAvailabilityZones mocks base method.
+func (m *MockNetworkService) GetProviderAvailabilityZones(arg0 context.Context) (network.AvailabilityZones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProviderAvailabilityZones", arg0)
+	ret0, _ := ret[0].(network.AvailabilityZones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProviderAvailabilityZones indicates an expected call of GetProviderAvailabilityZones.
+func (mr *MockNetworkServiceMockRecorder) GetProviderAvailabilityZones(arg0 any) *MockNetworkServiceGetProviderAvailabilityZonesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderAvailabilityZones", reflect.TypeOf((*MockNetworkService)(nil).GetProviderAvailabilityZones), arg0)
+	return &MockNetworkServiceGetProviderAvailabilityZonesCall{Call: call}
+}
+
+// MockNetworkServiceGetProviderAvailabilityZonesCall wrap *gomock.Call
+type MockNetworkServiceGetProviderAvailabilityZonesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetProviderAvailabilityZonesCall) Return(arg0 network.AvailabilityZones, arg1 error) *MockNetworkServiceGetProviderAvailabilityZonesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetProviderAvailabilityZonesCall) Do(f func(context.Context) (network.AvailabilityZones, error)) *MockNetworkServiceGetProviderAvailabilityZonesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetProviderAvailabilityZonesCall) DoAndReturn(f func(context.Context) (network.AvailabilityZones, error)) *MockNetworkServiceGetProviderAvailabilityZonesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Space mocks base method.
 func (m *MockNetworkService) Space(arg0 context.Context, arg1 string) (*network.SpaceInfo, error) {
 	m.ctrl.T.Helper()
@@ -1181,6 +1220,45 @@ func (c *MockApplicationServiceGetApplicationLifeCall) DoAndReturn(f func(contex
 	return c
 }
 
+// GetApplicationsBoundToSpace mocks base method.
+func (m *MockApplicationService) GetApplicationsBoundToSpace(arg0 context.Context, arg1 network.SpaceName) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationsBoundToSpace", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationsBoundToSpace indicates an expected call of GetApplicationsBoundToSpace.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationsBoundToSpace(arg0, arg1 any) *MockApplicationServiceGetApplicationsBoundToSpaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsBoundToSpace", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationsBoundToSpace), arg0, arg1)
+	return &MockApplicationServiceGetApplicationsBoundToSpaceCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationsBoundToSpaceCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationsBoundToSpaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationsBoundToSpaceCall) Return(arg0 []string, arg1 error) *MockApplicationServiceGetApplicationsBoundToSpaceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationsBoundToSpaceCall) Do(f func(context.Context, network.SpaceName) ([]string, error)) *MockApplicationServiceGetApplicationsBoundToSpaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationsBoundToSpaceCall) DoAndReturn(f func(context.Context, network.SpaceName) ([]string, error)) *MockApplicationServiceGetApplicationsBoundToSpaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharm mocks base method.
 func (m *MockApplicationService) GetCharm(arg0 context.Context, arg1 charm0.CharmLocator) (charm1.Charm, charm0.CharmLocator, bool, error) {
 	m.ctrl.T.Helper()
@@ -1768,6 +1846,45 @@ func (c *MockApplicationServiceIsSubordinateApplicationCall) DoAndReturn(f func(
 	return c
 }
 
+// MergeApplicationConstraints mocks base method.
+func (m *MockApplicationService) MergeApplicationConstraints(arg0 context.Context, arg1 application.ID, arg2 constraints.Value) (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeApplicationConstraints", arg0, arg1, arg2)
+	ret0, _ := ret[0].(constraints.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeApplicationConstraints indicates an expected call of MergeApplicationConstraints.
+func (mr *MockApplicationServiceMockRecorder) MergeApplicationConstraints(arg0, arg1, arg2 any) *MockApplicationServiceMergeApplicationConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeApplicationConstraints", reflect.TypeOf((*MockApplicationService)(nil).MergeApplicationConstraints), arg0, arg1, arg2)
+	return &MockApplicationServiceMergeApplicationConstraintsCall{Call: call}
+}
+
+// MockApplicationServiceMergeApplicationConstraintsCall wrap *gomock.Call
+type MockApplicationServiceMergeApplicationConstraintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceMergeApplicationConstraintsCall) Return(arg0 constraints.Value, arg1 error) *MockApplicationServiceMergeApplicationConstraintsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceMergeApplicationConstraintsCall) Do(f func(context.Context, application.ID, constraints.Value) (constraints.Value, error)) *MockApplicationServiceMergeApplicationConstraintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceMergeApplicationConstraintsCall) DoAndReturn(f func(context.Context, application.ID, constraints.Value) (constraints.Value, error)) *MockApplicationServiceMergeApplicationConstraintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MergeExposeSettings mocks base method.
 func (m *MockApplicationService) MergeExposeSettings(arg0 context.Context, arg1 string, arg2 map[string]application0.ExposedEndpoint) error {
 	m.ctrl.T.Helper()
@@ -2095,6 +2212,45 @@ func (c *MockResolveServiceResolveUnitCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// ResolveUnits mocks base method.
+func (m *MockResolveService) ResolveUnits(arg0 context.Context, arg1 []unit.Name, arg2 resolve.ResolveMode) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveUnits", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveUnits indicates an expected call of ResolveUnits.
+func (mr *MockResolveServiceMockRecorder) ResolveUnits(arg0, arg1, arg2 any) *MockResolveServiceResolveUnitsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveUnits", reflect.TypeOf((*MockResolveService)(nil).ResolveUnits), arg0, arg1, arg2)
+	return &MockResolveServiceResolveUnitsCall{Call: call}
+}
+
+// MockResolveServiceResolveUnitsCall wrap *gomock.Call
+type MockResolveServiceResolveUnitsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResolveServiceResolveUnitsCall) Return(arg0 []error, arg1 error) *MockResolveServiceResolveUnitsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResolveServiceResolveUnitsCall) Do(f func(context.Context, []unit.Name, resolve.ResolveMode) ([]error, error)) *MockResolveServiceResolveUnitsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResolveServiceResolveUnitsCall) DoAndReturn(f func(context.Context, []unit.Name, resolve.ResolveMode) ([]error, error)) *MockResolveServiceResolveUnitsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockPortService is a mock of PortService interface.
 type MockPortService struct {
 	ctrl     *gomock.Controller
@@ -11,6 +11,8 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/authentication"
+	commonmodel "github.com/juju/juju/apiserver/common/model"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/permission"
@@ -20,6 +22,11 @@ import (
 
 var logger = internallogger.GetLogger("juju.apiserver.client")
 
+// ClientV8 serves the client-specific API methods of version 8.
+type ClientV8 struct {
+	*Client
+}
+
 // Client serves client-specific API methods.
 type Client struct {
 	controllerTag names.ControllerTag
@@ -44,16 +51,14 @@ type Client struct {
 }
 
 func (c *Client) checkCanRead(ctx context.Context) error {
-	err := c.auth.HasPermission(ctx, permission.SuperuserAccess, c.controllerTag)
-	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+	canRead, err := commonmodel.HasModelRead(ctx, c.auth, c.controllerTag, c.modelTag)
+	if err != nil {
 		return errors.Trace(err)
 	}
-
-	if err == nil {
-		return nil
+	if !canRead {
+		return apiservererrors.ErrPerm
 	}
-
-	return c.auth.HasPermission(ctx, permission.ReadAccess, c.modelTag)
+	return nil
 }
 
 func (c *Client) checkIsAdmin(ctx context.Context) error {
@@ -19,11 +19,23 @@ import (
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Client", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV8(ctx)
+	}, reflect.TypeOf((*ClientV8)(nil)))
+	registry.MustRegister("Client", 9, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV9(ctx) // Add StatusSummary
 	}, reflect.TypeOf((*Client)(nil)))
 }
 
 // newFacadeV8 returns a new Client facade (v8).
-func newFacadeV8(ctx facade.ModelContext) (*Client, error) {
+func newFacadeV8(ctx facade.ModelContext) (*ClientV8, error) {
+	client, err := newFacadeV9(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ClientV8{Client: client}, nil
+}
+
+// newFacadeV9 returns a new Client facade (v9).
+func newFacadeV9(ctx facade.ModelContext) (*Client, error) {
 	authorizer := ctx.Auth()
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/domain/port"
 	domainrelation "github.com/juju/juju/domain/relation"
 	statusservice "github.com/juju/juju/domain/status/service"
+	internalcharm "github.com/juju/juju/internal/charm"
 )
 
 // ApplicationService defines the methods that the facade assumes from the
@@ -43,6 +44,13 @@ type ApplicationService interface {
 	// If no application is found, an error satisfying
 	// [applicationerrors.ApplicationNotFound] is returned.
 	GetExposedEndpoints(ctx context.Context, appName string) (map[string]application.ExposedEndpoint, error)
+
+	// GetCharmLXDProfile returns the LXD profile along with the revision of the
+	// charm using the charm name, source and revision.
+	//
+	// If the charm does not exist, a [applicationerrors.CharmNotFound] error is
+	// returned.
+	GetCharmLXDProfile(ctx context.Context, locator charm.CharmLocator) (internalcharm.LXDProfile, charm.Revision, error)
 }
 
 // StatusService defines the methods that the facade assumes from the Status
@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/lxdprofile"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
@@ -157,7 +158,7 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		return noStatus, internalerrors.Errorf("could not load model status values: %w", err)
 	}
 	if context.allAppsUnitsCharmBindings, err =
-		fetchAllApplicationsAndUnits(ctx, c.statusService, c.applicationService, c.stateAccessor, context.spaceInfos); err != nil {
+		fetchAllApplicationsAndUnits(ctx, c.statusService, c.applicationService, c.stateAccessor, context.model.Name, context.spaceInfos); err != nil {
 		return noStatus, internalerrors.Errorf("could not fetch applications and units: %w", err)
 	}
 	if context.consumerRemoteApplications, err =
@@ -281,6 +282,50 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 	}, nil
 }
 
+// StatusSummary isn't implemented in the ClientV8 facade.
+func (*ClientV8) StatusSummary(_, _ struct{}) {}
+
+// StatusSummary returns the number of applications and units in the model
+// in each status, so that clients can summarise the status of large models
+// without fetching and aggregating the full status.
+func (c *Client) StatusSummary(ctx context.Context) (params.StatusSummary, error) {
+	if err := c.checkCanRead(ctx); err != nil {
+		return params.StatusSummary{}, err
+	}
+
+	applications, err := c.statusService.GetApplicationAndUnitStatuses(ctx)
+	if err != nil {
+		return params.StatusSummary{}, internalerrors.Errorf("could not fetch applications and units: %w", err)
+	}
+
+	var summary params.StatusSummary
+	for _, application := range applications {
+		countStatus(&summary.Applications, application.Status.Status)
+		for _, unit := range application.Units {
+			countStatus(&summary.Units, unit.WorkloadStatus.Status)
+		}
+	}
+	return summary, nil
+}
+
+// countStatus adds an entity in the given status to the counts.
+func countStatus(counts *params.StatusCounts, s status.Status) {
+	switch s {
+	case status.Active:
+		counts.Active++
+	case status.Blocked:
+		counts.Blocked++
+	case status.Waiting:
+		counts.Waiting++
+	case status.Error:
+		counts.Error++
+	case status.Maintenance:
+		counts.Maintenance++
+	default:
+		counts.Other++
+	}
+}
+
 // modelStatus returns the status of the current model.
 func (c *Client) modelStatus(ctx context.Context) (params.ModelStatusInfo, error) {
 	var info params.ModelStatusInfo
@@ -343,6 +388,58 @@ type applicationStatusInfo struct {
 	lxdProfiles map[string]*charm.LXDProfile
 }
 
+// lxdProfile returns the named charm LXD profile. Profiles of the current
+// charms of applications are already known; a profile for any other revision
+// of an application's charm is looked up and remembered.
+func (s applicationStatusInfo) lxdProfile(
+	ctx context.Context, applicationService ApplicationService, name string,
+) (*charm.LXDProfile, bool) {
+	if profile, ok := s.lxdProfiles[name]; ok {
+		return profile, true
+	}
+	_, appName, revision, ok := lxdprofile.ParseName(name)
+	if !ok {
+		return nil, false
+	}
+	app, ok := s.applications[appName]
+	if !ok {
+		return nil, false
+	}
+	locator := app.CharmLocator
+	locator.Revision = revision
+	profile, _, err := applicationService.GetCharmLXDProfile(ctx, locator)
+	if err != nil {
+		logger.Debugf(ctx, "error fetching lxd profile %q: %v", name, err)
+		return nil, false
+	}
+	s.lxdProfiles[name] = &profile
+	return &profile, true
+}
+
+// lxdProfileConfigDrift returns the config drift of the named applied
+// profile from the profile expected for its application's current charm.
+// Nil is returned if the applied profile is the expected one, or the
+// expected profile is unknown.
+func (s applicationStatusInfo) lxdProfileConfigDrift(name string, applied *charm.LXDProfile) map[string]string {
+	_, appName, _, ok := lxdprofile.ParseName(name)
+	if !ok {
+		return nil
+	}
+	app, ok := s.applications[appName]
+	if !ok {
+		return nil
+	}
+	expectedName, err := lxdprofile.ProfileReplaceRevision(name, app.CharmLocator.Revision)
+	if err != nil || expectedName == name {
+		return nil
+	}
+	expected, ok := s.lxdProfiles[expectedName]
+	if !ok {
+		return nil
+	}
+	return lxdprofile.ConfigDrift(applied.Config, expected.Config)
+}
+
 type relationStatus struct {
 	ID        int
 	Key       corerelation.Key
@@ -589,7 +686,14 @@ func fetchNetworkInterfaces(st Backend, subnetInfos network.SubnetInfos, spaceIn
 
 // fetchAllApplicationsAndUnits returns a map from application name to application,
 // a map from application name to unit name to unit, and a map from base charm URL to latest URL.
-func fetchAllApplicationsAndUnits(ctx context.Context, statusService StatusService, applicationService ApplicationService, st Backend, spaceInfos network.SpaceInfos) (applicationStatusInfo, error) {
+func fetchAllApplicationsAndUnits(
+	ctx context.Context,
+	statusService StatusService,
+	applicationService ApplicationService,
+	st Backend,
+	modelName string,
+	spaceInfos network.SpaceInfos,
+) (applicationStatusInfo, error) {
 	var (
 		apps         = make(map[string]statusservice.Application)
 		appCharmURL  = make(map[string]string)
@@ -636,6 +740,10 @@ func fetchAllApplicationsAndUnits(ctx context.Context, statusService StatusServi
 			continue
 		}
 
+		if app.LXDProfile != nil && !app.LXDProfile.Empty() {
+			lxdProfiles[lxdprofile.Name(modelName, name, app.CharmLocator.Revision)] = app.LXDProfile
+		}
+
 		// De-duplicate charms with the same name and architecture.
 		// Don't look up revision for local charms
 		if applicationcharm.CharmHubSource == app.CharmLocator.Source {
@@ -916,28 +1024,42 @@ func (c *statusContext) makeMachineStatus(
 	}
 	status.Containers = make(map[string]params.MachineStatus)
 
+	status.LXDProfiles = c.machineLXDProfiles(ctx, machineService, machineUUID, appStatusInfo)
+
+	return
+}
+
+// machineLXDProfiles returns the charm LXD profiles applied to the machine.
+// A profile applied for an older revision of its application's charm reports
+// how its config has drifted from the profile of the current charm.
+func (c *statusContext) machineLXDProfiles(
+	ctx context.Context,
+	machineService MachineService,
+	machineUUID coremachine.UUID,
+	appStatusInfo applicationStatusInfo,
+) map[string]params.LXDProfile {
 	lxdProfiles := make(map[string]params.LXDProfile)
 	charmProfiles, err := machineService.AppliedLXDProfileNames(ctx, machineUUID)
 	if internalerrors.Is(err, machineerrors.NotProvisioned) {
 		logger.Debugf(ctx, "can't retrieve lxd profiles for machine %q: not provisioned", machineUUID)
 	}
 	if err != nil {
-		logger.Debugf(ctx, "error fetching lxd profiles: %w", err)
+		logger.Debugf(ctx, "error fetching lxd profiles: %v", err)
 	}
 
 	for _, v := range charmProfiles {
-		if profile, ok := appStatusInfo.lxdProfiles[v]; ok {
-			lxdProfiles[v] = params.LXDProfile{
-				Config:      profile.Config,
-				Description: profile.Description,
-				Devices:     profile.Devices,
-			}
+		profile, ok := appStatusInfo.lxdProfile(ctx, c.applicationService, v)
+		if !ok {
+			continue
+		}
+		lxdProfiles[v] = params.LXDProfile{
+			Config:      profile.Config,
+			Description: profile.Description,
+			Devices:     profile.Devices,
+			ConfigDrift: appStatusInfo.lxdProfileConfigDrift(v, profile),
 		}
 	}
-
-	status.LXDProfiles = lxdProfiles
-
-	return
+	return lxdProfiles
 }
 
 func (c *statusContext) processRelations(ctx context.Context) []params.RelationStatus {
@@ -14,14 +14,18 @@ import (
 	gomock "go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	machinetesting "github.com/juju/juju/core/machine/testing"
 	"github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	permission "github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/unit"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	domainmodel "github.com/juju/juju/domain/model"
 	domainmodelerrors "github.com/juju/juju/domain/model/errors"
 	statusservice "github.com/juju/juju/domain/status/service"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -33,6 +37,9 @@ type statusSuite struct {
 	authorizer       *MockAuthorizer
 	modelInfoService *MockModelInfoService
 	statusService    *MockStatusService
+
+	applicationService *MockApplicationService
+	machineService     *MockMachineService
 }
 
 var _ = gc.Suite(&statusSuite{})
@@ -240,11 +247,161 @@ func (s *statusSuite) TestStatusHistoryError(c *gc.C) {
 	}})
 }
 
+func (s *statusSuite) TestStatusSummary(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"foo": {
+			Status: status.StatusInfo{Status: status.Active},
+			Units: map[unit.Name]statusservice.Unit{
+				"foo/0": {WorkloadStatus: status.StatusInfo{Status: status.Active}},
+				"foo/1": {WorkloadStatus: status.StatusInfo{Status: status.Maintenance}},
+				"foo/2": {WorkloadStatus: status.StatusInfo{Status: status.Error}},
+			},
+		},
+		"bar": {
+			Status: status.StatusInfo{Status: status.Blocked},
+			Units: map[unit.Name]statusservice.Unit{
+				"bar/0": {WorkloadStatus: status.StatusInfo{Status: status.Blocked}},
+				"bar/1": {WorkloadStatus: status.StatusInfo{Status: status.Waiting}},
+			},
+		},
+		"baz": {
+			Status: status.StatusInfo{Status: status.Unknown},
+		},
+	}, nil)
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	summary, err := client.StatusSummary(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(summary, gc.DeepEquals, params.StatusSummary{
+		Applications: params.StatusCounts{
+			Active:  1,
+			Blocked: 1,
+			Other:   1,
+		},
+		Units: params.StatusCounts{
+			Active:      1,
+			Blocked:     1,
+			Waiting:     1,
+			Error:       1,
+			Maintenance: 1,
+		},
+	})
+	c.Check(summary.Units.Total(), gc.Equals, 5)
+}
+
+func (s *statusSuite) TestStatusSummaryError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(nil, errors.New("boom"))
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	_, err := client.StatusSummary(context.Background())
+	c.Assert(err, gc.ErrorMatches, "could not fetch applications and units: boom")
+}
+
+func (s *statusSuite) TestMachineLXDProfilesConfigDrift(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := machinetesting.GenUUID(c)
+	locator := applicationcharm.CharmLocator{
+		Name:     "foo",
+		Revision: 2,
+		Source:   applicationcharm.CharmHubSource,
+	}
+	current := &charm.LXDProfile{
+		Config: map[string]string{
+			"security.nesting":    "true",
+			"security.privileged": "true",
+		},
+	}
+	appStatusInfo := applicationStatusInfo{
+		applications: map[string]statusservice.Application{
+			"foo": {CharmLocator: locator, LXDProfile: current},
+		},
+		lxdProfiles: map[string]*charm.LXDProfile{
+			"juju-model-foo-2": current,
+		},
+	}
+
+	// The machine still has the profile of the previous charm revision.
+	s.machineService.EXPECT().AppliedLXDProfileNames(gomock.Any(), machineUUID).Return([]string{"juju-model-foo-1"}, nil)
+	previous := locator
+	previous.Revision = 1
+	s.applicationService.EXPECT().GetCharmLXDProfile(gomock.Any(), previous).Return(charm.LXDProfile{
+		Description: "previous",
+		Config: map[string]string{
+			"security.nesting": "false",
+		},
+	}, 1, nil)
+
+	statusCtx := &statusContext{applicationService: s.applicationService}
+	profiles := statusCtx.machineLXDProfiles(context.Background(), s.machineService, machineUUID, appStatusInfo)
+	c.Check(profiles, jc.DeepEquals, map[string]params.LXDProfile{
+		"juju-model-foo-1": {
+			Description: "previous",
+			Config: map[string]string{
+				"security.nesting": "false",
+			},
+			ConfigDrift: map[string]string{
+				"security.nesting":    "false",
+				"security.privileged": "",
+			},
+		},
+	})
+}
+
+func (s *statusSuite) TestMachineLXDProfilesCurrent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := machinetesting.GenUUID(c)
+	current := &charm.LXDProfile{
+		Config: map[string]string{
+			"security.nesting": "true",
+		},
+	}
+	appStatusInfo := applicationStatusInfo{
+		applications: map[string]statusservice.Application{
+			"foo": {
+				CharmLocator: applicationcharm.CharmLocator{Name: "foo", Revision: 2},
+				LXDProfile:   current,
+			},
+		},
+		lxdProfiles: map[string]*charm.LXDProfile{
+			"juju-model-foo-2": current,
+		},
+	}
+
+	// The profile for the current charm has no drift and is not looked up.
+	s.machineService.EXPECT().AppliedLXDProfileNames(gomock.Any(), machineUUID).Return([]string{"juju-model-foo-2"}, nil)
+
+	statusCtx := &statusContext{applicationService: s.applicationService}
+	profiles := statusCtx.machineLXDProfiles(context.Background(), s.machineService, machineUUID, appStatusInfo)
+	c.Check(profiles, jc.DeepEquals, map[string]params.LXDProfile{
+		"juju-model-foo-2": {
+			Config: map[string]string{
+				"security.nesting": "true",
+			},
+		},
+	})
+}
+
 func (s *statusSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.modelInfoService = NewMockModelInfoService(ctrl)
 	s.statusService = NewMockStatusService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.machineService = NewMockMachineService(ctrl)
 	s.authorizer = NewMockAuthorizer(ctrl)
 
 	s.modelUUID = modeltesting.GenModelUUID(c)
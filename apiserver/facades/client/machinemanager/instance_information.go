@@ -5,6 +5,7 @@ package machinemanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 
@@ -14,6 +15,10 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
+// defaultInstanceTypesTimeout is the default time allowed for the provider
+// to report the instance types matching a single set of constraints.
+const defaultInstanceTypesTimeout = 30 * time.Second
+
 // InstanceTypes returns instance type information for the cloud and region
 // in which the current model is deployed.
 func (mm *MachineManagerAPI) InstanceTypes(ctx context.Context, cons params.ModelInstanceTypesConstraints) (params.InstanceTypesResults, error) {
@@ -21,15 +26,18 @@ func (mm *MachineManagerAPI) InstanceTypes(ctx context.Context, cons params.Mode
 	if err != nil {
 		return params.InstanceTypesResults{}, errors.Trace(err)
 	}
-	return instanceTypes(ctx, fetcher, cons)
+	return instanceTypes(ctx, fetcher, cons, mm.instanceTypesTimeout)
 }
 
 // instanceTypes reports back the results from the provider for what instance
-// types are available for given constraints.
+// types are available for given constraints. Each provider call is bounded by
+// the input timeout, so that a hung cloud API results in a timeout error for
+// that set of constraints rather than blocking the request indefinitely.
 func instanceTypes(
 	ctx context.Context,
 	fetcher environs.InstanceTypesFetcher,
 	cons params.ModelInstanceTypesConstraints,
+	timeout time.Duration,
 ) (params.InstanceTypesResults, error) {
 	result := make([]params.InstanceTypesResult, len(cons.Constraints))
 	for i, c := range cons.Constraints {
@@ -41,7 +49,7 @@ func instanceTypes(
 			fetcher,
 			value,
 		)
-		it, err := getInstanceTypes(ctx, itCons)
+		it, err := getInstanceTypesWithTimeout(ctx, itCons, timeout)
 		if err != nil {
 			it = params.InstanceTypesResult{Error: apiservererrors.ServerError(err)}
 		}
@@ -50,3 +58,21 @@ func instanceTypes(
 
 	return params.InstanceTypesResults{Results: result}, nil
 }
+
+// getInstanceTypesWithTimeout calls getInstanceTypes with a context that is
+// cancelled after the input timeout. If the deadline is exceeded, an error
+// satisfying [errors.Timeout] is returned.
+func getInstanceTypesWithTimeout(
+	ctx context.Context,
+	cons instanceTypeConstraints,
+	timeout time.Duration,
+) (params.InstanceTypesResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := getInstanceTypes(ctx, cons)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return params.InstanceTypesResult{}, errors.Timeoutf("fetching instance types after %v", timeout)
+	}
+	return result, err
+}
@@ -5,6 +5,7 @@ package machinemanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
@@ -53,7 +54,7 @@ func (s *instanceTypesSuite) TestInstanceTypes(c *gc.C) {
 		Constraints: []params.ModelInstanceTypesConstraint{{Value: &itCons}, {Value: &failureCons}, {}},
 	}
 
-	r, err := instanceTypes(context.Background(), s.instanceTypesFetcher, cons)
+	r, err := instanceTypes(context.Background(), s.instanceTypesFetcher, cons, defaultInstanceTypesTimeout)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(r.Results, gc.HasLen, 3)
 	expected := []params.InstanceTypesResult{
@@ -78,3 +79,115 @@ func (s *instanceTypesSuite) TestInstanceTypes(c *gc.C) {
 	}
 	c.Assert(r.Results, gc.DeepEquals, expected)
 }
+
+func (s *instanceTypesSuite) TestInstanceTypesFilteredByArch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	itCons := constraints.MustParse("arch=arm64")
+
+	s.instanceTypesFetcher.EXPECT().InstanceTypes(gomock.Any(), itCons).Return(instances.InstanceTypesWithCostMetadata{
+		InstanceTypes: []instances.InstanceType{
+			{Name: "instancetype-1", Arch: "amd64"},
+			{Name: "instancetype-2", Arch: "arm64"},
+			{Name: "instancetype-3"},
+		},
+	}, nil)
+
+	cons := params.ModelInstanceTypesConstraints{
+		Constraints: []params.ModelInstanceTypesConstraint{{Value: &itCons}},
+	}
+
+	r, err := instanceTypes(context.Background(), s.instanceTypesFetcher, cons, defaultInstanceTypesTimeout)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 1)
+	c.Assert(r.Results[0].InstanceTypes, gc.DeepEquals, []params.InstanceType{
+		{Name: "instancetype-2", Arches: []string{"arm64"}},
+		{Name: "instancetype-3"},
+	})
+}
+
+func (s *instanceTypesSuite) TestInstanceTypesNoArchConstraint(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	itCons := constraints.Value{}
+
+	s.instanceTypesFetcher.EXPECT().InstanceTypes(gomock.Any(), itCons).Return(instances.InstanceTypesWithCostMetadata{
+		InstanceTypes: []instances.InstanceType{
+			{Name: "instancetype-1", Arch: "amd64"},
+			{Name: "instancetype-2", Arch: "arm64"},
+		},
+	}, nil)
+
+	cons := params.ModelInstanceTypesConstraints{
+		Constraints: []params.ModelInstanceTypesConstraint{{Value: &itCons}},
+	}
+
+	r, err := instanceTypes(context.Background(), s.instanceTypesFetcher, cons, defaultInstanceTypesTimeout)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 1)
+	c.Assert(r.Results[0].InstanceTypes, gc.DeepEquals, []params.InstanceType{
+		{Name: "instancetype-1", Arches: []string{"amd64"}},
+		{Name: "instancetype-2", Arches: []string{"arm64"}},
+	})
+}
+
+func (s *instanceTypesSuite) TestInstanceTypesCostMetadata(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	itCons := constraints.Value{}
+
+	s.instanceTypesFetcher.EXPECT().InstanceTypes(gomock.Any(), itCons).Return(instances.InstanceTypesWithCostMetadata{
+		CostUnit:     "USD/h",
+		CostCurrency: "USD",
+		CostDivisor:  1000,
+		InstanceTypes: []instances.InstanceType{
+			{Name: "instancetype-1", Cost: 96},
+			{Name: "instancetype-2"},
+		},
+	}, nil)
+
+	cons := params.ModelInstanceTypesConstraints{
+		Constraints: []params.ModelInstanceTypesConstraint{{Value: &itCons}},
+	}
+
+	r, err := instanceTypes(context.Background(), s.instanceTypesFetcher, cons, defaultInstanceTypesTimeout)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 1)
+
+	// Cost data is passed through as supplied by the provider; instance
+	// types without a cost are left empty.
+	c.Assert(r.Results[0], gc.DeepEquals, params.InstanceTypesResult{
+		InstanceTypes: []params.InstanceType{
+			{Name: "instancetype-1", Cost: 96},
+			{Name: "instancetype-2"},
+		},
+		CostUnit:     "USD/h",
+		CostCurrency: "USD",
+		CostDivisor:  1000,
+	})
+}
+
+func (s *instanceTypesSuite) TestInstanceTypesTimeout(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	itCons := constraints.Value{CpuCores: &over9kCPUCores}
+
+	// The provider blocks until its context is done, as a hung cloud API
+	// would if it honoured cancellation.
+	s.instanceTypesFetcher.EXPECT().InstanceTypes(gomock.Any(), itCons).DoAndReturn(
+		func(ctx context.Context, _ constraints.Value) (instances.InstanceTypesWithCostMetadata, error) {
+			<-ctx.Done()
+			return instances.InstanceTypesWithCostMetadata{}, ctx.Err()
+		},
+	)
+
+	cons := params.ModelInstanceTypesConstraints{
+		Constraints: []params.ModelInstanceTypesConstraint{{Value: &itCons}},
+	}
+
+	r, err := instanceTypes(context.Background(), s.instanceTypesFetcher, cons, time.Millisecond)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(r.Results, gc.HasLen, 1)
+	c.Check(r.Results[0].Error, gc.NotNil)
+	c.Check(r.Results[0].Error.Message, gc.Equals, "fetching instance types after 1ms timeout")
+}
@@ -42,6 +42,20 @@ func toParamsInstanceTypeResult(itypes []instances.InstanceType) []params.Instan
 	return result
 }
 
+// filterInstanceTypesByArch returns the instance types that support the
+// given architecture. Not every provider honours the arch constraint when
+// listing instance types, so it's applied again here. Instance types that
+// don't report an architecture are kept, as they can't be ruled out.
+func filterInstanceTypesByArch(itypes []instances.InstanceType, arch string) []instances.InstanceType {
+	var result []instances.InstanceType
+	for _, t := range itypes {
+		if t.Arch == "" || t.Arch == arch {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
 // newInstanceTypeConstraints returns an instanceTypeConstraints.
 func newInstanceTypeConstraints(
 	fetcher environs.InstanceTypesFetcher,
@@ -64,8 +78,13 @@ func getInstanceTypes(
 		return params.InstanceTypesResult{}, errors.Trace(err)
 	}
 
+	itypes := instanceTypes.InstanceTypes
+	if cons.constraints.HasArch() {
+		itypes = filterInstanceTypesByArch(itypes, *cons.constraints.Arch)
+	}
+
 	return params.InstanceTypesResult{
-		InstanceTypes: toParamsInstanceTypeResult(instanceTypes.InstanceTypes),
+		InstanceTypes: toParamsInstanceTypeResult(itypes),
 		CostUnit:      instanceTypes.CostUnit,
 		CostCurrency:  instanceTypes.CostCurrency,
 		CostDivisor:   instanceTypes.CostDivisor,
@@ -174,6 +174,10 @@ type MachineManagerAPI struct {
 	modelConfigService ModelConfigService
 	agentBinaryService AgentBinaryService
 
+	// instanceTypesTimeout bounds the time spent waiting on the provider
+	// for each set of instance types requested.
+	instanceTypesTimeout time.Duration
+
 	logger corelogger.Logger
 }
 
@@ -218,6 +222,7 @@ func NewMachineManagerAPI(
 		keyUpdaterService:       keyUpdaterService,
 		modelConfigService:      modelConfigService,
 		agentBinaryService:      agentBinaryService,
+		instanceTypesTimeout:    defaultInstanceTypesTimeout,
 	}
 	return api
 }
@@ -92,12 +92,12 @@ func (s *baseStorageSuite) setupMocks(c *gc.C) *gomock.Controller {
 		s.controllerUUID, s.modelUUID, coremodel.IAAS,
 		s.storageAccessor, s.blockDeviceGetter,
 		s.storageService, s.applicationService, s.storageRegistryGetter,
-		s.authorizer, s.blockCommandService)
+		s.authorizer, s.blockCommandService, s.resources)
 	s.apiCaas = storage.NewStorageAPI(
 		s.controllerUUID, s.modelUUID, coremodel.CAAS,
 		s.storageAccessor, s.blockDeviceGetter,
 		s.storageService, s.applicationService, s.storageRegistryGetter,
-		s.authorizer, s.blockCommandService)
+		s.authorizer, s.blockCommandService, s.resources)
 
 	return ctrl
 }
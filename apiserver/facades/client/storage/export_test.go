@@ -5,6 +5,7 @@ package storage
 
 var (
 	EnsureStoragePoolFilter = (*StorageAPI).ensureStoragePoolFilter
+	SortFilesystemDetails   = sortFilesystemDetails
 )
 
 type (
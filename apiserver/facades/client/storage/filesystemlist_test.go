@@ -7,9 +7,15 @@ import (
 	"context"
 
 	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/facades/client/storage"
+	domainstorage "github.com/juju/juju/domain/storage"
+	internalstorage "github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 )
@@ -65,6 +71,54 @@ func (s *filesystemSuite) TestListFilesystemsEmptyFilter(c *gc.C) {
 	c.Assert(found.Results[0].Result[0], gc.DeepEquals, s.expectedFilesystemDetails())
 }
 
+func (s *filesystemSuite) TestListFilesystemsSortedByTag(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageAccessor.allFilesystems = func() ([]state.Filesystem, error) {
+		var filesystems []state.Filesystem
+		for _, id := range []string{"10", "2", "1/3", "1"} {
+			filesystems = append(filesystems, &mockFilesystem{
+				tag:  names.NewFilesystemTag(id),
+				life: state.Alive,
+			})
+		}
+		return filesystems, nil
+	}
+	found, err := s.api.ListFilesystems(context.Background(), params.FilesystemFilters{
+		Filters: []params.FilesystemFilter{{}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 1)
+	c.Assert(found.Results[0].Error, gc.IsNil)
+
+	var tags []string
+	for _, details := range found.Results[0].Result {
+		tags = append(tags, details.FilesystemTag)
+	}
+	c.Check(tags, jc.DeepEquals, []string{
+		"filesystem-1",
+		"filesystem-1-3",
+		"filesystem-2",
+		"filesystem-10",
+	})
+}
+
+func (s *filesystemSuite) TestSortFilesystemDetailsKeepsDuplicates(c *gc.C) {
+	details := []params.FilesystemDetails{
+		{FilesystemTag: "filesystem-10"},
+		{FilesystemTag: "filesystem-2", Status: params.EntityStatus{Info: "first"}},
+		{FilesystemTag: "filesystem-1"},
+		{FilesystemTag: "filesystem-2", Status: params.EntityStatus{Info: "second"}},
+	}
+	storage.SortFilesystemDetails(details)
+	c.Check(details, jc.DeepEquals, []params.FilesystemDetails{
+		{FilesystemTag: "filesystem-1"},
+		{FilesystemTag: "filesystem-2", Status: params.EntityStatus{Info: "first"}},
+		{FilesystemTag: "filesystem-2", Status: params.EntityStatus{Info: "second"}},
+		{FilesystemTag: "filesystem-10"},
+	})
+}
+
 func (s *filesystemSuite) TestListFilesystemsError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -175,3 +229,112 @@ func (s *filesystemSuite) TestListFilesystemsVolumeBacked(c *gc.C) {
 	c.Assert(found.Results[0].Result, gc.HasLen, 1)
 	c.Assert(found.Results[0].Result[0], jc.DeepEquals, expected)
 }
+
+func (s *filesystemSuite) TestFilesystemPoolSummary(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	loop, err := internalstorage.NewConfig("loop-pool", provider.LoopProviderType, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	empty, err := internalstorage.NewConfig("empty-pool", provider.LoopProviderType, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.storageService.EXPECT().ListStoragePools(gomock.Any(), domainstorage.NilNames, domainstorage.NilProviders).
+		Return([]*internalstorage.Config{loop, empty}, nil)
+
+	s.filesystem.info = &state.FilesystemInfo{Pool: "loop-pool", Size: 2}
+	unattached := &mockFilesystem{
+		tag:  names.NewFilesystemTag("105"),
+		life: state.Alive,
+		info: &state.FilesystemInfo{Pool: "loop-pool", Size: 1},
+	}
+	s.storageAccessor.allFilesystems = func() ([]state.Filesystem, error) {
+		return []state.Filesystem{s.filesystem, unattached}, nil
+	}
+
+	result, err := s.api.FilesystemPoolSummary(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Pools, jc.DeepEquals, map[string]params.PoolUsage{
+		"loop-pool": {
+			Total:       3 * 1024 * 1024,
+			Used:        2 * 1024 * 1024,
+			Available:   1 * 1024 * 1024,
+			Filesystems: 2,
+		},
+		"empty-pool": {},
+	})
+}
+
+func (s *filesystemSuite) TestFilesystemPoolSummaryError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageService.EXPECT().ListStoragePools(gomock.Any(), domainstorage.NilNames, domainstorage.NilProviders).
+		Return(nil, nil)
+	s.storageAccessor.allFilesystems = func() ([]state.Filesystem, error) {
+		return nil, errors.New("inventing error")
+	}
+
+	_, err := s.api.FilesystemPoolSummary(context.Background())
+	c.Assert(err, gc.ErrorMatches, "inventing error")
+}
+
+func (s *filesystemSuite) TestWatchFilesystemAttachments(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var hosts []names.Tag
+	changes := make(chan []string, 1)
+	changes <- []string{s.filesystemTag.String()}
+	s.storageAccessor.watchFilesystemAttachmentChanges = func(h ...names.Tag) state.StringsWatcher {
+		hosts = h
+		return &mockStringsWatcher{changes: changes}
+	}
+	found, err := s.api.WatchFilesystemAttachments(context.Background(), params.FilesystemFilters{
+		Filters: []params.FilesystemFilter{{}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 1)
+	c.Assert(found.Results[0].Error, gc.IsNil)
+	c.Check(found.Results[0].Changes, jc.DeepEquals, []string{s.filesystemTag.String()})
+	c.Check(found.Results[0].StringsWatcherId, gc.Equals, "1")
+	c.Check(hosts, gc.HasLen, 0)
+	c.Check(s.resources.Count(), gc.Equals, 1)
+}
+
+func (s *filesystemSuite) TestWatchFilesystemAttachmentsFilter(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var hosts []names.Tag
+	changes := make(chan []string, 1)
+	changes <- []string{}
+	s.storageAccessor.watchFilesystemAttachmentChanges = func(h ...names.Tag) state.StringsWatcher {
+		hosts = h
+		return &mockStringsWatcher{changes: changes}
+	}
+	found, err := s.api.WatchFilesystemAttachments(context.Background(), params.FilesystemFilters{
+		Filters: []params.FilesystemFilter{{
+			Machines: []string{s.machineTag.String()},
+		}, {
+			Machines: []string{"invalid"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 2)
+	c.Assert(found.Results[0].Error, gc.IsNil)
+	c.Check(hosts, jc.DeepEquals, []names.Tag{s.machineTag})
+	c.Check(found.Results[1].Error, gc.ErrorMatches, `"invalid" is not a valid tag`)
+}
+
+func (s *filesystemSuite) TestWatchFilesystemAttachmentsWatcherClosed(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	changes := make(chan []string)
+	close(changes)
+	s.storageAccessor.watchFilesystemAttachmentChanges = func(...names.Tag) state.StringsWatcher {
+		return &mockStringsWatcher{changes: changes}
+	}
+	found, err := s.api.WatchFilesystemAttachments(context.Background(), params.FilesystemFilters{
+		Filters: []params.FilesystemFilter{{}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 1)
+	c.Check(found.Results[0].Error, gc.ErrorMatches, "expected an error from watcher, got nil")
+	c.Check(s.resources.Count(), gc.Equals, 0)
+}
@@ -46,6 +46,7 @@ type mockStorageAccessor struct {
 	machineFilesystemAttachments        func(machine names.MachineTag) ([]state.FilesystemAttachment, error)
 	filesystemAttachments               func(filesystem names.FilesystemTag) ([]state.FilesystemAttachment, error)
 	allFilesystems                      func() ([]state.Filesystem, error)
+	watchFilesystemAttachmentChanges    func(hosts ...names.Tag) state.StringsWatcher
 	addStorageForUnit                   func(u names.UnitTag, name string, cons state.StorageConstraints) ([]names.StorageTag, error)
 	destroyStorageInstance              func(names.StorageTag, bool, bool) error
 	releaseStorageInstance              func(names.StorageTag, bool, bool) error
@@ -131,6 +132,10 @@ func (st *mockStorageAccessor) Filesystem(tag names.FilesystemTag) (state.Filesy
 	return st.filesystem(tag)
 }
 
+func (st *mockStorageAccessor) WatchFilesystemAttachmentChanges(hosts ...names.Tag) state.StringsWatcher {
+	return st.watchFilesystemAttachmentChanges(hosts...)
+}
+
 func (st *mockStorageAccessor) AddStorageForUnit(u names.UnitTag, name string, cons state.StorageConstraints) ([]names.StorageTag, error) {
 	return st.addStorageForUnit(u, name, cons)
 }
@@ -382,3 +387,25 @@ func (va *mockVolumeAttachment) Info() (state.VolumeAttachmentInfo, error) {
 func (va *mockVolumeAttachment) Params() (state.VolumeAttachmentParams, bool) {
 	panic("not implemented for test")
 }
+
+type mockStringsWatcher struct {
+	changes chan []string
+}
+
+func (*mockStringsWatcher) Stop() error {
+	return nil
+}
+
+func (*mockStringsWatcher) Kill() {}
+
+func (*mockStringsWatcher) Wait() error {
+	return nil
+}
+
+func (*mockStringsWatcher) Err() error {
+	return nil
+}
+
+func (w *mockStringsWatcher) Changes() <-chan []string {
+	return w.changes
+}
@@ -16,10 +16,22 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Storage", 6, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newStorageAPI(stdCtx, ctx) // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+		return newStorageAPIv6(stdCtx, ctx) // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+	}, reflect.TypeOf((*StorageAPIv6)(nil)))
+	registry.MustRegister("Storage", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newStorageAPI(stdCtx, ctx) // Add WatchFilesystemAttachments, FilesystemPoolSummary
 	}, reflect.TypeOf((*StorageAPI)(nil)))
 }
 
+// newStorageAPIv6 returns a new storage API facade for version 6.
+func newStorageAPIv6(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIv6, error) {
+	api, err := newStorageAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageAPIv6{StorageAPI: api}, nil
+}
+
 // newStorageAPI returns a new storage API facade.
 func newStorageAPI(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPI, error) {
 	domainServices := ctx.DomainServices()
@@ -49,5 +61,6 @@ func newStorageAPI(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPI
 		domainServices.Application(),
 		storageService.GetStorageRegistry,
 		authorizer,
-		domainServices.BlockCommand()), nil
+		domainServices.BlockCommand(),
+		ctx.Resources()), nil
 }
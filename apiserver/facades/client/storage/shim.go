@@ -93,6 +93,10 @@ type storageFile interface {
 	// Filesystem is required for filesystem functionality.
 	Filesystem(tag names.FilesystemTag) (state.Filesystem, error)
 
+	// WatchFilesystemAttachmentChanges is required for filesystem
+	// attachment watching functionality.
+	WatchFilesystemAttachmentChanges(hosts ...names.Tag) state.StringsWatcher
+
 	// AddExistingFilesystem imports an existing filesystem into the model.
 	AddExistingFilesystem(f state.FilesystemInfo, v *state.VolumeInfo, storageName string) (names.StorageTag, error)
 }
@@ -5,13 +5,15 @@ package storage
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"github.com/juju/naturalsort"
 
-	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/common"
+	commonmodel "github.com/juju/juju/apiserver/common/model"
 	"github.com/juju/juju/apiserver/common/storagecommon"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
@@ -28,6 +30,7 @@ import (
 	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
 )
 
 // StorageService defines apis on the storage service.
@@ -46,7 +49,12 @@ type ApplicationService interface {
 
 type storageRegistryGetter func(context.Context) (storage.ProviderRegistry, error)
 
-// StorageAPI implements the latest version (v6) of the Storage API.
+// StorageAPIv6 implements version 6 of the Storage API.
+type StorageAPIv6 struct {
+	*StorageAPI
+}
+
+// StorageAPI implements the latest version (v7) of the Storage API.
 type StorageAPI struct {
 	storageAccess         storageAccess
 	blockDeviceGetter     blockDeviceGetter
@@ -55,6 +63,7 @@ type StorageAPI struct {
 	storageRegistryGetter storageRegistryGetter
 	authorizer            facade.Authorizer
 	blockCommandService   common.BlockCommandService
+	resources             facade.Resources
 
 	controllerUUID string
 	modelUUID      coremodel.UUID
@@ -72,6 +81,7 @@ func NewStorageAPI(
 	storageRegistryGetter storageRegistryGetter,
 	authorizer facade.Authorizer,
 	blockCommandService common.BlockCommandService,
+	resources facade.Resources,
 ) *StorageAPI {
 	return &StorageAPI{
 		controllerUUID:        controllerUUID,
@@ -84,19 +94,24 @@ func NewStorageAPI(
 		storageRegistryGetter: storageRegistryGetter,
 		authorizer:            authorizer,
 		blockCommandService:   blockCommandService,
+		resources:             resources,
 	}
 }
 
 func (a *StorageAPI) checkCanRead(ctx context.Context) error {
-	err := a.authorizer.HasPermission(ctx, permission.SuperuserAccess, names.NewControllerTag(a.controllerUUID))
-	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+	canRead, err := commonmodel.HasModelRead(
+		ctx,
+		a.authorizer,
+		names.NewControllerTag(a.controllerUUID),
+		names.NewModelTag(a.modelUUID.String()),
+	)
+	if err != nil {
 		return errors.Trace(err)
 	}
-
-	if err == nil {
-		return nil
+	if !canRead {
+		return apiservererrors.ErrPerm
 	}
-	return a.authorizer.HasPermission(ctx, permission.ReadAccess, names.NewModelTag(a.modelUUID.String()))
+	return nil
 }
 
 func (a *StorageAPI) checkCanWrite(ctx context.Context) error {
@@ -427,6 +442,96 @@ func filterFilesystems(
 	return filesystems, filesystemAttachments, nil
 }
 
+// FilesystemPoolSummary isn't implemented in the StorageAPIv6 facade.
+func (*StorageAPIv6) FilesystemPoolSummary(_, _ struct{}) {}
+
+// FilesystemPoolSummary returns the filesystem usage of each storage pool
+// in the model, aggregated over the same filesystem details returned by
+// ListFilesystems. Filesystems attached to a machine or unit count as used.
+// Pools with no filesystems are reported with zero usage.
+func (a *StorageAPI) FilesystemPoolSummary(ctx context.Context) (params.FilesystemPoolSummaryResult, error) {
+	if err := a.checkCanRead(ctx); err != nil {
+		return params.FilesystemPoolSummaryResult{}, errors.Trace(err)
+	}
+
+	pools, err := a.listPools(ctx, a.ensureStoragePoolFilter(params.StoragePoolFilter{}))
+	if err != nil {
+		return params.FilesystemPoolSummaryResult{}, errors.Trace(err)
+	}
+	filesystems, filesystemAttachments, err := filterFilesystems(a.storageAccess, params.FilesystemFilter{})
+	if err != nil {
+		return params.FilesystemPoolSummaryResult{}, errors.Trace(err)
+	}
+	details, err := a.createFilesystemDetailsList(ctx, filesystems, filesystemAttachments)
+	if err != nil {
+		return params.FilesystemPoolSummaryResult{}, errors.Trace(err)
+	}
+
+	usage := make(map[string]params.PoolUsage, len(pools))
+	for _, pool := range pools {
+		usage[pool.Name] = params.PoolUsage{}
+	}
+	for _, d := range details {
+		// Filesystem sizes are recorded in MiB.
+		size := d.Info.Size * 1024 * 1024
+		u := usage[d.Info.Pool]
+		u.Filesystems++
+		u.Total += size
+		if len(d.MachineAttachments) > 0 || len(d.UnitAttachments) > 0 {
+			u.Used += size
+		} else {
+			u.Available += size
+		}
+		usage[d.Info.Pool] = u
+	}
+	return params.FilesystemPoolSummaryResult{Pools: usage}, nil
+}
+
+// WatchFilesystemAttachments isn't implemented in the StorageAPIv6 facade.
+func (*StorageAPIv6) WatchFilesystemAttachments(_, _ struct{}) {}
+
+// WatchFilesystemAttachments returns a StringsWatcher for each of the
+// provided filters, notifying of the tags of the filesystems whose
+// attachments have changed. A filter with machines only reports changes
+// to the attachments of those machines.
+func (a *StorageAPI) WatchFilesystemAttachments(ctx context.Context, filters params.FilesystemFilters) (params.StringsWatchResults, error) {
+	results := params.StringsWatchResults{
+		Results: make([]params.StringsWatchResult, len(filters.Filters)),
+	}
+	if err := a.checkCanRead(ctx); err != nil {
+		return results, errors.Trace(err)
+	}
+
+	for i, filter := range filters.Filters {
+		id, changes, err := a.watchFilesystemAttachments(filter)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].StringsWatcherId = id
+		results.Results[i].Changes = changes
+	}
+	return results, nil
+}
+
+func (a *StorageAPI) watchFilesystemAttachments(f params.FilesystemFilter) (string, []string, error) {
+	hosts := make([]names.Tag, len(f.Machines))
+	for i, machine := range f.Machines {
+		machineTag, err := names.ParseMachineTag(machine)
+		if err != nil {
+			return "", nil, errors.Trace(err)
+		}
+		hosts[i] = machineTag
+	}
+	w := a.storageAccess.WatchFilesystemAttachmentChanges(hosts...)
+	// Consume the initial event.
+	changes, ok := <-w.Changes()
+	if !ok {
+		return "", nil, watcher.EnsureErr(w)
+	}
+	return a.resources.Register(w), changes, nil
+}
+
 func (a *StorageAPI) createFilesystemDetailsList(
 	ctx context.Context,
 	filesystems []state.Filesystem,
@@ -446,9 +551,28 @@ func (a *StorageAPI) createFilesystemDetailsList(
 		}
 		results[i] = *details
 	}
+	sortFilesystemDetails(results)
 	return results, nil
 }
 
+// sortFilesystemDetails sorts the filesystem details by tag, comparing any
+// numbers numerically, so that filesystem-2 sorts before filesystem-10.
+func sortFilesystemDetails(details []params.FilesystemDetails) {
+	sort.SliceStable(details, func(i, j int) bool {
+		return naturalLess(details[i].FilesystemTag, details[j].FilesystemTag)
+	})
+}
+
+// naturalLess reports whether a sorts before b in natural order. The
+// naturalsort package only sorts slices, so the two values are sorted as
+// one; a is less than b if the pair is swapped.
+func naturalLess(a, b string) bool {
+	if a == b {
+		return false
+	}
+	return naturalsort.Sort([]string{b, a})[0] == a
+}
+
 // AddToUnit validates and creates additional storage instances for units.
 // A "CHANGE" block can block this operation.
 func (a *StorageAPI) AddToUnit(ctx context.Context, args params.StoragesAddParams) (params.AddStorageResults, error) {
@@ -731,7 +731,7 @@ func (s *storageSuite) TestListStorageAsAdminOnNotOwnedModel(c *gc.C) {
 		controllerUUID, modelUUID, coremodel.IAAS,
 		s.storageAccessor, nil, s.storageService,
 		s.applicationService, s.storageRegistryGetter,
-		s.authorizer, s.blockCommandService)
+		s.authorizer, s.blockCommandService, s.resources)
 
 	// ListStorageDetails should not fail
 	_, err := s.api.ListStorageDetails(context.Background(), params.StorageFilters{})
@@ -750,7 +750,7 @@ func (s *storageSuite) TestListStorageAsNonAdminOnNotOwnedModel(c *gc.C) {
 		controllerUUID, modelUUID, coremodel.IAAS,
 		s.storageAccessor, nil, s.storageService,
 		s.applicationService, s.storageRegistryGetter,
-		s.authorizer, s.blockCommandService)
+		s.authorizer, s.blockCommandService, s.resources)
 
 	// ListStorageDetails should fail with perm error
 	_, err := s.api.ListStorageDetails(context.Background(), params.StorageFilters{})
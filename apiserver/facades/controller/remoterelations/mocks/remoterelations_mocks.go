@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/remoterelations_mocks.go github.com/juju/juju/apiserver/facades/controller/remoterelations RemoteRelationsState,ControllerConfigAPI,ExternalControllerService,SecretService
+//	mockgen -typed -package mocks -destination apiserver/facades/controller/remoterelations/mocks/remoterelations_mocks.go github.com/juju/juju/apiserver/facades/controller/remoterelations RemoteRelationsState,ControllerConfigAPI,ExternalControllerService,SecretService
 //
 
 // Package mocks is a generated GoMock package.
@@ -676,6 +676,45 @@ func (c *MockRemoteRelationsStateOfferConnectionForRelationCall) DoAndReturn(f f
 	return c
 }
 
+// OfferConnectionsForRelations mocks base method.
+func (m *MockRemoteRelationsState) OfferConnectionsForRelations(arg0 []string) (map[string]crossmodel.OfferConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferConnectionsForRelations", arg0)
+	ret0, _ := ret[0].(map[string]crossmodel.OfferConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferConnectionsForRelations indicates an expected call of OfferConnectionsForRelations.
+func (mr *MockRemoteRelationsStateMockRecorder) OfferConnectionsForRelations(arg0 any) *MockRemoteRelationsStateOfferConnectionsForRelationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferConnectionsForRelations", reflect.TypeOf((*MockRemoteRelationsState)(nil).OfferConnectionsForRelations), arg0)
+	return &MockRemoteRelationsStateOfferConnectionsForRelationsCall{Call: call}
+}
+
+// MockRemoteRelationsStateOfferConnectionsForRelationsCall wrap *gomock.Call
+type MockRemoteRelationsStateOfferConnectionsForRelationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemoteRelationsStateOfferConnectionsForRelationsCall) Return(arg0 map[string]crossmodel.OfferConnection, arg1 error) *MockRemoteRelationsStateOfferConnectionsForRelationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemoteRelationsStateOfferConnectionsForRelationsCall) Do(f func([]string) (map[string]crossmodel.OfferConnection, error)) *MockRemoteRelationsStateOfferConnectionsForRelationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemoteRelationsStateOfferConnectionsForRelationsCall) DoAndReturn(f func([]string) (map[string]crossmodel.OfferConnection, error)) *MockRemoteRelationsStateOfferConnectionsForRelationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // OfferUUIDForRelation mocks base method.
 func (m *MockRemoteRelationsState) OfferUUIDForRelation(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -6,13 +6,18 @@ package status
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"github.com/juju/naturalsort"
 
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/core/instance"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/internal/charm"
 )
 
 type formattedStatus struct {
@@ -48,6 +53,7 @@ type modelStatus struct {
 
 type controllerStatus struct {
 	Timestamp string `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	ClockSkew string `json:"clock-skew,omitempty" yaml:"clock-skew,omitempty"`
 }
 
 type networkInterface struct {
@@ -75,11 +81,106 @@ type machineStatus struct {
 	Containers         map[string]machineStatus      `json:"containers,omitempty" yaml:"containers,omitempty"`
 	Constraints        string                        `json:"constraints,omitempty" yaml:"constraints,omitempty"`
 	Hardware           string                        `json:"hardware,omitempty" yaml:"hardware,omitempty"`
-	HAStatus           string                        `json:"controller-member-status,omitempty" yaml:"controller-member-status,omitempty"`
+	HardwareDetails    *hardwareDetails              `json:"hardware-details,omitempty" yaml:"hardware-details,omitempty"`
+	HAStatus           haStatus                      `json:"controller-member-status,omitempty" yaml:"controller-member-status,omitempty"`
 	HAPrimary          bool                          `json:"ha-primary,omitempty" yaml:"ha-primary,omitempty"`
 	LXDProfiles        map[string]lxdProfileContents `json:"lxd-profiles,omitempty" yaml:"lxd-profiles,omitempty"`
 }
 
+// setHAStatus sets the controller member status of the machine, along with
+// the HAPrimary flag derived from it, so that the two always agree.
+func (s *machineStatus) setHAStatus(status haStatus) {
+	s.HAStatus = status
+	s.HAPrimary = status == haStatusPrimary
+}
+
+// haStatus is the role of a controller machine in the controller replica
+// set, as shown in the status output.
+type haStatus string
+
+const (
+	// haStatusPrimary is the status of the replica set primary.
+	haStatusPrimary haStatus = "primary"
+	// haStatusSecondary is the status of a voting member which isn't the
+	// primary.
+	haStatusSecondary haStatus = "secondary"
+	// haStatusPassive is the status of a member without a vote, which
+	// doesn't want one.
+	haStatusPassive haStatus = "passive"
+	// haStatusAddingVote is the status of a member without a vote, which
+	// is in the process of being given one.
+	haStatusAddingVote haStatus = "adding-vote"
+	// haStatusRemovingVote is the status of a voting member which is in the
+	// process of having its vote removed.
+	haStatusRemovingVote haStatus = "removing-vote"
+	// haStatusUnknown is the status of a member whose replica set state is
+	// inconsistent, such as a primary without a vote.
+	haStatusUnknown haStatus = "unknown"
+)
+
+// validHAStatus reports whether s is one of the known statuses. An empty
+// status is valid, and is omitted from the output.
+func validHAStatus(s haStatus) bool {
+	switch s {
+	case haStatusPrimary, haStatusSecondary, haStatusPassive,
+		haStatusAddingVote, haStatusRemovingVote, haStatusUnknown, "":
+		return true
+	}
+	return false
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s haStatus) MarshalJSON() ([]byte, error) {
+	if !validHAStatus(s) {
+		return nil, errors.NotValidf("controller member status %q", string(s))
+	}
+	return json.Marshal(string(s))
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s haStatus) MarshalYAML() (interface{}, error) {
+	if !validHAStatus(s) {
+		return nil, errors.NotValidf("controller member status %q", string(s))
+	}
+	return string(s), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *haStatus) UnmarshalJSON(data []byte) error {
+	var status string
+	if err := json.Unmarshal(data, &status); err != nil {
+		return errors.Trace(err)
+	}
+	return s.set(status)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *haStatus) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var status string
+	if err := unmarshal(&status); err != nil {
+		return errors.Trace(err)
+	}
+	return s.set(status)
+}
+
+func (s *haStatus) set(status string) error {
+	if !validHAStatus(haStatus(status)) {
+		return errors.NotValidf("controller member status %q", status)
+	}
+	*s = haStatus(status)
+	return nil
+}
+
+// hardwareDetails holds the structured hardware characteristics of a machine.
+// Memory and disk sizes are in megabytes.
+type hardwareDetails struct {
+	Arch             string `json:"arch,omitempty" yaml:"arch,omitempty"`
+	Cores            uint64 `json:"cores,omitempty" yaml:"cores,omitempty"`
+	Mem              uint64 `json:"mem,omitempty" yaml:"mem,omitempty"`
+	RootDisk         uint64 `json:"root-disk,omitempty" yaml:"root-disk,omitempty"`
+	AvailabilityZone string `json:"availability-zone,omitempty" yaml:"availability-zone,omitempty"`
+}
+
 // A goyaml bug means we can't declare these types
 // locally to the GetYAML methods.
 type machineStatusNoMarshal machineStatus
@@ -111,6 +212,7 @@ type lxdProfileContents struct {
 	Config      map[string]string            `json:"config" yaml:"config"`
 	Description string                       `json:"description" yaml:"description"`
 	Devices     map[string]map[string]string `json:"devices" yaml:"devices"`
+	ConfigDrift map[string]string            `json:"config-drift,omitempty" yaml:"config-drift,omitempty"`
 }
 
 type applicationStatus struct {
@@ -121,26 +223,82 @@ type applicationStatus struct {
 	CharmName        string                                 `json:"charm-name" yaml:"charm-name"`
 	CharmRev         int                                    `json:"charm-rev" yaml:"charm-rev"`
 	CharmChannel     string                                 `json:"charm-channel,omitempty" yaml:"charm-channel,omitempty"`
+	CharmTrack       string                                 `json:"charm-track,omitempty" yaml:"charm-track,omitempty"`
+	CharmRisk        string                                 `json:"charm-risk,omitempty" yaml:"charm-risk,omitempty"`
 	CharmVersion     string                                 `json:"charm-version,omitempty" yaml:"charm-version,omitempty"`
+	CharmFullVersion string                                 `json:"charm-full-version,omitempty" yaml:"charm-full-version,omitempty"`
 	CharmProfile     string                                 `json:"charm-profile,omitempty" yaml:"charm-profile,omitempty"`
 	CanUpgradeTo     string                                 `json:"can-upgrade-to,omitempty" yaml:"can-upgrade-to,omitempty"`
 	Scale            int                                    `json:"scale,omitempty" yaml:"scale,omitempty"`
 	ProviderId       string                                 `json:"provider-id,omitempty" yaml:"provider-id,omitempty"`
 	Address          string                                 `json:"address,omitempty" yaml:"address,omitempty"`
 	Exposed          bool                                   `json:"exposed" yaml:"exposed"`
+	ExposedEndpoints map[string]exposedEndpointDetail       `json:"exposed-endpoints,omitempty" yaml:"exposed-endpoints,omitempty"`
 	Life             string                                 `json:"life,omitempty" yaml:"life,omitempty"`
 	StatusInfo       statusInfoContents                     `json:"application-status,omitempty" yaml:"application-status"`
 	Relations        map[string][]applicationStatusRelation `json:"relations,omitempty" yaml:"relations,omitempty"`
 	SubordinateTo    []string                               `json:"subordinate-to,omitempty" yaml:"subordinate-to,omitempty"`
 	Units            map[string]unitStatus                  `json:"units,omitempty" yaml:"units,omitempty"`
 	Version          string                                 `json:"version,omitempty" yaml:"version,omitempty"`
+	VersionMismatch  bool                                   `json:"version-mismatch,omitempty" yaml:"version-mismatch,omitempty"`
 	EndpointBindings map[string]string                      `json:"endpoint-bindings,omitempty" yaml:"endpoint-bindings,omitempty"`
 }
 
+// exposedEndpointDetail describes the spaces and CIDRs that an exposed
+// application endpoint is reachable from.
+type exposedEndpointDetail struct {
+	ExposeToSpaces []string `json:"expose-to-spaces,omitempty" yaml:"expose-to-spaces,omitempty"`
+	ExposeToCIDRs  []string `json:"expose-to-cidrs,omitempty" yaml:"expose-to-cidrs,omitempty"`
+}
+
 type applicationStatusRelation struct {
-	RelatedApplicationName string `json:"related-application,omitempty" yaml:"related-application,omitempty"`
-	Interface              string `json:"interface,omitempty" yaml:"interface,omitempty"`
-	Scope                  string `json:"scope,omitempty" yaml:"scope,omitempty"`
+	RelatedApplicationName string        `json:"related-application,omitempty" yaml:"related-application,omitempty"`
+	Interface              string        `json:"interface,omitempty" yaml:"interface,omitempty"`
+	Scope                  relationScope `json:"scope,omitempty" yaml:"scope,omitempty"`
+}
+
+// relationScope is the scope of a relation, as shown in the status output.
+type relationScope string
+
+const (
+	relationScopeGlobal    relationScope = "global"
+	relationScopeContainer relationScope = "container"
+)
+
+// formatRelationScope maps the scope reported by the controller onto a
+// relationScope. An unknown scope results in an empty relationScope, which
+// is omitted from the output.
+func formatRelationScope(scope string) relationScope {
+	switch charm.RelationScope(strings.ToLower(strings.TrimSpace(scope))) {
+	case charm.ScopeGlobal:
+		return relationScopeGlobal
+	case charm.ScopeContainer:
+		return relationScopeContainer
+	}
+	return ""
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s relationScope) MarshalJSON() ([]byte, error) {
+	switch s {
+	case relationScopeGlobal, relationScopeContainer, "":
+		return json.Marshal(string(s))
+	}
+	return nil, errors.NotValidf("relation scope %q", string(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *relationScope) UnmarshalJSON(data []byte) error {
+	var scope string
+	if err := json.Unmarshal(data, &scope); err != nil {
+		return errors.Trace(err)
+	}
+	switch relationScope(scope) {
+	case relationScopeGlobal, relationScopeContainer, "":
+		*s = relationScope(scope)
+		return nil
+	}
+	return errors.NotValidf("relation scope %q", scope)
 }
 
 type formattedBase struct {
@@ -234,10 +392,64 @@ type unitStatus struct {
 	Address       string                `json:"address,omitempty" yaml:"address,omitempty"`
 	ProviderId    string                `json:"provider-id,omitempty" yaml:"provider-id,omitempty"`
 	Subordinates  map[string]unitStatus `json:"subordinates,omitempty" yaml:"subordinates,omitempty"`
+
+	// OpenedPortRanges holds the structured form of OpenedPorts, for
+	// consumers that need to reason about ranges without parsing them.
+	OpenedPortRanges []portRange `json:"open-port-ranges,omitempty" yaml:"open-port-ranges,omitempty"`
+}
+
+// portRange holds a range of ports opened by a unit. ICMP ranges have no
+// ports.
+type portRange struct {
+	From     int    `json:"from,omitempty" yaml:"from,omitempty"`
+	To       int    `json:"to,omitempty" yaml:"to,omitempty"`
+	Protocol string `json:"protocol" yaml:"protocol"`
+}
+
+// UnitHealth is the bucket a unit falls into when summarising the health
+// of an application's units.
+type UnitHealth string
+
+const (
+	// UnitHealthReady is the health of a unit whose agent is idle or
+	// running.
+	UnitHealthReady UnitHealth = "ready"
+	// UnitHealthBusy is the health of a unit whose agent is executing.
+	UnitHealthBusy UnitHealth = "busy"
+	// UnitHealthError is the health of a unit in error, or whose agent has
+	// failed or been lost.
+	UnitHealthError UnitHealth = "error"
+	// UnitHealthTerminated is the health of a unit whose workload has
+	// terminated.
+	UnitHealthTerminated UnitHealth = "terminated"
+	// UnitHealthAllocating is the health of a unit whose agent isn't up,
+	// either because it hasn't started yet or because it is rebooting.
+	UnitHealthAllocating UnitHealth = "allocating"
+)
+
+// ClassifyUnit returns the health of the unit. A terminated workload takes
+// precedence over any error, and an error over the agent status.
+func ClassifyUnit(u unitStatus) UnitHealth {
+	if u.WorkloadStatusInfo.Current == status.Terminated {
+		return UnitHealthTerminated
+	}
+	if u.WorkloadStatusInfo.Err != nil || u.JujuStatusInfo.Err != nil ||
+		u.WorkloadStatusInfo.Current == status.Error {
+		return UnitHealthError
+	}
+	switch u.JujuStatusInfo.Current {
+	case status.Error, status.Failed, status.Lost:
+		return UnitHealthError
+	case status.Executing:
+		return UnitHealthBusy
+	case status.Idle, status.Running:
+		return UnitHealthReady
+	}
+	return UnitHealthAllocating
 }
 
 func (s *formattedStatus) applicationScale(name string) (string, bool) {
-	// The current unit count are units that are either in Idle or Executing status.
+	// The current unit count are units that are either ready or busy.
 	// In other words, units that are active and available.
 	currentUnitCount := 0
 	desiredUnitCount := 0
@@ -245,25 +457,23 @@ func (s *formattedStatus) applicationScale(name string) (string, bool) {
 	app := s.Applications[name]
 	match := func(u unitStatus) {
 		desiredUnitCount++
-		if u.WorkloadStatusInfo.Current == status.Terminated {
-			return
-		}
-		switch u.JujuStatusInfo.Current {
-		case status.Executing, status.Idle, status.Running:
+		switch ClassifyUnit(u) {
+		case UnitHealthReady, UnitHealthBusy:
 			currentUnitCount++
+		case UnitHealthError:
+			// A unit whose workload is in error is still counted, as
+			// long as its agent is up.
+			switch u.JujuStatusInfo.Current {
+			case status.Executing, status.Idle, status.Running:
+				currentUnitCount++
+			}
 		}
 	}
 	// If the app is subordinate to other units, then this is a subordinate charm.
 	if len(app.SubordinateTo) > 0 {
-		for _, a := range s.Applications {
-			for _, u := range a.Units {
-				for sub, subStatus := range u.Subordinates {
-					if subAppName, _ := names.UnitApplication(sub); subAppName == name {
-						match(subStatus)
-					}
-				}
-			}
-		}
+		s.forEachSubordinateUnit(name, func(_ string, subStatus unitStatus) {
+			match(subStatus)
+		})
 	} else {
 		for _, u := range app.Units {
 			match(u)
@@ -278,6 +488,38 @@ func (s *formattedStatus) applicationScale(name string) (string, bool) {
 	return fmt.Sprintf("%d/%d", currentUnitCount, desiredUnitCount), true
 }
 
+// PrincipalsFor returns the names of the principal units that units of the
+// named subordinate application are deployed alongside, in natural order.
+// Subordinates of subordinates are resolved to the principal at the top of
+// the chain. Units in error are included, as their names are still known.
+func (s *formattedStatus) PrincipalsFor(subAppName string) []string {
+	seen := set.NewStrings()
+	s.forEachSubordinateUnit(subAppName, func(principal string, _ unitStatus) {
+		seen.Add(principal)
+	})
+	return naturalsort.Sort(seen.Values())
+}
+
+// forEachSubordinateUnit calls fn with every unit of the named subordinate
+// application, along with the name of the principal unit it is deployed
+// alongside.
+func (s *formattedStatus) forEachSubordinateUnit(subAppName string, fn func(principal string, sub unitStatus)) {
+	var walk func(principal string, subordinates map[string]unitStatus)
+	walk = func(principal string, subordinates map[string]unitStatus) {
+		for sub, subStatus := range subordinates {
+			if appName, err := names.UnitApplication(sub); err == nil && appName == subAppName {
+				fn(principal, subStatus)
+			}
+			walk(principal, subStatus.Subordinates)
+		}
+	}
+	for _, app := range s.Applications {
+		for principal, u := range app.Units {
+			walk(principal, u.Subordinates)
+		}
+	}
+}
+
 type statusInfoContents struct {
 	Err     error         `json:"-" yaml:",omitempty"`
 	Current status.Status `json:"current,omitempty" yaml:"current,omitempty"`
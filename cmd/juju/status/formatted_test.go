@@ -0,0 +1,255 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"encoding/json"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	goyaml "gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/internal/testing"
+)
+
+type RelationScopeSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&RelationScopeSuite{})
+
+func (s *RelationScopeSuite) TestFormatRelationScope(c *gc.C) {
+	for scope, expected := range map[string]relationScope{
+		"global":     relationScopeGlobal,
+		"container":  relationScopeContainer,
+		"Container":  relationScopeContainer,
+		" global ":   relationScopeGlobal,
+		"":           "",
+		"unexpected": "",
+	} {
+		c.Check(formatRelationScope(scope), gc.Equals, expected, gc.Commentf("scope %q", scope))
+	}
+}
+
+func (s *RelationScopeSuite) TestMarshalJSON(c *gc.C) {
+	out, err := json.Marshal(applicationStatusRelation{
+		RelatedApplicationName: "mysql",
+		Scope:                  relationScopeContainer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(out), gc.Equals, `{"related-application":"mysql","scope":"container"}`)
+
+	out, err = json.Marshal(applicationStatusRelation{RelatedApplicationName: "mysql"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(out), gc.Equals, `{"related-application":"mysql"}`)
+
+	_, err = json.Marshal(applicationStatusRelation{Scope: "unexpected"})
+	c.Check(err, gc.ErrorMatches, `.*relation scope "unexpected" not valid`)
+}
+
+func (s *RelationScopeSuite) TestUnmarshalJSON(c *gc.C) {
+	var rel applicationStatusRelation
+	err := json.Unmarshal([]byte(`{"scope":"global"}`), &rel)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(rel.Scope, gc.Equals, relationScopeGlobal)
+
+	err = json.Unmarshal([]byte(`{"scope":"unexpected"}`), &rel)
+	c.Check(err, gc.ErrorMatches, `relation scope "unexpected" not valid`)
+}
+
+type HAStatusSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&HAStatusSuite{})
+
+func (s *HAStatusSuite) TestMakeHAStatus(c *gc.C) {
+	yes, no := true, false
+	for _, t := range []struct {
+		hasVote   bool
+		wantsVote bool
+		isPrimary *bool
+		expected  haStatus
+	}{
+		{hasVote: true, wantsVote: true, isPrimary: &yes, expected: haStatusPrimary},
+		{hasVote: true, isPrimary: &yes, expected: haStatusPrimary},
+		{hasVote: true, wantsVote: true, isPrimary: &no, expected: haStatusSecondary},
+		{hasVote: true, wantsVote: true, expected: haStatusSecondary},
+		{hasVote: true, expected: haStatusRemovingVote},
+		{wantsVote: true, expected: haStatusAddingVote},
+		{expected: haStatusPassive},
+		{wantsVote: true, isPrimary: &yes, expected: haStatusUnknown},
+	} {
+		c.Check(makeHAStatus(t.hasVote, t.wantsVote, t.isPrimary), gc.Equals, t.expected, gc.Commentf("%+v", t))
+	}
+}
+
+func (s *HAStatusSuite) TestSetHAStatusDerivesPrimary(c *gc.C) {
+	var m machineStatus
+	m.setHAStatus(haStatusPrimary)
+	c.Check(m.HAStatus, gc.Equals, haStatusPrimary)
+	c.Check(m.HAPrimary, jc.IsTrue)
+
+	m.setHAStatus(haStatusSecondary)
+	c.Check(m.HAStatus, gc.Equals, haStatusSecondary)
+	c.Check(m.HAPrimary, jc.IsFalse)
+}
+
+func (s *HAStatusSuite) TestMarshalJSON(c *gc.C) {
+	out, err := json.Marshal(haStatusSecondary)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(out), gc.Equals, `"secondary"`)
+
+	_, err = json.Marshal(haStatus("has-vote"))
+	c.Check(err, gc.ErrorMatches, `.*controller member status "has-vote" not valid`)
+}
+
+func (s *HAStatusSuite) TestUnmarshalJSON(c *gc.C) {
+	var status haStatus
+	err := json.Unmarshal([]byte(`"passive"`), &status)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status, gc.Equals, haStatusPassive)
+
+	err = json.Unmarshal([]byte(`"has-vote"`), &status)
+	c.Check(err, gc.ErrorMatches, `controller member status "has-vote" not valid`)
+}
+
+func (s *HAStatusSuite) TestYAMLRoundTrip(c *gc.C) {
+	for _, status := range []haStatus{
+		haStatusPrimary, haStatusSecondary, haStatusPassive,
+		haStatusAddingVote, haStatusRemovingVote, haStatusUnknown,
+	} {
+		out, err := goyaml.Marshal(machineStatus{HAStatus: status})
+		c.Assert(err, jc.ErrorIsNil)
+
+		var m machineStatus
+		err = goyaml.Unmarshal(out, &m)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(m.HAStatus, gc.Equals, status)
+	}
+}
+
+func (s *HAStatusSuite) TestUnmarshalYAML(c *gc.C) {
+	var status haStatus
+	err := goyaml.Unmarshal([]byte(`removing-vote`), &status)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status, gc.Equals, haStatusRemovingVote)
+
+	err = goyaml.Unmarshal([]byte(`has-vote`), &status)
+	c.Check(err, gc.ErrorMatches, `controller member status "has-vote" not valid`)
+}
+
+type PrincipalsForSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&PrincipalsForSuite{})
+
+func (s *PrincipalsForSuite) TestPrincipalsFor(c *gc.C) {
+	fs := formattedStatus{
+		Applications: map[string]applicationStatus{
+			"mysql": {
+				Units: map[string]unitStatus{
+					"mysql/10": {
+						Subordinates: map[string]unitStatus{
+							"logging/1": {},
+						},
+					},
+					"mysql/2": {
+						Subordinates: map[string]unitStatus{
+							"logging/0": {
+								WorkloadStatusInfo: statusInfoContents{Err: errors.New("boom")},
+							},
+						},
+					},
+				},
+			},
+			"wordpress": {
+				Units: map[string]unitStatus{
+					"wordpress/0": {
+						Subordinates: map[string]unitStatus{
+							"filebeat/0": {
+								Subordinates: map[string]unitStatus{
+									"logging/2": {},
+								},
+							},
+						},
+					},
+					"wordpress/1": {},
+				},
+			},
+			"logging": {SubordinateTo: []string{"mysql", "wordpress"}},
+		},
+	}
+
+	c.Check(fs.PrincipalsFor("logging"), jc.DeepEquals, []string{"mysql/2", "mysql/10", "wordpress/0"})
+	c.Check(fs.PrincipalsFor("filebeat"), jc.DeepEquals, []string{"wordpress/0"})
+	c.Check(fs.PrincipalsFor("mysql"), gc.HasLen, 0)
+	c.Check(fs.PrincipalsFor("unknown"), gc.HasLen, 0)
+}
+
+type ClassifyUnitSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&ClassifyUnitSuite{})
+
+func (s *ClassifyUnitSuite) TestClassifyUnit(c *gc.C) {
+	unit := func(workload, agent status.Status) unitStatus {
+		return unitStatus{
+			WorkloadStatusInfo: statusInfoContents{Current: workload},
+			JujuStatusInfo:     statusInfoContents{Current: agent},
+		}
+	}
+	for i, t := range []struct {
+		unit     unitStatus
+		expected UnitHealth
+	}{
+		{unit: unit(status.Active, status.Idle), expected: UnitHealthReady},
+		{unit: unit(status.Active, status.Running), expected: UnitHealthReady},
+		{unit: unit(status.Maintenance, status.Executing), expected: UnitHealthBusy},
+		{unit: unit(status.Active, status.Rebooting), expected: UnitHealthAllocating},
+		{unit: unit(status.Error, status.Idle), expected: UnitHealthError},
+		{unit: unit(status.Active, status.Failed), expected: UnitHealthError},
+		{unit: unit(status.Active, status.Lost), expected: UnitHealthError},
+		{unit: unitStatus{
+			WorkloadStatusInfo: statusInfoContents{Err: errors.New("boom")},
+		}, expected: UnitHealthError},
+		{unit: unit(status.Terminated, status.Error), expected: UnitHealthTerminated},
+		{unit: unit(status.Waiting, status.Allocating), expected: UnitHealthAllocating},
+		{unit: unitStatus{}, expected: UnitHealthAllocating},
+	} {
+		c.Check(ClassifyUnit(t.unit), gc.Equals, t.expected, gc.Commentf("test %d", i))
+	}
+}
+
+func (s *ClassifyUnitSuite) TestApplicationScale(c *gc.C) {
+	unit := func(workload, agent status.Status) unitStatus {
+		return unitStatus{
+			WorkloadStatusInfo: statusInfoContents{Current: workload},
+			JujuStatusInfo:     statusInfoContents{Current: agent},
+		}
+	}
+	fs := formattedStatus{
+		Applications: map[string]applicationStatus{
+			"mysql": {
+				Units: map[string]unitStatus{
+					"mysql/0": unit(status.Active, status.Idle),
+					"mysql/1": unit(status.Maintenance, status.Executing),
+					"mysql/2": unit(status.Error, status.Idle),
+					"mysql/3": unit(status.Active, status.Rebooting),
+					"mysql/4": unit(status.Terminated, status.Idle),
+					"mysql/5": unit(status.Active, status.Lost),
+					"mysql/6": unit(status.Waiting, status.Allocating),
+				},
+			},
+		},
+	}
+
+	scale, warn := fs.applicationScale("mysql")
+	c.Check(scale, gc.Equals, "3/7")
+	c.Check(warn, jc.IsTrue)
+}
@@ -6,18 +6,25 @@ package status
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/juju/storage"
 	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/instance"
 	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/rpc/params"
 )
 
+// maxClockSkew is the difference between the controller and client clocks
+// above which status reports a clock skew warning.
+const maxClockSkew = time.Minute
+
 type statusFormatter struct {
 	status                 *params.FullStatus
 	controllerName         string
@@ -25,6 +32,7 @@ type statusFormatter struct {
 	relations              map[int]params.RelationStatus
 	storage                *storage.CombinedStorage
 	isoTime, showRelations bool
+	now                    time.Time
 }
 
 // NewStatusFormatterParams contains the parameters required
@@ -36,6 +44,9 @@ type NewStatusFormatterParams struct {
 	OutputName     string
 	ISOTime        bool
 	ShowRelations  bool
+	// Now is the client's local time, used to detect skew against the
+	// controller timestamp. No check is made if it is zero.
+	Now time.Time
 }
 
 // NewStatusFormatter returns a new status formatter used in various
@@ -49,6 +60,7 @@ func NewStatusFormatter(p NewStatusFormatterParams) *statusFormatter {
 		isoTime:        p.ISOTime,
 		showRelations:  p.ShowRelations,
 		outputName:     p.OutputName,
+		now:            p.Now,
 	}
 	if p.ShowRelations {
 		for _, relation := range p.Status.Relations {
@@ -87,6 +99,7 @@ func (sf *statusFormatter) Format() (formattedStatus, error) {
 	if sf.status.ControllerTimestamp != nil {
 		out.Controller = &controllerStatus{
 			Timestamp: common.FormatTimeAsTimestamp(sf.status.ControllerTimestamp, sf.isoTime),
+			ClockSkew: formatClockSkew(*sf.status.ControllerTimestamp, sf.now),
 		}
 	}
 	for k, m := range sf.status.Machines {
@@ -112,6 +125,25 @@ func (sf *statusFormatter) Format() (formattedStatus, error) {
 	return out, nil
 }
 
+// formatClockSkew returns a warning describing the skew between the
+// controller and local clocks, or an empty string if the skew is within
+// maxClockSkew or the local time is unknown.
+func formatClockSkew(controllerTime, localTime time.Time) string {
+	if localTime.IsZero() {
+		return ""
+	}
+	skew := controllerTime.Sub(localTime)
+	direction := "ahead of"
+	if skew < 0 {
+		skew = -skew
+		direction = "behind"
+	}
+	if skew <= maxClockSkew {
+		return ""
+	}
+	return fmt.Sprintf("controller clock is %s %s local clock", skew.Round(time.Second), direction)
+}
+
 // MachineFormat takes stored model information (params.FullStatus) and formats machine status info.
 func (sf *statusFormatter) MachineFormat(machineId []string) formattedMachineStatus {
 	if sf.status == nil {
@@ -160,6 +192,7 @@ func (sf *statusFormatter) formatMachine(machine params.MachineStatus) machineSt
 		Containers:         make(map[string]machineStatus),
 		Constraints:        machine.Constraints,
 		Hardware:           machine.Hardware,
+		HardwareDetails:    formatHardwareDetails(machine.Hardware),
 		LXDProfiles:        make(map[string]lxdProfileContents),
 	}
 
@@ -180,11 +213,7 @@ func (sf *statusFormatter) formatMachine(machine params.MachineStatus) machineSt
 
 	for _, job := range machine.Jobs {
 		if job == coremodel.JobManageModel {
-			out.HAStatus = makeHAStatus(machine.HasVote, machine.WantsVote)
-			isPrimary := machine.PrimaryControllerMachine
-			if isPrimary != nil {
-				out.HAPrimary = *isPrimary
-			}
+			out.setHAStatus(makeHAStatus(machine.HasVote, machine.WantsVote, machine.PrimaryControllerMachine))
 			break
 		}
 	}
@@ -194,12 +223,43 @@ func (sf *statusFormatter) formatMachine(machine params.MachineStatus) machineSt
 			Config:      v.Config,
 			Description: v.Description,
 			Devices:     v.Devices,
+			ConfigDrift: v.ConfigDrift,
 		}
 	}
 
 	return out
 }
 
+// formatHardwareDetails returns the structured form of the hardware
+// characteristics string reported for a machine, or nil if there are none.
+func formatHardwareDetails(hardware string) *hardwareDetails {
+	if hardware == "" {
+		return nil
+	}
+	hw, err := instance.ParseHardware(hardware)
+	if err != nil {
+		logger.Warningf(context.TODO(), "invalid hardware info %q: %v", hardware, err)
+		return nil
+	}
+	var details hardwareDetails
+	if hw.Arch != nil {
+		details.Arch = *hw.Arch
+	}
+	if hw.CpuCores != nil {
+		details.Cores = *hw.CpuCores
+	}
+	if hw.Mem != nil {
+		details.Mem = *hw.Mem
+	}
+	if hw.RootDisk != nil {
+		details.RootDisk = *hw.RootDisk
+	}
+	if hw.AvailabilityZone != nil {
+		details.AvailabilityZone = *hw.AvailabilityZone
+	}
+	return &details
+}
+
 func (sf *statusFormatter) formatApplication(name string, application params.ApplicationStatus) applicationStatus {
 	var (
 		charmAlias  = ""
@@ -227,11 +287,22 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 		charmName = curl.Name
 	}
 
+	var charmTrack, charmRisk string
+	if application.CharmChannel != "" {
+		if ch, err := charm.ParseChannelNormalize(application.CharmChannel); err != nil {
+			logger.Warningf(context.TODO(), "invalid charm channel %q: %v", application.CharmChannel, err)
+		} else {
+			charmTrack = ch.Track
+			charmRisk = string(ch.Risk)
+		}
+	}
+
 	var base *formattedBase
 	channel, err := corebase.ParseChannel(application.Base.Channel)
 	if err == nil {
 		base = &formattedBase{Name: application.Base.Name, Channel: channel.DisplayString()}
 	}
+	version, versionMismatch := aggregateWorkloadVersion(application.WorkloadVersion, application.Units)
 	out := applicationStatus{
 		Err:              typedNilCheck(application.Err),
 		Charm:            charmAlias,
@@ -240,9 +311,13 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 		CharmName:        charmName,
 		CharmRev:         charmRev,
 		CharmVersion:     application.CharmVersion,
+		CharmFullVersion: charmFullVersion(charmRev, application.CharmVersion),
 		CharmProfile:     application.CharmProfile,
 		CharmChannel:     application.CharmChannel,
+		CharmTrack:       charmTrack,
+		CharmRisk:        charmRisk,
 		Exposed:          application.Exposed,
+		ExposedEndpoints: formatExposedEndpoints(application.ExposedEndpoints),
 		Life:             string(application.Life),
 		Scale:            application.Scale,
 		ProviderId:       application.ProviderId,
@@ -252,7 +327,8 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 		SubordinateTo:    application.SubordinateTo,
 		Units:            make(map[string]unitStatus),
 		StatusInfo:       sf.getApplicationStatusInfo(application),
-		Version:          application.WorkloadVersion,
+		Version:          version,
+		VersionMismatch:  versionMismatch,
 		EndpointBindings: application.EndpointBindings,
 	}
 
@@ -267,6 +343,73 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 	return out
 }
 
+// formatExposedEndpoints returns the per-endpoint expose settings of an
+// application, keyed by endpoint name. The empty endpoint name applies to
+// all endpoints.
+func formatExposedEndpoints(endpoints map[string]params.ExposedEndpoint) map[string]exposedEndpointDetail {
+	if len(endpoints) == 0 {
+		return nil
+	}
+	out := make(map[string]exposedEndpointDetail, len(endpoints))
+	for name, details := range endpoints {
+		out[name] = exposedEndpointDetail{
+			ExposeToSpaces: details.ExposeToSpaces,
+			ExposeToCIDRs:  details.ExposeToCIDRs,
+		}
+	}
+	return out
+}
+
+// charmFullVersion combines the charm revision and its VCS version, as
+// "<rev>+<version>", so that both can be read at a glance. An empty string is
+// returned if the charm has no version.
+func charmFullVersion(rev int, version string) string {
+	if version == "" {
+		return ""
+	}
+	return fmt.Sprintf("%d+%s", rev, version)
+}
+
+// aggregateWorkloadVersion returns the most common workload version reported
+// by the units, and whether the units report differing versions, such as
+// during an upgrade. Units that haven't reported a version are ignored. If
+// no unit has, the application's version is returned. Ties are resolved in
+// favour of the application's version, then the lowest version string.
+func aggregateWorkloadVersion(appVersion string, units map[string]params.UnitStatus) (string, bool) {
+	counts := make(map[string]int)
+	for _, unit := range units {
+		if unit.WorkloadVersion != "" {
+			counts[unit.WorkloadVersion]++
+		}
+	}
+	if len(counts) == 0 {
+		return appVersion, false
+	}
+
+	var (
+		version string
+		best    int
+	)
+	for v, n := range counts {
+		if n > best || (n == best && preferVersion(v, version, appVersion)) {
+			version, best = v, n
+		}
+	}
+	return version, len(counts) > 1
+}
+
+// preferVersion reports whether candidate should be chosen over current when
+// both are reported by the same number of units.
+func preferVersion(candidate, current, appVersion string) bool {
+	switch {
+	case candidate == appVersion:
+		return true
+	case current == appVersion:
+		return false
+	}
+	return candidate < current
+}
+
 func (sf *statusFormatter) processApplicationRelations(appName string, rels map[string][]string) map[string][]applicationStatusRelation {
 	out := make(map[string][]applicationStatusRelation)
 	for relName, theOtherSideAppNames := range rels {
@@ -279,7 +422,7 @@ func (sf *statusFormatter) processApplicationRelations(appName string, rels map[
 			out[relName] = append(out[relName], applicationStatusRelation{
 				RelatedApplicationName: endpointAppName,
 				Interface:              relStatus.Interface,
-				Scope:                  relStatus.Scope,
+				Scope:                  formatRelationScope(relStatus.Scope),
 			})
 		}
 	}
@@ -355,7 +498,7 @@ func (sf *statusFormatter) formatRelation(rel params.RelationStatus) relationSta
 	}
 	var relType string
 	switch {
-	case rel.Scope == "container":
+	case formatRelationScope(rel.Scope) == relationScopeContainer:
 		relType = "subordinate"
 	case provider.ApplicationName == requirer.ApplicationName:
 		relType = "peer"
@@ -444,6 +587,7 @@ func (sf *statusFormatter) formatUnit(info unitFormatInfo) unitStatus {
 		JujuStatusInfo:     sf.getAgentStatusInfo(info.unit),
 		Machine:            info.unit.Machine,
 		OpenedPorts:        info.unit.OpenedPorts,
+		OpenedPortRanges:   formatOpenedPortRanges(info.unit.OpenedPorts),
 		ProviderId:         info.unit.ProviderId,
 		Address:            info.unit.Address,
 		PublicAddress:      info.unit.PublicAddress,
@@ -462,6 +606,26 @@ func (sf *statusFormatter) formatUnit(info unitFormatInfo) unitStatus {
 	return out
 }
 
+// formatOpenedPortRanges returns the structured form of the opened ports
+// reported for a unit, such as "80/tcp" or "1000-2000/udp". Ports that
+// cannot be parsed are skipped.
+func formatOpenedPortRanges(openedPorts []string) []portRange {
+	var out []portRange
+	for _, p := range openedPorts {
+		pr, err := network.ParsePortRange(p)
+		if err != nil {
+			logger.Warningf(context.TODO(), "invalid opened port range %q: %v", p, err)
+			continue
+		}
+		r := portRange{Protocol: pr.Protocol}
+		if pr.FromPort > 0 {
+			r.From, r.To = pr.FromPort, pr.ToPort
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
 func (sf *statusFormatter) getStatusInfoContents(inst params.DetailedStatus) statusInfoContents {
 	// TODO(perrito66) add status validation.
 	info := statusInfoContents{
@@ -522,19 +686,25 @@ func (sf *statusFormatter) updateUnitStatusInfo(unit *params.UnitStatus, applica
 	}
 }
 
-func makeHAStatus(hasVote, wantsVote bool) string {
-	var s string
+// makeHAStatus maps the replica set state of a controller machine onto its
+// controller member status. Only the primary is reported with isPrimary set.
+func makeHAStatus(hasVote, wantsVote bool, isPrimary *bool) haStatus {
+	primary := isPrimary != nil && *isPrimary
 	switch {
+	case primary && hasVote:
+		return haStatusPrimary
+	case primary:
+		// The primary must have a vote, so the state can't be trusted.
+		return haStatusUnknown
 	case hasVote && wantsVote:
-		s = "has-vote"
-	case hasVote && !wantsVote:
-		s = "removing-vote"
-	case !hasVote && wantsVote:
-		s = "adding-vote"
-	case !hasVote && !wantsVote:
-		s = "no-vote"
-	}
-	return s
+		return haStatusSecondary
+	case hasVote:
+		return haStatusRemovingVote
+	case wantsVote:
+		return haStatusAddingVote
+	default:
+		return haStatusPassive
+	}
 }
 
 func getRelationIdFromData(unit *params.UnitStatus) int {
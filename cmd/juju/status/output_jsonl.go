@@ -0,0 +1,58 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/juju/errors"
+	"github.com/juju/naturalsort"
+)
+
+const (
+	jsonLineKindMachine     = "machine"
+	jsonLineKindApplication = "application"
+)
+
+// jsonLine is a single line of the JSON lines status output. Kind tells
+// consumers which of the formatted status types Status holds.
+type jsonLine struct {
+	Kind   string      `json:"kind"`
+	Name   string      `json:"name"`
+	Status interface{} `json:"status"`
+}
+
+// FormatJSONLines writes the status as JSON lines, with one JSON object per
+// machine followed by one per application. Each object is written as soon
+// as it is encoded, so that the output can be streamed into tools which
+// consume a line at a time.
+func FormatJSONLines(writer io.Writer, value interface{}) error {
+	fs, valueConverted := value.(formattedStatus)
+	if !valueConverted {
+		return errors.Errorf("expected value of type %T, got %T", fs, value)
+	}
+
+	// The encoder terminates each value with a newline.
+	encoder := json.NewEncoder(writer)
+	for _, id := range naturalsort.Sort(stringKeysFromMap(fs.Machines)) {
+		if err := encoder.Encode(jsonLine{
+			Kind:   jsonLineKindMachine,
+			Name:   id,
+			Status: fs.Machines[id],
+		}); err != nil {
+			return errors.Annotatef(err, "encoding machine %q", id)
+		}
+	}
+	for _, name := range naturalsort.Sort(stringKeysFromMap(fs.Applications)) {
+		if err := encoder.Encode(jsonLine{
+			Kind:   jsonLineKindApplication,
+			Name:   name,
+			Status: fs.Applications[name],
+		}); err != nil {
+			return errors.Annotatef(err, "encoding application %q", name)
+		}
+	}
+	return nil
+}
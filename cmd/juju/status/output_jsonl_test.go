@@ -0,0 +1,74 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/testing"
+)
+
+type JSONLinesSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&JSONLinesSuite{})
+
+func (s *JSONLinesSuite) TestFormatJSONLines(c *gc.C) {
+	fs := formattedStatus{
+		Machines: map[string]machineStatus{
+			"10": {Hostname: "host-10"},
+			"2":  {Err: errors.New("boom")},
+		},
+		Applications: map[string]applicationStatus{
+			"mysql": {Charm: "ch:mysql", CharmName: "mysql", CharmRev: 1},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := FormatJSONLines(&buf, fs)
+	c.Assert(err, jc.ErrorIsNil)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	c.Assert(lines, gc.HasLen, 3)
+
+	var decoded []map[string]interface{}
+	for _, line := range lines {
+		var obj map[string]interface{}
+		c.Assert(json.Unmarshal([]byte(line), &obj), jc.ErrorIsNil, gc.Commentf("line %q", line))
+		decoded = append(decoded, obj)
+	}
+
+	// Machines come first, in natural order, followed by applications.
+	c.Check(decoded[0]["kind"], gc.Equals, "machine")
+	c.Check(decoded[0]["name"], gc.Equals, "2")
+	c.Check(decoded[0]["status"], jc.DeepEquals, map[string]interface{}{
+		"status-error": "boom",
+	})
+	c.Check(decoded[1]["kind"], gc.Equals, "machine")
+	c.Check(decoded[1]["name"], gc.Equals, "10")
+	c.Check(decoded[1]["status"].(map[string]interface{})["hostname"], gc.Equals, "host-10")
+	c.Check(decoded[2]["kind"], gc.Equals, "application")
+	c.Check(decoded[2]["name"], gc.Equals, "mysql")
+	c.Check(decoded[2]["status"].(map[string]interface{})["charm-name"], gc.Equals, "mysql")
+}
+
+func (s *JSONLinesSuite) TestFormatJSONLinesEmpty(c *gc.C) {
+	var buf bytes.Buffer
+	err := FormatJSONLines(&buf, formattedStatus{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(buf.String(), gc.Equals, "")
+}
+
+func (s *JSONLinesSuite) TestFormatJSONLinesWrongType(c *gc.C) {
+	var buf bytes.Buffer
+	err := FormatJSONLines(&buf, "not a status")
+	c.Assert(err, gc.ErrorMatches, `expected value of type status.formattedStatus, got string`)
+}
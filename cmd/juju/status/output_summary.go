@@ -20,6 +20,7 @@ import (
 
 	"github.com/juju/juju/core/output"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/rpc/params"
 )
 
 func (c *statusCommand) formatSummary(writer io.Writer, value interface{}) error {
@@ -274,3 +275,35 @@ func (f *summaryFormatter) aggregateApplicationAndUnitStates(applications map[st
 	}
 	return svcExposure
 }
+
+// formatStatusCounts returns a one-line summary of the application and unit
+// counts computed by the controller, such as
+// "Applications: 2 (1 active, 1 blocked), Units: 3 (3 active)".
+func formatStatusCounts(summary params.StatusSummary) string {
+	return fmt.Sprintf("Applications: %s, Units: %s",
+		formatCounts(summary.Applications), formatCounts(summary.Units))
+}
+
+func formatCounts(counts params.StatusCounts) string {
+	total := counts.Total()
+	if total == 0 {
+		return "0"
+	}
+	var buckets []string
+	for _, bucket := range []struct {
+		name  string
+		count int
+	}{
+		{name: string(status.Active), count: counts.Active},
+		{name: string(status.Blocked), count: counts.Blocked},
+		{name: string(status.Waiting), count: counts.Waiting},
+		{name: string(status.Error), count: counts.Error},
+		{name: string(status.Maintenance), count: counts.Maintenance},
+		{name: "other", count: counts.Other},
+	} {
+		if bucket.count > 0 {
+			buckets = append(buckets, fmt.Sprintf("%d %s", bucket.count, bucket.name))
+		}
+	}
+	return fmt.Sprintf("%d (%s)", total, strings.Join(buckets, ", "))
+}
@@ -86,6 +86,10 @@ func FormatTabular(writer io.Writer, forceColor bool, value interface{}) error {
 	}
 
 	w.Println(values[versionPos:]...)
+	if cs := fs.Controller; cs != nil && cs.ClockSkew != "" {
+		w.PrintColorNoTab(output.WarningHighlight, "Warning: "+cs.ClockSkew)
+		w.Println()
+	}
 
 	if len(fs.RemoteApplications) > 0 {
 		printRemoteApplications(tw, fs.RemoteApplications)
@@ -0,0 +1,188 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+	// enumTypes holds the allowed values of string types that are
+	// restricted to a set of constants.
+	enumTypes = map[reflect.Type][]string{
+		reflect.TypeOf(relationScope("")): {
+			string(relationScopeGlobal),
+			string(relationScopeContainer),
+		},
+	}
+)
+
+// StatusJSONSchema returns a JSON Schema describing the output of
+// `juju status --format=json`. The schema is generated from the json
+// struct tags of the formatted status types, so it always matches the
+// structure that is marshalled.
+func StatusJSONSchema() ([]byte, error) {
+	g := &schemaGenerator{
+		names:       make(map[reflect.Type]string),
+		definitions: make(map[string]interface{}),
+	}
+	schema := g.structSchema(reflect.TypeOf(formattedStatus{}))
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = "juju status"
+	schema["definitions"] = g.definitions
+
+	out, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out, nil
+}
+
+// schemaGenerator builds JSON Schema documents for Go types. Named struct
+// types are emitted once as definitions and referenced elsewhere, which
+// also allows recursive types such as machine containers.
+type schemaGenerator struct {
+	names       map[reflect.Type]string
+	definitions map[string]interface{}
+}
+
+func (g *schemaGenerator) schemaFor(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	if values, ok := enumTypes[t]; ok {
+		return map[string]interface{}{"type": "string", "enum": values}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are marshalled as base64 strings.
+			return map[string]interface{}{"type": "string"}
+		}
+		return map[string]interface{}{
+			"type":  "array",
+			"items": g.schemaFor(t.Elem()),
+		}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": g.schemaFor(t.Elem()),
+		}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return g.refFor(t)
+	}
+	// Interfaces and anything else can hold any value.
+	return map[string]interface{}{}
+}
+
+// refFor returns a reference to the definition of the named struct type,
+// generating the definition the first time the type is seen.
+func (g *schemaGenerator) refFor(t reflect.Type) map[string]interface{} {
+	name, ok := g.names[t]
+	if !ok {
+		name = g.definitionName(t)
+		g.names[t] = name
+		// Reserve the name before recursing so that recursive types
+		// resolve to the same definition.
+		g.definitions[name] = nil
+		g.definitions[name] = g.structSchema(t)
+	}
+	return map[string]interface{}{"$ref": "#/definitions/" + name}
+}
+
+func (g *schemaGenerator) definitionName(t reflect.Type) string {
+	name := t.Name()
+	if _, taken := g.definitions[name]; taken {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+	return name
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+	hasErr := g.addFields(t, properties, &required)
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	if hasErr {
+		// Entities that failed to be read are replaced by an error
+		// status when marshalled.
+		return map[string]interface{}{
+			"anyOf": []interface{}{schema, g.refFor(reflect.TypeOf(errorStatus{}))},
+		}
+	}
+	return schema
+}
+
+// addFields adds the json fields of the struct type to the properties,
+// flattening embedded structs. It reports whether the struct has a hidden
+// error field.
+func (g *schemaGenerator) addFields(t reflect.Type, properties map[string]interface{}, required *[]string) bool {
+	var hasErr bool
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			if field.Type == errorType {
+				hasErr = true
+			}
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				hasErr = g.addFields(ft, properties, required) || hasErr
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = g.schemaFor(field.Type)
+		if !strings.Contains(opts, "omitempty") {
+			*required = append(*required, name)
+		}
+	}
+	return hasErr
+}
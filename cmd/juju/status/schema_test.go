@@ -0,0 +1,95 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"encoding/json"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/testing"
+)
+
+type SchemaSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&SchemaSuite{})
+
+func (s *SchemaSuite) schema(c *gc.C) map[string]interface{} {
+	out, err := StatusJSONSchema()
+	c.Assert(err, jc.ErrorIsNil)
+
+	var schema map[string]interface{}
+	err = json.Unmarshal(out, &schema)
+	c.Assert(err, jc.ErrorIsNil)
+	return schema
+}
+
+func (s *SchemaSuite) definition(c *gc.C, schema map[string]interface{}, name string) map[string]interface{} {
+	definitions, ok := schema["definitions"].(map[string]interface{})
+	c.Assert(ok, jc.IsTrue)
+	def, ok := definitions[name].(map[string]interface{})
+	c.Assert(ok, jc.IsTrue, gc.Commentf("definition %q not found", name))
+	return def
+}
+
+func (s *SchemaSuite) TestTopLevel(c *gc.C) {
+	schema := s.schema(c)
+	c.Check(schema["$schema"], gc.Equals, jsonSchemaDraft)
+	c.Check(schema["type"], gc.Equals, "object")
+	c.Check(schema["required"], jc.SameContents, []interface{}{"model", "machines", "applications"})
+
+	properties := schema["properties"].(map[string]interface{})
+	c.Check(properties["model"], jc.DeepEquals, map[string]interface{}{
+		"$ref": "#/definitions/modelStatus",
+	})
+	c.Check(properties["machines"], jc.DeepEquals, map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": map[string]interface{}{"$ref": "#/definitions/machineStatus"},
+	})
+	// Fields hidden from the json output are not described.
+	_, ok := properties["Relations"]
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *SchemaSuite) TestFieldsFromTags(c *gc.C) {
+	model := s.definition(c, s.schema(c), "modelStatus")
+	properties := model["properties"].(map[string]interface{})
+	c.Check(properties["name"], jc.DeepEquals, map[string]interface{}{"type": "string"})
+	c.Check(properties["region"], jc.DeepEquals, map[string]interface{}{"type": "string"})
+	// Omitted when empty, so not required.
+	c.Check(model["required"], jc.SameContents, []interface{}{"name", "type", "controller", "cloud", "version"})
+}
+
+func (s *SchemaSuite) TestErrorStatusAlternative(c *gc.C) {
+	schema := s.schema(c)
+	machine := s.definition(c, schema, "machineStatus")
+	anyOf, ok := machine["anyOf"].([]interface{})
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(anyOf, gc.HasLen, 2)
+	c.Check(anyOf[1], jc.DeepEquals, map[string]interface{}{
+		"$ref": "#/definitions/errorStatus",
+	})
+
+	// Containers refer back to the machine definition.
+	properties := anyOf[0].(map[string]interface{})["properties"].(map[string]interface{})
+	c.Check(properties["containers"], jc.DeepEquals, map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": map[string]interface{}{"$ref": "#/definitions/machineStatus"},
+	})
+
+	errStatus := s.definition(c, schema, "errorStatus")
+	c.Check(errStatus["required"], jc.DeepEquals, []interface{}{"status-error"})
+}
+
+func (s *SchemaSuite) TestEnum(c *gc.C) {
+	relation := s.definition(c, s.schema(c), "applicationStatusRelation")
+	properties := relation["properties"].(map[string]interface{})
+	c.Check(properties["scope"], jc.DeepEquals, map[string]interface{}{
+		"type": "string",
+		"enum": []interface{}{"global", "container"},
+	})
+}
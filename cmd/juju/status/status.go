@@ -32,6 +32,8 @@ var logger = internallogger.GetLogger("juju.cmd.juju.status")
 
 type statusAPI interface {
 	Status(context.Context, *client.StatusArgs) (*params.FullStatus, error)
+	StatusSummary(context.Context) (params.StatusSummary, error)
+	BestAPIVersion() int
 	Close() error
 }
 
@@ -45,6 +47,7 @@ func NewStatusCommand() cmd.Command {
 
 // Clock defines the methods needed for the status command.
 type Clock interface {
+	Now() time.Time
 	After(time.Duration) <-chan time.Time
 }
 
@@ -71,6 +74,15 @@ type statusCommand struct {
 
 	// storage indicates if 'storage' section is displayed
 	storage bool
+
+	// schema indicates that the JSON Schema of the json output should be
+	// printed instead of the status.
+	schema bool
+
+	// summary indicates that a one-line summary of the application and
+	// unit statuses, computed by the controller, should be printed
+	// instead of the status.
+	summary bool
 }
 
 var usageSummary = `
@@ -118,7 +130,16 @@ The '--format' option allows you to specify how the status report is formatted.
   --format=json
   --format=yaml
                     Provide information in a JSON or YAML formats for 
-                    programmatic use.
+                    programmatic use. Use the '--schema' option to print
+                    a JSON Schema describing the JSON output.
+
+  --format=jsonl
+                    Provide information as JSON lines, with one JSON object
+                    per machine and per application. Each object has a
+                    'kind' field of either "machine" or "application", a
+                    'name' field and a 'status' field holding the same
+                    information as the JSON format. Suitable for streaming
+                    into log shippers and line oriented tools.
 `
 
 const usageExamples = `
@@ -142,6 +163,10 @@ Provide output as valid JSON:
 
     juju status --format=json
 
+Print the JSON Schema of the JSON output:
+
+    juju status --schema
+
 Show only applications/units in active status:
 
     juju status active
@@ -176,6 +201,8 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.integrations, "integrations", false, "Show 'integrations' section in tabular output")
 	f.BoolVar(&c.relations, "relations", false, "The same as '--integrations'")
 	f.BoolVar(&c.storage, "storage", false, "Show 'storage' section in tabular output")
+	f.BoolVar(&c.schema, "schema", false, "Print the JSON Schema of the JSON output and exit")
+	f.BoolVar(&c.summary, "summary", false, "Print a one-line summary of application and unit statuses and exit")
 
 	f.IntVar(&c.retryCount, "retry-count", 3, "Number of times to retry API failures")
 	f.DurationVar(&c.retryDelay, "retry-delay", 100*time.Millisecond, "Time to wait between retry attempts")
@@ -201,6 +228,7 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.out.AddFlags(f, defaultFormat, map[string]cmd.Formatter{
 		"yaml":    c.formatYaml,
 		"json":    c.formatJson,
+		"jsonl":   FormatJSONLines,
 		"short":   c.formatOneline,
 		"oneline": c.formatOneline,
 		"line":    c.formatOneline,
@@ -229,6 +257,9 @@ func (c *statusCommand) Init(args []string) error {
 	if c.color && c.noColor {
 		return errors.Errorf("cannot mix --no-color and --color")
 	}
+	if c.summary && len(c.patterns) > 0 {
+		return errors.Errorf("cannot use --summary with selectors")
+	}
 
 	return nil
 }
@@ -319,6 +350,7 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		OutputName:     c.out.Name(),
 		ISOTime:        c.isoTime,
 		ShowRelations:  showIntegrations,
+		Now:            c.clock.Now(),
 	}
 	if showStorage {
 		// TODO: move this into StatusFormatter
@@ -382,6 +414,19 @@ func (c *statusCommand) statusCommandAllArgs(args []string) []string {
 func (c *statusCommand) Run(ctx *cmd.Context) error {
 	defer c.close()
 
+	if c.schema {
+		schema, err := StatusJSONSchema()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, err = fmt.Fprintln(ctx.Stdout, string(schema))
+		return errors.Trace(err)
+	}
+
+	if c.summary {
+		return c.runSummary(ctx)
+	}
+
 	err := c.runStatus(ctx)
 	if err != nil {
 		return err
@@ -390,6 +435,25 @@ func (c *statusCommand) Run(ctx *cmd.Context) error {
 	return nil
 }
 
+// runSummary prints a one-line summary of the application and unit
+// statuses in the model. The counts are computed by the controller, so
+// that large models don't need to be fetched and aggregated here.
+func (c *statusCommand) runSummary(ctx *cmd.Context) error {
+	apiclient, err := c.getStatusAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if apiclient.BestAPIVersion() < 9 {
+		return errors.New("--summary is not supported by this controller")
+	}
+	summary, err := apiclient.StatusSummary(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = fmt.Fprintln(ctx.Stdout, formatStatusCounts(summary))
+	return errors.Trace(err)
+}
+
 func (c *statusCommand) formatYaml(writer io.Writer, value interface{}) error {
 	var noColor bool
 
@@ -202,6 +202,7 @@ var (
 			},
 		},
 		"hardware":                 "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware-details":         M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 		"controller-member-status": "adding-vote",
 	}
 	machine1 = M{
@@ -229,7 +230,8 @@ var (
 				"is-up":        true,
 			},
 		},
-		"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 	}
 	machine1WithLXDProfile = M{
 		"juju-status": M{
@@ -256,7 +258,8 @@ var (
 				"is-up":        true,
 			},
 		},
-		"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 		"lxd-profiles": M{
 			"juju-controller-lxd-profile-1": M{
 				"config": M{
@@ -312,7 +315,8 @@ var (
 				"is-up":        true,
 			},
 		},
-		"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 	}
 	machine3 = M{
 		"juju-status": M{
@@ -340,7 +344,8 @@ var (
 				"is-up":        true,
 			},
 		},
-		"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 	}
 	machine4 = M{
 		"juju-status": M{
@@ -367,7 +372,8 @@ var (
 				"is-up":        true,
 			},
 		},
-		"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 	}
 	machine1WithContainers = M{
 		"juju-status": M{
@@ -464,7 +470,8 @@ var (
 				"is-up":        true,
 			},
 		},
-		"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+		"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 	}
 	unexposedApplication = dummyCharm(M{
 		"application-status": M{
@@ -485,6 +492,7 @@ var (
 		"charm-name":    "logging",
 		"charm-rev":     1,
 		"charm-channel": "stable",
+		"charm-risk":    "stable",
 		"base":          M{"name": "ubuntu", "channel": "12.10"},
 		"exposed":       true,
 		"scale":         2,
@@ -611,6 +619,7 @@ var statusTests = []testCase{
 							},
 						},
 						"hardware":                 "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware-details":         M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 						"controller-member-status": "adding-vote",
 					},
 				},
@@ -658,6 +667,7 @@ var statusTests = []testCase{
 							},
 						},
 						"hardware":                 "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware-details":         M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 						"controller-member-status": "adding-vote",
 					},
 				},
@@ -706,6 +716,7 @@ var statusTests = []testCase{
 							},
 						},
 						"hardware":                 "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware-details":         M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 						"controller-member-status": "adding-vote",
 					},
 				},
@@ -762,6 +773,7 @@ var statusTests = []testCase{
 						},
 						"constraints":              "cores=2 mem=8192M root-disk=8192M",
 						"hardware":                 "arch=amd64 cores=2 mem=8192M root-disk=8192M",
+						"hardware-details":         M{"arch": "amd64", "cores": 2, "mem": 8192, "root-disk": 8192},
 						"controller-member-status": "adding-vote",
 					},
 				},
@@ -800,6 +812,7 @@ var statusTests = []testCase{
 						"base":                     M{"name": "ubuntu", "channel": "12.10"},
 						"constraints":              "cores=2 mem=8192M root-disk=8192M",
 						"hardware":                 "arch=amd64 cores=2 mem=8192M root-disk=8192M",
+						"hardware-details":         M{"arch": "amd64", "cores": 2, "mem": 8192, "root-disk": 8192},
 						"controller-member-status": "adding-vote",
 					},
 				},
@@ -867,6 +880,7 @@ var statusTests = []testCase{
 						},
 						"base":                     M{"name": "ubuntu", "channel": "12.10"},
 						"hardware":                 "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware-details":         M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 						"controller-member-status": "adding-vote",
 					},
 				},
@@ -1005,6 +1019,12 @@ var statusTests = []testCase{
 								"open-ports": L{
 									"2/tcp", "3/tcp", "2/udp", "10/udp",
 								},
+								"open-port-ranges": L{
+									M{"from": 2, "to": 2, "protocol": "tcp"},
+									M{"from": 3, "to": 3, "protocol": "tcp"},
+									M{"from": 2, "to": 2, "protocol": "udp"},
+									M{"from": 10, "to": 10, "protocol": "udp"},
+								},
 								"public-address": "10.0.2.1",
 							},
 						},
@@ -1085,7 +1105,8 @@ var statusTests = []testCase{
 								"is-up":        true,
 							},
 						},
-						"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 					},
 					"4": M{
 						"hostname":     "antediluvian-furniture",
@@ -1113,7 +1134,8 @@ var statusTests = []testCase{
 								"is-up":        true,
 							},
 						},
-						"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 					},
 					"5": M{
 						"juju-status": M{
@@ -1156,6 +1178,12 @@ var statusTests = []testCase{
 								"open-ports": L{
 									"2/tcp", "3/tcp", "2/udp", "10/udp",
 								},
+								"open-port-ranges": L{
+									M{"from": 2, "to": 2, "protocol": "tcp"},
+									M{"from": 3, "to": 3, "protocol": "tcp"},
+									M{"from": 2, "to": 2, "protocol": "udp"},
+									M{"from": 10, "to": 10, "protocol": "udp"},
+								},
 								"public-address": "10.0.2.1",
 							},
 						},
@@ -1521,8 +1549,9 @@ var statusTests = []testCase{
 							"current": "idle",
 							"since":   "01 Apr 15 01:23+10:00",
 						},
-						"base":     M{"name": "ubuntu", "channel": "12.10"},
-						"hardware": "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"base":             M{"name": "ubuntu", "channel": "12.10"},
+						"hardware":         "arch=amd64 cores=1 mem=1024M root-disk=8192M",
+						"hardware-details": M{"arch": "amd64", "cores": 1, "mem": 1024, "root-disk": 8192},
 					},
 				},
 				"applications": M{
@@ -1717,6 +1746,7 @@ var statusTests = []testCase{
 						"charm-origin":  "charmhub",
 						"charm-name":    "varnish",
 						"charm-channel": "stable",
+						"charm-risk":    "stable",
 						"charm-rev":     1,
 						"base":          M{"name": "ubuntu", "channel": "12.10"},
 						"exposed":       true,
@@ -1860,6 +1890,7 @@ var statusTests = []testCase{
 						"charm-name":    "riak",
 						"charm-rev":     7,
 						"charm-channel": "stable",
+						"charm-risk":    "stable",
 						"base":          M{"name": "ubuntu", "channel": "12.10"},
 						"exposed":       true,
 						"application-status": M{
@@ -2726,6 +2757,7 @@ var statusTests = []testCase{
 						},
 						"constraints":              "cores=2 mem=8192M root-disk=8192M",
 						"hardware":                 "arch=amd64 cores=2 mem=8192M root-disk=8192M",
+						"hardware-details":         M{"arch": "amd64", "cores": 2, "mem": 8192, "root-disk": 8192},
 						"controller-member-status": "adding-vote",
 					},
 				},
@@ -2965,6 +2997,7 @@ func mysqlCharm(extras M) M {
 		"charm-name":    "mysql",
 		"charm-rev":     1,
 		"charm-channel": "stable",
+		"charm-risk":    "stable",
 		"base":          M{"name": "ubuntu", "channel": "12.10"},
 		"exposed":       false,
 	}
@@ -2990,6 +3023,7 @@ func dummyCharm(extras M) M {
 		"charm-name":    "dummy",
 		"charm-rev":     1,
 		"charm-channel": "stable",
+		"charm-risk":    "stable",
 		"base":          M{"name": "ubuntu", "channel": "12.10"},
 		"exposed":       false,
 	}
@@ -3003,6 +3037,7 @@ func wordpressCharm(extras M) M {
 		"charm-name":    "wordpress",
 		"charm-rev":     3,
 		"charm-channel": "stable",
+		"charm-risk":    "stable",
 		"base":          M{"name": "ubuntu", "channel": "12.10"},
 		"exposed":       false,
 	}
@@ -5474,6 +5509,88 @@ func (s *StatusSuite) TestControllerTimestampInFullStatus(c *gc.C) {
 	})
 }
 
+func (s *StatusSuite) TestControllerClockSkewInFullStatus(c *gc.C) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	controllerTime := now.Add(-5 * time.Minute)
+	status := &params.FullStatus{
+		Model: params.ModelStatusInfo{
+			CloudTag: "cloud-dummy",
+		},
+		ControllerTimestamp: &controllerTime,
+	}
+
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status:  status,
+		ISOTime: true,
+		Now:     now,
+	})
+	formatted, err := formatter.Format()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(formatted.Controller, jc.DeepEquals, &controllerStatus{
+		Timestamp: common.FormatTimeAsTimestamp(&controllerTime, true),
+		ClockSkew: "controller clock is 5m0s behind local clock",
+	})
+}
+
+func (s *StatusSuite) TestFormatClockSkew(c *gc.C) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		about      string
+		controller time.Time
+		local      time.Time
+		expected   string
+	}{{
+		about:      "no local time",
+		controller: now,
+	}, {
+		about:      "in sync",
+		controller: now,
+		local:      now,
+	}, {
+		about:      "within threshold",
+		controller: now.Add(maxClockSkew),
+		local:      now,
+	}, {
+		about:      "controller ahead",
+		controller: now.Add(90 * time.Second),
+		local:      now,
+		expected:   "controller clock is 1m30s ahead of local clock",
+	}, {
+		about:      "controller behind",
+		controller: now.Add(-2 * time.Hour),
+		local:      now,
+		expected:   "controller clock is 2h0m0s behind local clock",
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.about)
+		c.Check(formatClockSkew(test.controller, test.local), gc.Equals, test.expected)
+	}
+}
+
+func (s *StatusSuite) TestFormatTabularClockSkew(c *gc.C) {
+	fStatus := formattedStatus{
+		Model: modelStatus{
+			Name:       "default",
+			Controller: "kontroll",
+			Cloud:      "dummy",
+			Version:    "3.0.0",
+		},
+		Controller: &controllerStatus{
+			Timestamp: "12:00:00Z",
+			ClockSkew: "controller clock is 5m0s behind local clock",
+		},
+	}
+	out := &bytes.Buffer{}
+	err := FormatTabular(out, false, fStatus)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(out.String(), gc.Equals, `
+Model    Controller  Cloud/Region  Version  Timestamp
+default  kontroll    dummy         3.0.0    12:00:00Z
+Warning: controller clock is 5m0s behind local clock
+`[1:])
+}
+
 func (s *StatusSuite) TestTabularNoRelations(c *gc.C) {
 	ctx := s.setupModel(c)
 
@@ -5568,3 +5685,212 @@ func (s *StatusSuite) TestStatusArgs(c *gc.C) {
 	expectedArgsGNUStyle := []string{"juju", "status", "--relations", "--color"}
 	c.Check(cmd.statusCommandAllArgs(statusArgsGNUStyle), jc.SameContents, expectedArgsGNUStyle)
 }
+
+func (s *StatusSuite) TestFormatApplicationCharmTrackAndRisk(c *gc.C) {
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status: &params.FullStatus{},
+	})
+
+	for _, t := range []struct {
+		channel string
+		track   string
+		risk    string
+	}{
+		{channel: "", track: "", risk: ""},
+		{channel: "stable", track: "", risk: "stable"},
+		{channel: "latest/edge", track: "latest", risk: "edge"},
+		{channel: "2.0/candidate/foo", track: "2.0", risk: "candidate"},
+		{channel: "not/a/valid/channel", track: "", risk: ""},
+	} {
+		c.Logf("channel %q", t.channel)
+		app := formatter.formatApplication("foo", params.ApplicationStatus{
+			Charm:        "ch:foo-1",
+			CharmChannel: t.channel,
+		})
+		c.Check(app.CharmChannel, gc.Equals, t.channel)
+		c.Check(app.CharmTrack, gc.Equals, t.track)
+		c.Check(app.CharmRisk, gc.Equals, t.risk)
+	}
+}
+
+func (s *StatusSuite) TestFormatHardwareDetails(c *gc.C) {
+	c.Check(formatHardwareDetails(""), gc.IsNil)
+	c.Check(formatHardwareDetails("invalid"), gc.IsNil)
+	c.Check(formatHardwareDetails("arch=arm64 cores=4 mem=2048M root-disk=16384M availability-zone=zone-a"), jc.DeepEquals, &hardwareDetails{
+		Arch:             "arm64",
+		Cores:            4,
+		Mem:              2048,
+		RootDisk:         16384,
+		AvailabilityZone: "zone-a",
+	})
+	c.Check(formatHardwareDetails("arch=amd64"), jc.DeepEquals, &hardwareDetails{
+		Arch: "amd64",
+	})
+}
+
+func (s *StatusSuite) TestFormatOpenedPortRanges(c *gc.C) {
+	c.Check(formatOpenedPortRanges(nil), gc.IsNil)
+	c.Check(formatOpenedPortRanges([]string{"80/tcp", "1000-2000/udp", "icmp", "bad/tcp"}), jc.DeepEquals, []portRange{
+		{From: 80, To: 80, Protocol: "tcp"},
+		{From: 1000, To: 2000, Protocol: "udp"},
+		{Protocol: "icmp"},
+	})
+}
+
+func (s *StatusSuite) TestFormatMachineLXDProfileConfigDrift(c *gc.C) {
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status: &params.FullStatus{},
+	})
+	out := formatter.formatMachine(params.MachineStatus{
+		Id: "0",
+		LXDProfiles: map[string]params.LXDProfile{
+			"juju-default-lxd-profile-1": {
+				Config: map[string]string{
+					"security.nesting": "false",
+				},
+				Description: "lxd profile",
+				ConfigDrift: map[string]string{
+					"security.nesting": "false",
+				},
+			},
+		},
+	})
+	c.Check(out.LXDProfiles, jc.DeepEquals, map[string]lxdProfileContents{
+		"juju-default-lxd-profile-1": {
+			Config: map[string]string{
+				"security.nesting": "false",
+			},
+			Description: "lxd profile",
+			ConfigDrift: map[string]string{
+				"security.nesting": "false",
+			},
+		},
+	})
+}
+
+func (s *StatusSuite) TestFormatApplicationVersionMismatch(c *gc.C) {
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status: &params.FullStatus{},
+	})
+
+	for _, t := range []struct {
+		about      string
+		appVersion string
+		units      []string
+		version    string
+		mismatch   bool
+	}{{
+		about:      "no units",
+		appVersion: "1.0",
+		version:    "1.0",
+	}, {
+		about:      "units without versions",
+		appVersion: "1.0",
+		units:      []string{"", ""},
+		version:    "1.0",
+	}, {
+		about:      "matching versions",
+		appVersion: "1.0",
+		units:      []string{"1.0", "1.0"},
+		version:    "1.0",
+	}, {
+		about:      "most common version",
+		appVersion: "1.0",
+		units:      []string{"1.0", "2.0", "2.0"},
+		version:    "2.0",
+		mismatch:   true,
+	}, {
+		about:      "tie prefers application version",
+		appVersion: "2.0",
+		units:      []string{"1.0", "2.0"},
+		version:    "2.0",
+		mismatch:   true,
+	}, {
+		about:      "tie without application version",
+		appVersion: "3.0",
+		units:      []string{"2.0", "1.0"},
+		version:    "1.0",
+		mismatch:   true,
+	}} {
+		c.Logf("%s", t.about)
+		units := make(map[string]params.UnitStatus)
+		for i, v := range t.units {
+			units[fmt.Sprintf("foo/%d", i)] = params.UnitStatus{WorkloadVersion: v}
+		}
+		app := formatter.formatApplication("foo", params.ApplicationStatus{
+			Charm:           "ch:foo-1",
+			WorkloadVersion: t.appVersion,
+			Units:           units,
+		})
+		c.Check(app.Version, gc.Equals, t.version)
+		c.Check(app.VersionMismatch, gc.Equals, t.mismatch)
+	}
+}
+
+func (s *StatusSuite) TestFormatApplicationCharmFullVersion(c *gc.C) {
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status: &params.FullStatus{},
+	})
+
+	app := formatter.formatApplication("foo", params.ApplicationStatus{
+		Charm:        "local:foo-22",
+		CharmVersion: "git-abc123",
+	})
+	c.Check(app.CharmRev, gc.Equals, 22)
+	c.Check(app.CharmVersion, gc.Equals, "git-abc123")
+	c.Check(app.CharmFullVersion, gc.Equals, "22+git-abc123")
+
+	app = formatter.formatApplication("foo", params.ApplicationStatus{
+		Charm: "ch:foo-1",
+	})
+	c.Check(app.CharmFullVersion, gc.Equals, "")
+}
+
+func (s *StatusSuite) TestFormatApplicationExposedEndpoints(c *gc.C) {
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status: &params.FullStatus{},
+	})
+
+	app := formatter.formatApplication("foo", params.ApplicationStatus{
+		Charm:   "ch:foo-1",
+		Exposed: true,
+		ExposedEndpoints: map[string]params.ExposedEndpoint{
+			"": {
+				ExposeToCIDRs: []string{"0.0.0.0/0"},
+			},
+			"website": {
+				ExposeToSpaces: []string{"public"},
+				ExposeToCIDRs:  []string{"10.0.0.0/24"},
+			},
+		},
+	})
+	c.Check(app.Exposed, jc.IsTrue)
+	c.Check(app.ExposedEndpoints, jc.DeepEquals, map[string]exposedEndpointDetail{
+		"": {
+			ExposeToCIDRs: []string{"0.0.0.0/0"},
+		},
+		"website": {
+			ExposeToSpaces: []string{"public"},
+			ExposeToCIDRs:  []string{"10.0.0.0/24"},
+		},
+	})
+
+	out, err := json.Marshal(app)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(out), jc.Contains, `"exposed-endpoints":{"":{"expose-to-cidrs":["0.0.0.0/0"]},"website":{"expose-to-spaces":["public"],"expose-to-cidrs":["10.0.0.0/24"]}}`)
+}
+
+func (s *StatusSuite) TestFormatApplicationNoExposedEndpoints(c *gc.C) {
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status: &params.FullStatus{},
+	})
+
+	app := formatter.formatApplication("foo", params.ApplicationStatus{
+		Charm: "ch:foo-1",
+	})
+	c.Check(app.ExposedEndpoints, gc.IsNil)
+
+	out, err := json.Marshal(app)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(out), gc.Not(jc.Contains), "exposed-endpoints")
+}
@@ -40,6 +40,7 @@ func (s *MinimalStatusSuite) SetUpTest(c *gc.C) {
 				CloudTag: "cloud-foo",
 			},
 		},
+		apiVersion: 9,
 	}
 	s.clock = &timeRecorder{}
 	store := jujuclient.NewMemStore()
@@ -68,6 +69,52 @@ func (s *MinimalStatusSuite) TestGoodCall(c *gc.C) {
 	c.Assert(s.clock.waits, gc.HasLen, 0)
 }
 
+func (s *MinimalStatusSuite) TestSchema(c *gc.C) {
+	// The status API must not be called when printing the schema.
+	s.statusapi.errors = []error{errors.New("status should not be called")}
+	ctx, err := s.runStatus(c, "--schema")
+	c.Assert(err, jc.ErrorIsNil)
+
+	expected, err := StatusJSONSchema()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, string(expected)+"\n")
+}
+
+func (s *MinimalStatusSuite) TestSummary(c *gc.C) {
+	s.statusapi.summary = params.StatusSummary{
+		Applications: params.StatusCounts{Active: 1, Blocked: 1},
+		Units:        params.StatusCounts{Active: 2, Error: 1, Other: 1},
+	}
+	ctx, err := s.runStatus(c, "--summary")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals,
+		"Applications: 2 (1 active, 1 blocked), Units: 4 (2 active, 1 error, 1 other)\n")
+}
+
+func (s *MinimalStatusSuite) TestSummaryEmptyModel(c *gc.C) {
+	ctx, err := s.runStatus(c, "--summary")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "Applications: 0, Units: 0\n")
+}
+
+func (s *MinimalStatusSuite) TestSummaryError(c *gc.C) {
+	s.statusapi.errors = []error{errors.New("boom")}
+	_, err := s.runStatus(c, "--summary")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *MinimalStatusSuite) TestSummaryNotSupported(c *gc.C) {
+	s.statusapi.apiVersion = 8
+	s.statusapi.errors = []error{errors.New("status summary should not be called")}
+	_, err := s.runStatus(c, "--summary")
+	c.Assert(err, gc.ErrorMatches, "--summary is not supported by this controller")
+}
+
+func (s *MinimalStatusSuite) TestSummaryWithSelectors(c *gc.C) {
+	_, err := s.runStatus(c, "--summary", "mysql")
+	c.Assert(err, gc.ErrorMatches, "cannot use --summary with selectors")
+}
+
 func (s *MinimalStatusSuite) TestGoodCallWithStorage(c *gc.C) {
 	t := time.Now()
 	s.statusapi.expectIncludeStorage = true
@@ -154,6 +201,8 @@ type fakeStatusAPI struct {
 	result               *params.FullStatus
 	patterns             []string
 	errors               []error
+	summary              params.StatusSummary
+	apiVersion           int
 }
 
 func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*params.FullStatus, error) {
@@ -172,6 +221,21 @@ func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*p
 	return f.result, nil
 }
 
+func (f *fakeStatusAPI) StatusSummary(ctx context.Context) (params.StatusSummary, error) {
+	if len(f.errors) > 0 {
+		err, rest := f.errors[0], f.errors[1:]
+		f.errors = rest
+		if err != nil {
+			return params.StatusSummary{}, err
+		}
+	}
+	return f.summary, nil
+}
+
+func (f *fakeStatusAPI) BestAPIVersion() int {
+	return f.apiVersion
+}
+
 func (*fakeStatusAPI) Close() error {
 	return nil
 }
@@ -181,6 +245,10 @@ type timeRecorder struct {
 	result chan time.Time
 }
 
+func (r *timeRecorder) Now() time.Time {
+	return time.Now()
+}
+
 func (r *timeRecorder) After(d time.Duration) <-chan time.Time {
 	r.waits = append(r.waits, d)
 	if r.result == nil {
@@ -193,6 +193,10 @@ type machineAgentCommand struct {
 	machineId string
 	// TODO(controlleragent) - this will be in a new controller agent command
 	controllerId string
+	// apiPortOpenDelay, if set, overrides the api-port-open-delay
+	// controller config value.
+	apiPortOpenDelay         string
+	apiPortOpenDelayOverride *time.Duration
 }
 
 // Init is called by the cmd system to initialize the structure for
@@ -208,6 +212,13 @@ func (a *machineAgentCommand) Init(args []string) error {
 	if a.controllerId != "" && !names.IsValidControllerAgent(a.controllerId) {
 		return errors.Errorf("--controller-id option must be a non-negative integer")
 	}
+	if a.apiPortOpenDelay != "" {
+		delay, err := time.ParseDuration(a.apiPortOpenDelay)
+		if err != nil || delay < 0 {
+			return errors.Errorf("--api-port-open-delay option must be a non-negative duration")
+		}
+		a.apiPortOpenDelayOverride = &delay
+	}
 	if err := a.agentInitializer.CheckArgs(args); err != nil {
 		return err
 	}
@@ -254,6 +265,7 @@ func (a *machineAgentCommand) Run(c *cmd.Context) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	machineAgent.apiPortOpenDelayOverride = a.apiPortOpenDelayOverride
 	return machineAgent.Run(c)
 }
 
@@ -263,6 +275,7 @@ func (a *machineAgentCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&a.machineId, "machine-id", "", "id of the machine to run")
 	f.StringVar(&a.controllerId, "controller-id", "", "id of the controller to run")
 	f.BoolVar(&a.logToStdErr, "log-to-stderr", false, "log to stderr instead of logsink.log")
+	f.StringVar(&a.apiPortOpenDelay, "api-port-open-delay", "", "override the api-port-open-delay controller config value, e.g. 0s to skip the delay")
 }
 
 // Info returns usage information for the command.
@@ -425,6 +438,10 @@ type MachineAgent struct {
 
 	isCaasAgent bool
 	cmdRunner   CommandRunner
+
+	// apiPortOpenDelayOverride, if not nil, is used by the http server
+	// instead of the api-port-open-delay controller config value.
+	apiPortOpenDelayOverride *time.Duration
 }
 
 // Wait waits for the machine agent to finish.
@@ -646,6 +663,7 @@ func (a *MachineAgent) makeEngineCreator(
 			RegisterIntrospectionHTTPHandlers: registerIntrospectionHandlers,
 			NewModelWorker:                    a.startModelWorkers,
 			MuxShutdownWait:                   1 * time.Minute,
+			APIPortOpenDelayOverride:          a.apiPortOpenDelayOverride,
 			NewBrokerFunc:                     newBroker,
 			IsCaasConfig:                      a.isCaasAgent,
 			UnitEngineConfig: func() dependency.EngineConfig {
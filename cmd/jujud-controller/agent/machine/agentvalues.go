@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machine
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	coreagent "github.com/juju/juju/agent"
+	charmstore "github.com/juju/juju/domain/application/charm/store"
+	internallogger "github.com/juju/juju/internal/logger"
+)
+
+var machineLogger = internallogger.GetLogger("juju.cmd.jujud.machine")
+
+// agentConfigInt returns the non-negative integer value of the agent config
+// key. If the key isn't set, or the value isn't a non-negative integer, zero
+// is returned.
+func agentConfigInt(agentConfig coreagent.Config, key string) int64 {
+	v := agentConfig.Value(key)
+	if v == "" {
+		return 0
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || i < 0 {
+		machineLogger.Warningf(context.TODO(), "invalid %s value %q, ignoring", key, v)
+		return 0
+	}
+	return i
+}
+
+// maxAPIConnections returns the maximum number of concurrent API connections
+// from the agent config. Zero, meaning unlimited, is returned if it isn't set.
+func maxAPIConnections(agentConfig coreagent.Config) int {
+	return int(agentConfigInt(agentConfig, coreagent.MaxAPIConnections))
+}
+
+// charmDigestAlgorithms returns the hash algorithms used to compute the
+// digest of charms, from the agent config. Unsupported algorithms are
+// ignored. If none are set, nil is returned and the charm store defaults
+// are used.
+func charmDigestAlgorithms(agentConfig coreagent.Config) []charmstore.HashAlgorithm {
+	v := agentConfig.Value(coreagent.CharmDigestAlgorithms)
+	if v == "" {
+		return nil
+	}
+	var algorithms []charmstore.HashAlgorithm
+	for _, name := range strings.Split(v, ",") {
+		switch algorithm := charmstore.HashAlgorithm(strings.TrimSpace(name)); algorithm {
+		case charmstore.SHA256, charmstore.SHA384, charmstore.SHA512:
+			algorithms = append(algorithms, algorithm)
+		default:
+			machineLogger.Warningf(context.TODO(), "unsupported charm digest algorithm %q, ignoring", name)
+		}
+	}
+	return algorithms
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machine_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/cmd/jujud-controller/agent/machine"
+	charmstore "github.com/juju/juju/domain/application/charm/store"
+	"github.com/juju/juju/internal/testing"
+)
+
+type AgentValuesSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&AgentValuesSuite{})
+
+func (s *AgentValuesSuite) TestAgentConfigInt(c *gc.C) {
+	conf := &mockConfig{values: map[string]string{
+		agent.CharmStoreQuota: "1048576",
+	}}
+	c.Check(machine.AgentConfigInt(conf, agent.CharmStoreQuota), gc.Equals, int64(1048576))
+}
+
+func (s *AgentValuesSuite) TestAgentConfigIntNotSet(c *gc.C) {
+	conf := &mockConfig{}
+	c.Check(machine.AgentConfigInt(conf, agent.CharmStoreQuota), gc.Equals, int64(0))
+}
+
+func (s *AgentValuesSuite) TestAgentConfigIntInvalid(c *gc.C) {
+	for _, v := range []string{"lots", "-1", "1.5"} {
+		conf := &mockConfig{values: map[string]string{
+			agent.CharmStoreQuota: v,
+		}}
+		c.Check(machine.AgentConfigInt(conf, agent.CharmStoreQuota), gc.Equals, int64(0), gc.Commentf("value %q", v))
+	}
+}
+
+func (s *AgentValuesSuite) TestMaxAPIConnections(c *gc.C) {
+	conf := &mockConfig{values: map[string]string{
+		agent.MaxAPIConnections: "500",
+	}}
+	c.Check(machine.MaxAPIConnections(conf), gc.Equals, 500)
+}
+
+func (s *AgentValuesSuite) TestMaxAPIConnectionsNotSet(c *gc.C) {
+	conf := &mockConfig{}
+	c.Check(machine.MaxAPIConnections(conf), gc.Equals, 0)
+}
+
+func (s *AgentValuesSuite) TestCharmDigestAlgorithms(c *gc.C) {
+	conf := &mockConfig{values: map[string]string{
+		agent.CharmDigestAlgorithms: "sha256, sha512,md5",
+	}}
+	c.Check(machine.CharmDigestAlgorithms(conf), jc.DeepEquals, []charmstore.HashAlgorithm{
+		charmstore.SHA256, charmstore.SHA512,
+	})
+}
+
+func (s *AgentValuesSuite) TestCharmDigestAlgorithmsNotSet(c *gc.C) {
+	conf := &mockConfig{}
+	c.Check(machine.CharmDigestAlgorithms(conf), gc.IsNil)
+}
@@ -0,0 +1,10 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machine
+
+var (
+	AgentConfigInt        = agentConfigInt
+	CharmDigestAlgorithms = charmDigestAlgorithms
+	MaxAPIConnections     = maxAPIConnections
+)
@@ -258,6 +258,11 @@ type ManifoldsConfig struct {
 	// exits regardless.
 	MuxShutdownWait time.Duration
 
+	// APIPortOpenDelayOverride, if not nil, overrides the
+	// api-port-open-delay controller config value used by the http-server
+	// worker.
+	APIPortOpenDelayOverride *time.Duration
+
 	// NewBrokerFunc is a function opens a instance broker (LXD/KVM)
 	NewBrokerFunc containerbroker.NewBrokerFunc
 
@@ -586,21 +591,23 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 		})),
 
 		httpServerName: httpserver.Manifold(httpserver.ManifoldConfig{
-			AuthorityName:        certificateWatcherName,
-			HubName:              centralHubName,
-			StateName:            stateName,
-			DomainServicesName:   domainServicesName,
-			MuxName:              httpServerArgsName,
-			APIServerName:        apiServerName,
-			PrometheusRegisterer: config.PrometheusRegisterer,
-			AgentName:            config.AgentName,
-			Clock:                config.Clock,
-			MuxShutdownWait:      config.MuxShutdownWait,
-			LogDir:               agentConfig.LogDir(),
-			Logger:               internallogger.GetLogger("juju.worker.httpserver"),
-			GetControllerConfig:  httpserver.GetControllerConfig,
-			NewTLSConfig:         httpserver.NewTLSConfig,
-			NewWorker:            httpserver.NewWorkerShim,
+			AuthorityName:            certificateWatcherName,
+			HubName:                  centralHubName,
+			StateName:                stateName,
+			DomainServicesName:       domainServicesName,
+			MuxName:                  httpServerArgsName,
+			APIServerName:            apiServerName,
+			PrometheusRegisterer:     config.PrometheusRegisterer,
+			AgentName:                config.AgentName,
+			Clock:                    config.Clock,
+			MuxShutdownWait:          config.MuxShutdownWait,
+			APIPortOpenDelayOverride: config.APIPortOpenDelayOverride,
+			MaxAPIConnections:        maxAPIConnections(agentConfig),
+			LogDir:                   agentConfig.LogDir(),
+			Logger:                   internallogger.GetLogger("juju.worker.httpserver"),
+			GetControllerConfig:      httpserver.GetControllerConfig,
+			NewTLSConfig:             httpserver.NewTLSConfig,
+			NewWorker:                httpserver.NewWorkerShim,
 		}),
 
 		logSinkName: ifDatabaseUpgradeComplete(logsink.Manifold(logsink.ManifoldConfig{
@@ -679,6 +686,9 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Logger:                      internallogger.GetLogger("juju.worker.services"),
 			Clock:                       config.Clock,
 			LogDir:                      agentConfig.LogDir(),
+			CharmStoreQuota:             agentConfigInt(agentConfig, coreagent.CharmStoreQuota),
+			CharmTempFilePoolSize:       int(agentConfigInt(agentConfig, coreagent.CharmTempFilePoolSize)),
+			CharmDigests:                charmDigestAlgorithms(agentConfig),
 			NewWorker:                   workerdomainservices.NewWorker,
 			NewDomainServicesGetter:     workerdomainservices.NewDomainServicesGetter,
 			NewControllerDomainServices: workerdomainservices.NewControllerDomainServices,
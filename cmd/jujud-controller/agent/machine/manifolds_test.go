@@ -2327,6 +2327,11 @@ type mockConfig struct {
 	ssiSet   bool
 	ssi      controller.StateServingInfo
 	dataPath string
+	values   map[string]string
+}
+
+func (mc *mockConfig) Value(key string) string {
+	return mc.values[key]
 }
 
 func (mc *mockConfig) Tag() names.Tag {
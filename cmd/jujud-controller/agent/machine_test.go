@@ -139,6 +139,10 @@ func (s *MachineSuite) TestParseNonsense(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "--machine-id option must be a non-negative integer")
 	err = ParseAgentCommand(&machineAgentCommand{agentInitializer: aCfg}, []string{"--controller-id", "-4004"})
 	c.Assert(err, gc.ErrorMatches, "--controller-id option must be a non-negative integer")
+	err = ParseAgentCommand(&machineAgentCommand{agentInitializer: aCfg}, []string{"--machine-id", "42", "--api-port-open-delay", "-1s"})
+	c.Assert(err, gc.ErrorMatches, "--api-port-open-delay option must be a non-negative duration")
+	err = ParseAgentCommand(&machineAgentCommand{agentInitializer: aCfg}, []string{"--machine-id", "42", "--api-port-open-delay", "soon"})
+	c.Assert(err, gc.ErrorMatches, "--api-port-open-delay option must be a non-negative duration")
 }
 
 func (s *MachineSuite) TestParseUnknown(c *gc.C) {
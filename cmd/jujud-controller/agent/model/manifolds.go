@@ -305,6 +305,8 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			DomainServicesName: domainServicesName,
 			GetRemovalService:  removal.GetRemovalService,
 			NewWorker:          removal.NewWorker,
+			LeaseManagerName:   leaseManagerName,
+			ModelUUID:          modelUUID,
 			Clock:              config.Clock,
 			Logger:             config.LoggingContext.GetLogger("juju.worker.removal"),
 		})),
@@ -25,6 +25,26 @@ type TxnRunner interface {
 	StdTxn(context.Context, func(context.Context, *sql.Tx) error) error
 }
 
+// ReadOnlyTxnRunner is implemented by a TxnRunner which can run SQLair
+// transactions that only read from the database.
+type ReadOnlyTxnRunner interface {
+	// ReadTxn manages the application of a read-only SQLair transaction
+	// within which the input function is executed. The transaction is begun
+	// with the read-only option, so that it never takes the write lock.
+	// The input context can be used by the caller to cancel this process.
+	ReadTxn(context.Context, func(context.Context, *sqlair.TX) error) error
+}
+
+// ReadTxn runs the input function in a read-only SQLair transaction if the
+// runner supports them, falling back to a regular transaction if it does
+// not. The input function must only read from the database.
+func ReadTxn(ctx context.Context, runner TxnRunner, fn func(context.Context, *sqlair.TX) error) error {
+	if r, ok := runner.(ReadOnlyTxnRunner); ok {
+		return r.ReadTxn(ctx, fn)
+	}
+	return runner.Txn(ctx, fn)
+}
+
 // TxnRunnerFactory aliases a function that
 // returns a database.TxnRunner or an error.
 type TxnRunnerFactory = func() (TxnRunner, error)
@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/juju/names/v6"
+
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/internal/errors"
 )
@@ -28,6 +30,35 @@ func Name(modelName, appName string, revision int) string {
 	return fmt.Sprintf("%s%s-%s-%d", Prefix, modelName, appName, revision)
 }
 
+// ParseName is the inverse of Name; it returns the model name, application
+// name and charm revision encoded in the given profile name. If the name
+// doesn't follow the juju-<model>-<application>-<charm-revision> convention,
+// ok is false.
+// As both model and application names may contain hyphens, the split can be
+// ambiguous; in that case the shortest valid model name is chosen.
+func ParseName(name string) (modelName, appName string, revision int, ok bool) {
+	if !IsValidName(name) {
+		return "", "", 0, false
+	}
+	suffix := name[len(Prefix):]
+	lastHyphen := strings.LastIndex(suffix, "-")
+	revision, err := strconv.Atoi(suffix[lastHyphen+1:])
+	if err != nil || revision < 0 {
+		return "", "", 0, false
+	}
+	modelAndApp := suffix[:lastHyphen]
+	for i, r := range modelAndApp {
+		if r != '-' {
+			continue
+		}
+		modelName, appName = modelAndApp[:i], modelAndApp[i+1:]
+		if names.IsValidModelName(modelName) && names.IsValidApplication(appName) {
+			return modelName, appName, revision, true
+		}
+	}
+	return "", "", 0, false
+}
+
 // FilterLXDProfileNames ensures that the LXD profile names are unique yet preserve
 // the same order as the input. It removes certain profile names from the list,
 // for example "default" profile name will be removed.
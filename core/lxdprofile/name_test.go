@@ -148,6 +148,63 @@ func (*LXDProfileNameSuite) TestProfileRevision(c *gc.C) {
 	}
 }
 
+func (*LXDProfileNameSuite) TestParseName(c *gc.C) {
+	testCases := []struct {
+		input    string
+		model    string
+		app      string
+		revision int
+		ok       bool
+	}{
+		{input: ""},
+		{input: "default"},
+		{input: "juju-model"},
+		{input: "juju-model-app"},
+		{input: "juju-model-app--1"},
+		{input: "juju-Model-app-1"},
+		{input: "juju-model-1app-1"},
+		{input: "lxd-model-app-1"},
+		{
+			input:    lxdprofile.Name("foo", "bar", 1),
+			model:    "foo",
+			app:      "bar",
+			revision: 1,
+			ok:       true,
+		},
+		{
+			input:    lxdprofile.Name("default", "lxd-profile", 42),
+			model:    "default",
+			app:      "lxd-profile",
+			revision: 42,
+			ok:       true,
+		},
+		{
+			// The shortest valid model name is chosen.
+			input:    lxdprofile.Name("my-model", "app", 0),
+			model:    "my",
+			app:      "model-app",
+			revision: 0,
+			ok:       true,
+		},
+		{
+			// The application name must start with a letter.
+			input:    lxdprofile.Name("model-2", "app", 3),
+			model:    "model-2",
+			app:      "app",
+			revision: 3,
+			ok:       true,
+		},
+	}
+	for k, tc := range testCases {
+		c.Logf("running test %d of %d with input %q", k, len(testCases), tc.input)
+		model, app, revision, ok := lxdprofile.ParseName(tc.input)
+		c.Check(ok, gc.Equals, tc.ok)
+		c.Check(model, gc.Equals, tc.model)
+		c.Check(app, gc.Equals, tc.app)
+		c.Check(revision, gc.Equals, tc.revision)
+	}
+}
+
 func (*LXDProfileNameSuite) TestProfileReplaceRevision(c *gc.C) {
 	testCases := []struct {
 		input    string
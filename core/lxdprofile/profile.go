@@ -39,6 +39,31 @@ func (p Profile) Empty() bool {
 	return len(p.Devices) < 1 && len(p.Config) < 1
 }
 
+// ConfigDrift returns the config keys whose applied value differs from the
+// expected value, mapped to the applied value. Keys that are expected but
+// not applied are reported with an empty value. Nil is returned if there is
+// no drift.
+func ConfigDrift(applied, expected map[string]string) map[string]string {
+	var drift map[string]string
+	add := func(key, value string) {
+		if drift == nil {
+			drift = make(map[string]string)
+		}
+		drift[key] = value
+	}
+	for key, value := range applied {
+		if expectedValue, ok := expected[key]; !ok || expectedValue != value {
+			add(key, value)
+		}
+	}
+	for key := range expected {
+		if _, ok := applied[key]; !ok {
+			add(key, "")
+		}
+	}
+	return drift
+}
+
 // ValidateConfigDevices implements LXDProfile interface.
 func (p Profile) ValidateConfigDevices() error {
 	for _, val := range p.Devices {
@@ -91,3 +91,27 @@ func testValidateConfigDevicesGoodDevices(deviceType string, c *gc.C) {
 	c.Assert(p.ValidateConfigDevices(), jc.ErrorIsNil)
 
 }
+
+func (*ProfileSuite) TestConfigDrift(c *gc.C) {
+	applied := map[string]string{
+		"linux.kernel_modules": "nbd,ip_tables",
+		"security.nesting":     "true",
+		"security.privileged":  "true",
+	}
+	expected := map[string]string{
+		"linux.kernel_modules": "nbd,ip_tables,ip6_tables",
+		"security.nesting":     "true",
+		"raw.lxc":              "lxc.apparmor.profile=unconfined",
+	}
+	c.Check(lxdprofile.ConfigDrift(applied, expected), jc.DeepEquals, map[string]string{
+		"linux.kernel_modules": "nbd,ip_tables",
+		"security.privileged":  "true",
+		"raw.lxc":              "",
+	})
+}
+
+func (*ProfileSuite) TestConfigDriftNone(c *gc.C) {
+	config := map[string]string{"security.nesting": "true"}
+	c.Check(lxdprofile.ConfigDrift(config, config), gc.IsNil)
+	c.Check(lxdprofile.ConfigDrift(nil, nil), gc.IsNil)
+}
@@ -26,6 +26,8 @@ func (*importSuite) TestImports(c *gc.C) {
 	// This package only brings in other core packages.
 	c.Assert(found, jc.SameContents, []string{
 		"core/arch",
+		"core/assumes",
+		"core/errors",
 		"core/semversion",
 		"internal/charm",
 		"internal/charm/assumes",
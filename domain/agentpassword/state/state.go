@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"crypto/subtle"
 
 	"github.com/canonical/sqlair"
 
@@ -106,6 +107,60 @@ AND password_hash = $validateUnitPasswordHash.password_hash;
 	return count > 0, errors.Capture(err)
 }
 
+// MatchesUnitPasswordHashes checks a batch of password hashes against the
+// password hashes stored in the database, fetching the stored hashes in a
+// single query. The result holds an entry for every unit in the input; units
+// that don't exist, or don't have a password hash set, map to false.
+func (s *State) MatchesUnitPasswordHashes(ctx context.Context, passwordHashes map[unit.UUID]agentpassword.PasswordHash) (map[unit.UUID]bool, error) {
+	result := make(map[unit.UUID]bool, len(passwordHashes))
+	if len(passwordHashes) == 0 {
+		return result, nil
+	}
+
+	db, err := s.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make(unitUUIDs, 0, len(passwordHashes))
+	for uuid := range passwordHashes {
+		uuids = append(uuids, uuid)
+		result[uuid] = false
+	}
+
+	query := `
+SELECT &unitPasswordHash.* FROM unit
+WHERE uuid IN ($unitUUIDs[:])
+AND password_hash IS NOT NULL;
+`
+	stmt, err := s.Prepare(query, unitPasswordHash{}, uuids)
+	if err != nil {
+		return nil, errors.Errorf("preparing statement to get password hashes: %w", err)
+	}
+
+	var stored []unitPasswordHash
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, uuids).GetAll(&stored); errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting password hashes: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	for _, hash := range stored {
+		if hash.PasswordHash == "" {
+			continue
+		}
+		expected := passwordHashes[hash.UUID]
+		result[hash.UUID] = subtle.ConstantTimeCompare([]byte(hash.PasswordHash), []byte(expected)) == 1
+	}
+	return result, nil
+}
+
 // GetUnitUUID returns the UUID of the unit with the given name, returning an
 // error satisfying [agentpassworderrors.UnitNotFound] if the unit does not exist.
 func (st *State) GetUnitUUID(ctx context.Context, unitName unit.Name) (unit.UUID, error) {
@@ -117,6 +117,53 @@ func (s *stateSuite) TestMatchesUnitPasswordHashInvalidPassword(c *gc.C) {
 	c.Assert(valid, jc.IsFalse)
 }
 
+func (s *stateSuite) TestMatchesUnitPasswordHashes(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.createApplication(c)
+	unitName0 := s.createUnit(c)
+	unitName1 := s.createUnit(c)
+	unitName2 := s.createUnit(c)
+
+	unitUUID0, err := st.GetUnitUUID(context.Background(), unitName0)
+	c.Assert(err, jc.ErrorIsNil)
+	unitUUID1, err := st.GetUnitUUID(context.Background(), unitName1)
+	c.Assert(err, jc.ErrorIsNil)
+	unitUUID2, err := st.GetUnitUUID(context.Background(), unitName2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	passwordHash0 := s.genPasswordHash(c)
+	err = st.SetUnitPasswordHash(context.Background(), unitUUID0, passwordHash0)
+	c.Assert(err, jc.ErrorIsNil)
+
+	passwordHash1 := s.genPasswordHash(c)
+	err = st.SetUnitPasswordHash(context.Background(), unitUUID1, passwordHash1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The third unit has no password set, and the last unit doesn't exist.
+	valid, err := st.MatchesUnitPasswordHashes(context.Background(), map[unit.UUID]agentpassword.PasswordHash{
+		unitUUID0:        passwordHash0,
+		unitUUID1:        passwordHash1 + "1",
+		unitUUID2:        s.genPasswordHash(c),
+		unit.UUID("foo"): s.genPasswordHash(c),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(valid, jc.DeepEquals, map[unit.UUID]bool{
+		unitUUID0:        true,
+		unitUUID1:        false,
+		unitUUID2:        false,
+		unit.UUID("foo"): false,
+	})
+}
+
+func (s *stateSuite) TestMatchesUnitPasswordHashesEmpty(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	valid, err := st.MatchesUnitPasswordHashes(context.Background(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(valid, gc.HasLen, 0)
+}
+
 func (s *stateSuite) TestGetAllUnitPasswordHashes(c *gc.C) {
 	st := NewState(s.TxnRunnerFactory())
 
@@ -20,6 +20,9 @@ type unitPasswordHash struct {
 	PasswordHash agentpassword.PasswordHash `db:"password_hash"`
 }
 
+// unitUUIDs represents a list of unit UUIDs.
+type unitUUIDs []unit.UUID
+
 // validateUnitPasswordHash represents a unit's password.
 type validateUnitPasswordHash struct {
 	UUID         unit.UUID                  `db:"uuid"`
@@ -10,6 +10,7 @@ import (
 )
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package store -destination store_mock_test.go github.com/juju/juju/core/objectstore ObjectStore,ModelObjectStoreGetter
+//go:generate go run go.uber.org/mock/mockgen -typed -package store -destination storedcharms_mock_test.go github.com/juju/juju/domain/application/charm/store StoredCharms
 
 func TestPackage(t *testing.T) {
 	gc.TestingT(t)
@@ -9,12 +9,25 @@ import (
 	"crypto/sha512"
 	"encoding/base64"
 	"encoding/hex"
+	"hash"
 	"io"
 	"os"
+	"runtime"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/juju/clock"
+	"github.com/juju/retry"
+
+	"github.com/juju/juju/core/database"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/errors"
 	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
 	"github.com/juju/juju/internal/uuid"
@@ -29,13 +42,54 @@ const (
 
 	// ErrCharmHashMismatch is returned when the charm hash does not match the expected hash.
 	ErrCharmHashMismatch = errors.ConstError("charm hash mismatch")
+
+	// ErrQuotaExceeded is returned when storing a charm would take the model
+	// object store over its quota.
+	ErrQuotaExceeded = errors.ConstError("object store quota exceeded")
+
+	// ErrObjectStoreUnavailable is returned when the object store for the
+	// model can not be obtained.
+	ErrObjectStoreUnavailable = errors.ConstError("object store unavailable")
+
+	// ErrTempFileIO is returned when creating, writing or rewinding the
+	// temporary file backing a charm reader fails.
+	ErrTempFileIO = errors.ConstError("temporary file io error")
+)
+
+const (
+	// getObjectStoreAttempts is the number of times getting the object store
+	// is attempted, when it fails with a transient error.
+	getObjectStoreAttempts = 5
+
+	// getObjectStoreDelay is the initial delay between attempts at getting
+	// the object store. The delay doubles after each attempt.
+	getObjectStoreDelay = 100 * time.Millisecond
 )
 
-// Digest contains the SHA256 and SHA384 hashes of a charm archive. This
-// will be used to verify the integrity of the charm archive.
+// HashAlgorithm is a hash algorithm used to compute the digest of a charm
+// archive.
+type HashAlgorithm string
+
+const (
+	// SHA256 is the SHA-256 hash algorithm.
+	SHA256 HashAlgorithm = "sha256"
+	// SHA384 is the SHA-384 hash algorithm.
+	SHA384 HashAlgorithm = "sha384"
+	// SHA512 is the SHA-512 hash algorithm.
+	SHA512 HashAlgorithm = "sha512"
+)
+
+// DefaultDigestAlgorithms are the hash algorithms used to compute the digest
+// of a charm archive when none are configured.
+var DefaultDigestAlgorithms = []HashAlgorithm{SHA256, SHA384}
+
+// Digest contains the hashes of a charm archive. This will be used to verify
+// the integrity of the charm archive. A hash is only set if its algorithm was
+// used to compute the digest; SHA256 and SHA384 are always set.
 type Digest struct {
 	SHA256 string
 	SHA384 string
+	SHA512 string
 	Size   int64
 }
 
@@ -44,6 +98,8 @@ type Digest struct {
 type StoreResult struct {
 	UniqueName      string
 	ObjectStoreUUID objectstore.UUID
+	// Source is where the charm archive came from, if it is known.
+	Source charm.CharmSource
 }
 
 // CharmReader is an interface that combines the io.Reader, io.ReaderAt, and
@@ -56,32 +112,158 @@ type CharmReader interface {
 
 // StoreFromReaderResult contains the unique name of the charm archive and the
 // object store UUID.
+//
+// The Charm reader is backed by a temporary file, which is only removed once
+// the result is cleaned up. Callers should defer a call to Cleanup as soon as
+// the result is obtained, so that the temporary file doesn't leak if they
+// return early. A result that is dropped without being cleaned up is
+// released when it is garbage collected, and a warning is logged.
 type StoreFromReaderResult struct {
 	Charm           CharmReader
 	UniqueName      string
 	ObjectStoreUUID objectstore.UUID
+	// Source is where the charm archive came from, if it is known.
+	Source charm.CharmSource
+}
+
+// Cleanup releases the charm reader and removes any temporary file backing
+// it. It is safe to call Cleanup after the charm reader has been closed, and
+// on an empty result.
+func (r StoreFromReaderResult) Cleanup() error {
+	if r.Charm == nil {
+		return nil
+	}
+	return r.Charm.Close()
+}
+
+// DeletionWatcher is implemented by object stores that can report when
+// objects are removed from them.
+type DeletionWatcher interface {
+	// WatchDeletions returns a watcher that emits the paths of objects that
+	// have been removed from the object store.
+	WatchDeletions(context.Context) (watcher.StringsWatcher, error)
+}
+
+// StoredCharms looks up the charm archives which are already stored in the
+// model object store.
+type StoredCharms interface {
+	// GetCharmArchiveBySHA384 returns the archive path and object store UUID
+	// of a charm with the given source, whose archive has the given SHA384
+	// hash and is still in the object store. If there is no such charm, an
+	// error satisfying [applicationerrors.CharmNotFound] is returned.
+	GetCharmArchiveBySHA384(ctx context.Context, sha384 string, source charm.CharmSource) (string, objectstore.UUID, error)
+}
+
+// UsageReporter reports the number of bytes the model object store currently
+// holds.
+type UsageReporter interface {
+	// Usage returns the total size, in bytes, of the objects in the object
+	// store.
+	Usage(context.Context) (int64, error)
 }
 
 // CharmStore provides an API for storing and retrieving charm blobs.
 type CharmStore struct {
 	objectStoreGetter objectstore.ModelObjectStoreGetter
+	quota             int64
+	usage             UsageReporter
+	charms            StoredCharms
+	tempFiles         *TempFilePool
 	encoder           *base64.Encoding
+	digests           []HashAlgorithm
 	logger            logger.Logger
+	clock             clock.Clock
+
+	// newUniqueName generates the name a charm archive is stored under.
+	newUniqueName func() (string, error)
+}
+
+// Config holds the configuration of a CharmStore.
+type Config struct {
+	// ObjectStoreGetter is used to get the model object store.
+	ObjectStoreGetter objectstore.ModelObjectStoreGetter
+
+	// Quota is the maximum number of bytes the model object store may hold
+	// for charms to still be stored. Zero means unlimited. The quota is only
+	// enforced if Usage is set.
+	Quota int64
+
+	// Usage reports how many bytes the model object store holds.
+	Usage UsageReporter
+
+	// Charms is used to find charm archives with identical content which
+	// are already stored, so that they aren't stored again. If nil, every
+	// charm is stored.
+	Charms StoredCharms
+
+	// TempFiles, if not nil, is the pool the temporary files backing charm
+	// readers are taken from, and returned to. Otherwise a new temporary
+	// file is created for every charm.
+	TempFiles *TempFilePool
+
+	// Digests are the hash algorithms used to compute the digest of charms
+	// stored from a reader. If empty, [DefaultDigestAlgorithms] are used.
+	// SHA256 and SHA384 are always computed, as they are required to
+	// identify the charm and by the object store respectively.
+	Digests []HashAlgorithm
+
+	// Logger is used to log messages.
+	Logger logger.Logger
+
+	// Clock is used to wait between attempts at getting the object store.
+	// If nil, the wall clock is used.
+	Clock clock.Clock
 }
 
 // NewCharmStore returns a new charm store instance.
-func NewCharmStore(objectStoreGetter objectstore.ModelObjectStoreGetter, logger logger.Logger) *CharmStore {
-	return &CharmStore{
-		objectStoreGetter: objectStoreGetter,
+func NewCharmStore(config Config) *CharmStore {
+	digests := config.Digests
+	if len(digests) == 0 {
+		digests = DefaultDigestAlgorithms
+	}
+	clk := config.Clock
+	if clk == nil {
+		clk = clock.WallClock
+	}
+	if config.Quota > 0 && config.Usage == nil {
+		config.Logger.Warningf(context.Background(), "no object store usage reporter, not enforcing charm quota")
+	}
+	s := &CharmStore{
+		objectStoreGetter: config.ObjectStoreGetter,
+		quota:             config.Quota,
+		usage:             config.Usage,
+		charms:            config.Charms,
+		tempFiles:         config.TempFiles,
 		encoder:           base64.StdEncoding.WithPadding(base64.NoPadding),
-		logger:            logger,
+		digests:           digests,
+		logger:            config.Logger,
+		clock:             clk,
 	}
+	s.newUniqueName = s.generateUniqueName
+	return s
 }
 
 // Store the charm at the specified path into the object store. It is expected
 // that the archive already exists at the specified path. If the file isn't
-// found, a [ErrNotFound] is returned.
-func (s *CharmStore) Store(ctx context.Context, path string, size int64, sha384 string) (StoreResult, error) {
+// found, a [ErrNotFound] is returned. If storing the charm would take the
+// object store over the quota, [ErrQuotaExceeded] is returned. The source
+// is optional, and is returned with the stored charm. If a charm with the
+// same SHA384 hash and source has already been stored, and is still in the
+// object store, the existing charm is returned rather than storing the
+// content again. The file is always checked against the SHA384 hash, and
+// [ErrCharmHashMismatch] is returned if it doesn't match.
+func (s *CharmStore) Store(ctx context.Context, path string, size int64, sha384 string, source charm.CharmSource) (StoreResult, error) {
+	return s.store(ctx, path, size, sha384, source, false)
+}
+
+// StoreFresh stores the charm at the specified path into the object store,
+// as Store does, but always stores a new copy, even if a charm with the
+// same content has already been stored.
+func (s *CharmStore) StoreFresh(ctx context.Context, path string, size int64, sha384 string, source charm.CharmSource) (StoreResult, error) {
+	return s.store(ctx, path, size, sha384, source, true)
+}
+
+func (s *CharmStore) store(ctx context.Context, path string, size int64, sha384 string, source charm.CharmSource, fresh bool) (StoreResult, error) {
 	file, err := os.Open(path)
 	if errors.Is(err, os.ErrNotExist) {
 		return StoreResult{}, errors.Errorf("%q: %w", path, ErrNotFound)
@@ -92,36 +274,87 @@ func (s *CharmStore) Store(ctx context.Context, path string, size int64, sha384
 	// Ensure that we close any open handles to the file.
 	defer file.Close()
 
+	if !fresh {
+		existing, ok, err := s.existingCharm(ctx, file, sha384, source)
+		if err != nil {
+			return StoreResult{}, errors.Capture(err)
+		} else if ok {
+			return existing, nil
+		}
+	}
+
 	// Generate a unique path for the file.
-	unique, err := uuid.NewUUID()
+	uniqueName, err := s.newUniqueName()
 	if err != nil {
-		return StoreResult{}, errors.Errorf("cannot generate unique path")
+		return StoreResult{}, errors.Capture(err)
 	}
-	uniqueName := s.encoder.EncodeToString(unique[:])
 
 	// Store the file in the object store.
-	objectStore, err := s.objectStoreGetter.GetObjectStore(ctx)
+	objectStore, err := s.getObjectStore(ctx)
 	if err != nil {
-		return StoreResult{}, errors.Errorf("getting object store: %w", err)
+		return StoreResult{}, errors.Capture(err)
+	}
+
+	if err := s.checkQuota(ctx, size); err != nil {
+		return StoreResult{}, errors.Capture(err)
 	}
 
 	uuid, err := objectStore.PutAndCheckHash(ctx, uniqueName, file, size, sha384)
 	if err != nil {
 		return StoreResult{}, errors.Errorf("putting charm: %w", err)
 	}
+
 	return StoreResult{
 		UniqueName:      uniqueName,
 		ObjectStoreUUID: uuid,
+		Source:          source,
 	}, nil
 }
 
+// existingCharm returns the charm already stored with the given SHA384 hash
+// and source, if there is one. The file is hashed first, so that a file
+// which doesn't match the hash is never resolved to an existing charm. The
+// file is rewound afterwards, so that it can still be stored.
+func (s *CharmStore) existingCharm(
+	ctx context.Context, file *os.File, sha384 string, source charm.CharmSource,
+) (StoreResult, bool, error) {
+	if s.charms == nil {
+		return StoreResult{}, false, nil
+	}
+
+	hasher := sha512.New384()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return StoreResult{}, false, errors.Errorf("hashing charm: %w", err)
+	}
+	if hex.EncodeToString(hasher.Sum(nil)) != sha384 {
+		return StoreResult{}, false, ErrCharmHashMismatch
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return StoreResult{}, false, errors.Errorf("seeking charm: %w", err)
+	}
+
+	path, uuid, err := s.charms.GetCharmArchiveBySHA384(ctx, sha384, source)
+	if errors.Is(err, applicationerrors.CharmNotFound) {
+		return StoreResult{}, false, nil
+	} else if err != nil {
+		return StoreResult{}, false, errors.Errorf("checking existing charm: %w", err)
+	}
+	return StoreResult{
+		UniqueName:      path,
+		ObjectStoreUUID: uuid,
+		Source:          source,
+	}, true, nil
+}
+
 // StoreFromReader stores the charm from the provided reader into the object
-// store. The caller is expected to remove the temporary file after the call.
-// This does not check the integrity of the charm hash.
-func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hashPrefix string) (_ StoreFromReaderResult, _ Digest, err error) {
-	file, err := os.CreateTemp("", "charm-")
+// store. The caller is expected to call Cleanup on the result, to remove the
+// temporary file backing the charm reader. This does not check the integrity of the charm hash.
+// If storing the charm would take the object store over the quota,
+// [ErrQuotaExceeded] is returned. The source is handled as it is by Store.
+func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hashPrefix string, source charm.CharmSource) (_ StoreFromReaderResult, _ Digest, err error) {
+	file, err := s.createTempFile()
 	if err != nil {
-		return StoreFromReaderResult{}, Digest{}, errors.Errorf("creating temporary file: %w", err)
+		return StoreFromReaderResult{}, Digest{}, errors.Errorf("creating temporary file: %w", err).Add(ErrTempFileIO)
 	}
 
 	// Clean up the temporary file if an error occurs.
@@ -129,29 +362,25 @@ func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hash
 		if err == nil {
 			return
 		}
-		if closeErr := file.Close(); closeErr != nil {
-			s.logger.Errorf(ctx, "closing temporary file: %v", closeErr)
-		}
-		if removeErr := os.Remove(file.Name()); removeErr != nil {
-			s.logger.Errorf(ctx, "removing temporary file: %v", removeErr)
+		if releaseErr := s.releaseTempFile(file); releaseErr != nil {
+			s.logger.Errorf(ctx, "closing temporary file: %v", releaseErr)
 		}
 	}()
 
 	// Store the file in the object store.
-	objectStore, err := s.objectStoreGetter.GetObjectStore(ctx)
+	objectStore, err := s.getObjectStore(ctx)
 	if err != nil {
-		return StoreFromReaderResult{}, Digest{}, errors.Errorf("getting object store: %w", err)
+		return StoreFromReaderResult{}, Digest{}, errors.Capture(err)
 	}
 
 	// Generate a unique path for the file.
-	unique, err := uuid.NewUUID()
+	uniqueName, err := s.newUniqueName()
 	if err != nil {
-		return StoreFromReaderResult{}, Digest{}, errors.Errorf("cannot generate unique path")
+		return StoreFromReaderResult{}, Digest{}, errors.Capture(err)
 	}
-	uniqueName := s.encoder.EncodeToString(unique[:])
 
 	// Copy the reader into the temporary file.
-	sha256, sha384, size, err := storeAndComputeHashes(file, reader)
+	digest, err := storeAndComputeHashes(tempFileWriter{Writer: file}, reader, s.digests)
 	if err != nil {
 		return StoreFromReaderResult{}, Digest{}, errors.Errorf("storing charm from reader: %w", err)
 	}
@@ -159,37 +388,135 @@ func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hash
 	// Ensure that we sync the file to disk, as the process may crash before
 	// the file is written to disk.
 	if err := file.Sync(); err != nil {
-		return StoreFromReaderResult{}, Digest{}, errors.Errorf("syncing temporary file: %w", err)
+		return StoreFromReaderResult{}, Digest{}, errors.Errorf("syncing temporary file: %w", err).Add(ErrTempFileIO)
 	}
 	if _, err := file.Seek(0, io.SeekStart); err != nil {
-		return StoreFromReaderResult{}, Digest{}, errors.Errorf("seeking temporary file: %w", err)
+		return StoreFromReaderResult{}, Digest{}, errors.Errorf("seeking temporary file: %w", err).Add(ErrTempFileIO)
 	}
 
-	if !strings.HasPrefix(sha256, hashPrefix) {
+	if !strings.HasPrefix(digest.SHA256, hashPrefix) {
 		return StoreFromReaderResult{}, Digest{}, ErrCharmHashMismatch
 	}
 
-	uuid, err := objectStore.PutAndCheckHash(ctx, uniqueName, file, size, sha384)
+	if err := s.checkQuota(ctx, digest.Size); err != nil {
+		return StoreFromReaderResult{}, Digest{}, errors.Capture(err)
+	}
+
+	uuid, err := objectStore.PutAndCheckHash(ctx, uniqueName, file, digest.Size, digest.SHA384)
 	if err != nil {
 		return StoreFromReaderResult{}, Digest{}, errors.Errorf("putting charm: %w", err)
 	}
 
 	if _, err := file.Seek(0, io.SeekStart); err != nil {
-		return StoreFromReaderResult{}, Digest{}, errors.Errorf("seeking temporary file: %w", err)
+		return StoreFromReaderResult{}, Digest{}, errors.Errorf("seeking temporary file: %w", err).Add(ErrTempFileIO)
 	}
 
+	charmReader := &charmReaderCloser{
+		file:    file,
+		release: s.releaseTempFile,
+	}
+	// As a last resort, release the temporary file if the caller drops the
+	// result without cleaning it up.
+	runtime.SetFinalizer(charmReader, func(c *charmReaderCloser) {
+		s.logger.Warningf(context.Background(), "charm reader for %q was not cleaned up", c.file.Name())
+		if err := c.Close(); err != nil {
+			s.logger.Errorf(context.Background(), "closing temporary file: %v", err)
+		}
+	})
+
 	return StoreFromReaderResult{
-			Charm: &charmReaderCloser{
-				file:   file,
-				logger: s.logger,
-			},
-			UniqueName:      uniqueName,
-			ObjectStoreUUID: uuid,
-		}, Digest{
-			SHA256: sha256,
-			SHA384: sha384,
-			Size:   size,
-		}, nil
+		Charm:           charmReader,
+		UniqueName:      uniqueName,
+		ObjectStoreUUID: uuid,
+		Source:          source,
+	}, digest, nil
+}
+
+// generateUniqueName returns a new name, derived from a UUID, to store a
+// charm archive under.
+func (s *CharmStore) generateUniqueName() (string, error) {
+	unique, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Errorf("cannot generate unique path")
+	}
+	return s.encoder.EncodeToString(unique[:]), nil
+}
+
+// getObjectStore returns the model object store. Transient errors, such as
+// the change stream being restarted during controller startup, are retried
+// a bounded number of times, until the context is done. Any other error is
+// returned immediately.
+func (s *CharmStore) getObjectStore(ctx context.Context) (objectstore.ObjectStore, error) {
+	var store objectstore.ObjectStore
+	err := retry.Call(retry.CallArgs{
+		Func: func() error {
+			var err error
+			store, err = s.objectStoreGetter.GetObjectStore(ctx)
+			return err
+		},
+		IsFatalError: func(err error) bool {
+			return !isTransientObjectStoreError(err)
+		},
+		NotifyFunc: func(err error, attempt int) {
+			s.logger.Debugf(ctx, "getting object store, attempt %d: %v", attempt, err)
+		},
+		Attempts:    getObjectStoreAttempts,
+		Delay:       getObjectStoreDelay,
+		BackoffFunc: retry.DoubleDelay,
+		Clock:       s.clock,
+		Stop:        ctx.Done(),
+	})
+	if retry.IsRetryStopped(err) {
+		err = ctx.Err()
+	} else if retry.IsAttemptsExceeded(err) {
+		err = retry.LastError(err)
+	}
+	if err != nil {
+		return nil, errors.Errorf("getting object store: %w", err).Add(ErrObjectStoreUnavailable)
+	}
+	return store, nil
+}
+
+// isTransientObjectStoreError returns true if the error returned when
+// getting the object store is expected to go away if retried.
+func isTransientObjectStoreError(err error) bool {
+	return errors.Is(err, database.ErrChangeStreamDying) ||
+		errors.Is(err, database.ErrEventMultiplexerDying) ||
+		internaldatabase.IsErrRetryable(err)
+}
+
+// createTempFile returns an empty temporary file to back a charm reader.
+func (s *CharmStore) createTempFile() (*os.File, error) {
+	if s.tempFiles != nil {
+		return s.tempFiles.Get()
+	}
+	return os.CreateTemp("", "charm-")
+}
+
+// releaseTempFile returns the temporary file to the pool, if there is one,
+// otherwise the file is closed and removed.
+func (s *CharmStore) releaseTempFile(file *os.File) error {
+	if s.tempFiles != nil {
+		return s.tempFiles.Put(file)
+	}
+	return removeTempFile(file, s.logger)
+}
+
+// checkQuota returns [ErrQuotaExceeded] if adding size bytes to the object
+// store would take it over the quota. If there is no usage reporter, the
+// quota isn't enforced.
+func (s *CharmStore) checkQuota(ctx context.Context, size int64) error {
+	if s.quota <= 0 || s.usage == nil {
+		return nil
+	}
+	used, err := s.usage.Usage(ctx)
+	if err != nil {
+		return errors.Errorf("getting object store usage: %w", err)
+	}
+	if used+size > s.quota {
+		return errors.Errorf("storing %d bytes with %d of %d bytes used: %w", size, used, s.quota, ErrQuotaExceeded)
+	}
+	return nil
 }
 
 // Get retrieves a ReadCloser for the charm archive at the give path from
@@ -197,9 +524,9 @@ func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hash
 // NOTE: It is up to the caller to verify the integrity of the data from the charm
 // hash stored in DQLite.
 func (s *CharmStore) Get(ctx context.Context, path string) (io.ReadCloser, error) {
-	store, err := s.objectStoreGetter.GetObjectStore(ctx)
+	store, err := s.getObjectStore(ctx)
 	if err != nil {
-		return nil, errors.Errorf("getting object store: %w", err)
+		return nil, errors.Capture(err)
 	}
 	reader, _, err := store.Get(ctx, path)
 	if errors.Is(err, objectstoreerrors.ObjectNotFound) {
@@ -211,12 +538,30 @@ func (s *CharmStore) Get(ctx context.Context, path string) (io.ReadCloser, error
 	return reader, nil
 }
 
+// GetVerified retrieves a ReadCloser for the charm archive at the given path
+// from the underlying storage. The SHA384 hash of the charm archive is
+// computed as it is read, and is checked against the expected hash when the
+// reader is closed. Any unread data is consumed on Close, so that the hash
+// always covers the whole archive. If the hashes don't match,
+// [ErrCharmHashMismatch] is returned from Close.
+func (s *CharmStore) GetVerified(ctx context.Context, path string, expectedSHA384 string) (io.ReadCloser, error) {
+	reader, err := s.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return &verifiedReadCloser{
+		reader:   reader,
+		hasher:   sha512.New384(),
+		expected: expectedSHA384,
+	}, nil
+}
+
 // GetBySHA256Prefix retrieves a ReadCloser for a charm archive who's SHA256 hash
 // starts with the provided prefix.
 func (s *CharmStore) GetBySHA256Prefix(ctx context.Context, sha256Prefix string) (io.ReadCloser, error) {
-	store, err := s.objectStoreGetter.GetObjectStore(ctx)
+	store, err := s.getObjectStore(ctx)
 	if err != nil {
-		return nil, errors.Errorf("getting object store: %w", err)
+		return nil, errors.Capture(err)
 	}
 	reader, _, err := store.GetBySHA256Prefix(ctx, sha256Prefix)
 	if errors.Is(err, objectstoreerrors.ObjectNotFound) {
@@ -228,9 +573,32 @@ func (s *CharmStore) GetBySHA256Prefix(ctx context.Context, sha256Prefix string)
 	return reader, nil
 }
 
+// WatchDeletions returns a watcher that emits the unique names of charm
+// archives that have been removed from the underlying storage. If the object
+// store can't report deletions, an error satisfying [coreerrors.NotSupported]
+// is returned, and callers should fall back to polling.
+func (s *CharmStore) WatchDeletions(ctx context.Context) (watcher.StringsWatcher, error) {
+	store, err := s.getObjectStore(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	deletionWatcher, ok := store.(DeletionWatcher)
+	if !ok {
+		return nil, errors.Errorf("watching charm deletions %w", coreerrors.NotSupported)
+	}
+	w, err := deletionWatcher.WatchDeletions(ctx)
+	if err != nil {
+		return nil, errors.Errorf("watching charm deletions: %w", err)
+	}
+	return w, nil
+}
+
 type charmReaderCloser struct {
-	file   *os.File
-	logger logger.Logger
+	file    *os.File
+	release func(*os.File) error
+
+	closeOnce sync.Once
+	closeErr  error
 }
 
 func (c *charmReaderCloser) Read(p []byte) (n int, err error) {
@@ -241,29 +609,113 @@ func (c *charmReaderCloser) ReadAt(p []byte, off int64) (n int, err error) {
 	return c.file.ReadAt(p, off)
 }
 
+// Close releases the temporary file, either removing it or returning it to
+// the temporary file pool. Subsequent calls are no-ops and return the result
+// of the first call.
 func (c *charmReaderCloser) Close() error {
-	err := c.file.Close()
-	if removeErr := os.Remove(c.file.Name()); removeErr != nil {
-		// We don't need to log this as error, as the file will be removed
-		// when the process exits or by the OS. It's not a direct action
-		// by the user, hence Info.
-		c.logger.Infof(context.Background(), "removing temporary file: %v", removeErr)
+	c.closeOnce.Do(func() {
+		runtime.SetFinalizer(c, nil)
+		c.closeErr = c.release(c.file)
+	})
+	return c.closeErr
+}
+
+// verifiedReadCloser computes the SHA384 hash of the data read from the
+// underlying reader, and verifies it against the expected hash on Close.
+type verifiedReadCloser struct {
+	reader   io.ReadCloser
+	hasher   hash.Hash
+	expected string
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func (v *verifiedReadCloser) Read(p []byte) (int, error) {
+	n, err := v.reader.Read(p)
+	v.hasher.Write(p[:n])
+	return n, err
+}
+
+// Close consumes any unread data, closes the underlying reader and verifies
+// the hash of the data. Subsequent calls are no-ops and return the result
+// of the first call.
+func (v *verifiedReadCloser) Close() error {
+	v.closeOnce.Do(func() {
+		v.closeErr = v.close()
+	})
+	return v.closeErr
+}
+
+func (v *verifiedReadCloser) close() error {
+	_, copyErr := io.Copy(v.hasher, v.reader)
+	if err := v.reader.Close(); err != nil {
+		return errors.Errorf("closing charm: %w", err)
+	}
+	if copyErr != nil {
+		return errors.Errorf("reading charm: %w", copyErr)
 	}
-	return err
+	if hex.EncodeToString(v.hasher.Sum(nil)) != v.expected {
+		return ErrCharmHashMismatch
+	}
+	return nil
+}
+
+// tempFileWriter tags errors writing to a temporary file with
+// [ErrTempFileIO], so that they can be told apart from errors reading the
+// source when both surface from the same copy.
+type tempFileWriter struct {
+	io.Writer
 }
 
-func storeAndComputeHashes(writer io.Writer, reader io.Reader) (string, string, int64, error) {
-	hasher256 := sha256.New()
-	hasher384 := sha512.New384()
+// Write is part of the io.Writer interface.
+func (w tempFileWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	if err != nil {
+		return n, errors.Errorf("writing temporary file: %w", err).Add(ErrTempFileIO)
+	}
+	return n, nil
+}
 
-	size, err := io.Copy(writer, io.TeeReader(reader, io.MultiWriter(hasher256, hasher384)))
+// storeAndComputeHashes copies the reader into the writer, computing the
+// hashes of the data for the given algorithms as it goes. SHA256 and SHA384
+// are always computed.
+func storeAndComputeHashes(writer io.Writer, reader io.Reader, algorithms []HashAlgorithm) (Digest, error) {
+	hashers := map[HashAlgorithm]hash.Hash{
+		SHA256: sha256.New(),
+		SHA384: sha512.New384(),
+	}
+	for _, algorithm := range algorithms {
+		if _, ok := hashers[algorithm]; ok {
+			continue
+		}
+		switch algorithm {
+		case SHA512:
+			hashers[algorithm] = sha512.New()
+		default:
+			return Digest{}, errors.Errorf("hash algorithm %q %w", algorithm, coreerrors.NotSupported)
+		}
+	}
+
+	writers := make([]io.Writer, 0, len(hashers))
+	for _, hasher := range hashers {
+		writers = append(writers, hasher)
+	}
+
+	size, err := io.Copy(writer, io.TeeReader(reader, io.MultiWriter(writers...)))
 	if errors.Is(err, io.EOF) {
-		return "", "", -1, ErrFileToLarge
+		return Digest{}, ErrFileToLarge
 	} else if err != nil {
-		return "", "", -1, errors.Errorf("hashing charm: %w", err)
+		return Digest{}, errors.Errorf("hashing charm: %w", err)
 	}
 
-	sha256 := hex.EncodeToString(hasher256.Sum(nil))
-	sha384 := hex.EncodeToString(hasher384.Sum(nil))
-	return sha256, sha384, size, nil
+	digest := Digest{
+		SHA256: hex.EncodeToString(hashers[SHA256].Sum(nil)),
+		SHA384: hex.EncodeToString(hashers[SHA384].Sum(nil)),
+		Size:   size,
+	}
+	if hasher, ok := hashers[SHA512]; ok {
+		digest.SHA512 = hex.EncodeToString(hasher.Sum(nil))
+	}
+	return digest, nil
 }
@@ -11,15 +11,25 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/database"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/objectstore"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
@@ -30,6 +40,7 @@ type storeSuite struct {
 
 	objectStore       *MockObjectStore
 	objectStoreGetter *MockModelObjectStoreGetter
+	charms            *MockStoredCharms
 }
 
 var _ = gc.Suite(&storeSuite{})
@@ -58,17 +69,191 @@ func (s *storeSuite) TestStore(c *gc.C) {
 			return uuid, nil
 		})
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
-	storeResult, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384)
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	storeResult, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
 	c.Assert(err, jc.ErrorIsNil)
 
 	c.Check(storeResult.ObjectStoreUUID, gc.DeepEquals, uuid)
 	c.Check(storeResult.UniqueName, gc.Equals, uniqueName)
+	c.Check(storeResult.Source, gc.Equals, charm.CharmHubSource)
 
 	// Make sure the contents are the same and it's not been tampered with.
 	c.Check(contents, gc.Equals, "hello world")
 }
 
+func (s *storeSuite) TestStoreIdenticalContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+	s.charms.EXPECT().
+		GetCharmArchiveBySHA384(gomock.Any(), contentDigest.SHA384, charm.CharmHubSource).
+		Return("foo-abc", uuid, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Charms:            s.charms,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	result, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, StoreResult{
+		UniqueName:      "foo-abc",
+		ObjectStoreUUID: uuid,
+		Source:          charm.CharmHubSource,
+	})
+}
+
+func (s *storeSuite) TestStoreIdenticalContentSeparateStores(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	// The first store writes the charm, which is then recorded in the
+	// charm table. A second, separate store finds it there.
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+	var uniqueName string
+	gomock.InOrder(
+		s.charms.EXPECT().
+			GetCharmArchiveBySHA384(gomock.Any(), contentDigest.SHA384, charm.CharmHubSource).
+			Return("", "", applicationerrors.CharmNotFound),
+		s.objectStore.EXPECT().
+			PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+			DoAndReturn(func(_ context.Context, name string, _ io.Reader, _ int64, _ string) (objectstore.UUID, error) {
+				uniqueName = name
+				return uuid, nil
+			}),
+		s.charms.EXPECT().
+			GetCharmArchiveBySHA384(gomock.Any(), contentDigest.SHA384, charm.CharmHubSource).
+			DoAndReturn(func(context.Context, string, charm.CharmSource) (string, objectstore.UUID, error) {
+				return uniqueName, uuid, nil
+			}),
+	)
+
+	config := Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Charms:            s.charms,
+		Logger:            loggertesting.WrapCheckLog(c),
+	}
+	first, err := NewCharmStore(config).Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+
+	second, err := NewCharmStore(config).Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(second, gc.DeepEquals, first)
+}
+
+func (s *storeSuite) TestStoreIdenticalContentHashMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	// The file doesn't match the hash it's claimed to have, so it must not
+	// be matched against an existing charm, nor stored.
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Charms:            s.charms,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), path, contentDigest.Size, "deadbeef", charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIs, ErrCharmHashMismatch)
+}
+
+func (s *storeSuite) TestStoreIdenticalContentDifferentSource(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	// Only charms with the same source are reused.
+	s.charms.EXPECT().
+		GetCharmArchiveBySHA384(gomock.Any(), contentDigest.SHA384, charm.LocalSource).
+		Return("", "", applicationerrors.CharmNotFound)
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(objectstoretesting.GenObjectStoreUUID(c), nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Charms:            s.charms,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	result, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.LocalSource)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Source, gc.Equals, charm.LocalSource)
+}
+
+func (s *storeSuite) TestStoreLookupError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	s.charms.EXPECT().
+		GetCharmArchiveBySHA384(gomock.Any(), contentDigest.SHA384, charm.CharmHubSource).
+		Return("", "", errors.Errorf("boom"))
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Charms:            s.charms,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, gc.ErrorMatches, ".*boom")
+}
+
+func (s *storeSuite) TestStoreNoStoredCharms(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	// Without a way to find stored charms, every charm is stored.
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(objectstoretesting.GenObjectStoreUUID(c), nil).Times(2)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	first, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+
+	second, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(second.UniqueName, gc.Not(gc.Equals), first.UniqueName)
+}
+
+func (s *storeSuite) TestStoreFresh(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	// StoreFresh never looks for an existing charm.
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(uuid, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Charms:            s.charms,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	result, err := storage.StoreFresh(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.ObjectStoreUUID, gc.Equals, uuid)
+}
+
 func (s *storeSuite) TestStoreFileClosed(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -86,8 +271,11 @@ func (s *storeSuite) TestStoreFileClosed(c *gc.C) {
 			return uuid, nil
 		})
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
-	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384)
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Attempt to read the contents of the read after it's been closed.
@@ -101,8 +289,11 @@ func (s *storeSuite) TestStoreFileNotFound(c *gc.C) {
 
 	dir := c.MkDir()
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
-	_, err := storage.Store(context.Background(), filepath.Join(dir, "foo"), 12, "hash")
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), filepath.Join(dir, "foo"), 12, "hash", charm.CharmHubSource)
 	c.Assert(err, jc.ErrorIs, ErrNotFound)
 }
 
@@ -116,11 +307,174 @@ func (s *storeSuite) TestStoreFailed(c *gc.C) {
 		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
 		Return("", errors.Errorf("boom"))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
-	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384)
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
 	c.Assert(err, gc.ErrorMatches, ".*boom")
 }
 
+func (s *storeSuite) TestStoreWithinQuota(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+
+	objectStore := NewMockObjectStore(ctrl)
+	usage := usageReporter{used: 89}
+	objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(uuid, nil)
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(objectStore, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Quota:             100,
+		Usage:             usage,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	storeResult, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(storeResult.ObjectStoreUUID, gc.DeepEquals, uuid)
+}
+
+func (s *storeSuite) TestStoreQuotaExceeded(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	objectStore := NewMockObjectStore(ctrl)
+	usage := usageReporter{used: 90}
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(objectStore, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Quota:             100,
+		Usage:             usage,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIs, ErrQuotaExceeded)
+}
+
+func (s *storeSuite) TestStoreQuotaUsageError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	objectStore := NewMockObjectStore(ctrl)
+	usage := usageReporter{err: errors.Errorf("boom")}
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(objectStore, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Quota:             100,
+		Usage:             usage,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, gc.ErrorMatches, ".*boom")
+}
+
+func (s *storeSuite) TestStoreQuotaNoUsageReporter(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(uuid, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Quota:             1,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.Store(context.Background(), path, contentDigest.Size, contentDigest.SHA384, charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *storeSuite) TestStoreFromReaderQuotaExceeded(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+	reader, err := os.Open(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	objectStore := NewMockObjectStore(ctrl)
+	usage := usageReporter{used: 90}
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(objectStore, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Quota:             100,
+		Usage:             usage,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, _, err = storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
+	c.Assert(err, jc.ErrorIs, ErrQuotaExceeded)
+}
+
+func (s *storeSuite) TestStoreFromReaderTempFileError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The pool's directory doesn't exist, so no temporary file can be
+	// created in it.
+	tempFiles := NewTempFilePool(filepath.Join(c.MkDir(), "missing"), 1, loggertesting.WrapCheckLog(c))
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		TempFiles:         tempFiles,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, _, err := storage.StoreFromReader(context.Background(), strings.NewReader("hello world"), "", charm.LocalSource)
+	c.Assert(err, jc.ErrorIs, ErrTempFileIO)
+}
+
+func (s *storeSuite) TestStoreFromReaderObjectStoreUnavailable(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(nil, errors.Errorf("boom"))
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, _, err := storage.StoreFromReader(context.Background(), strings.NewReader("hello world"), "", charm.LocalSource)
+	c.Assert(err, jc.ErrorIs, ErrObjectStoreUnavailable)
+}
+
+func (s *storeSuite) TestStoreAndComputeHashesTempFileWriteError(c *gc.C) {
+	_, err := storeAndComputeHashes(tempFileWriter{Writer: failingWriter{}}, strings.NewReader("hello world"), nil)
+	c.Assert(err, jc.ErrorIs, ErrTempFileIO)
+}
+
+func (s *storeSuite) TestStoreAndComputeHashesReadError(c *gc.C) {
+	reader := io.MultiReader(strings.NewReader("hello"), failingReader{})
+	_, err := storeAndComputeHashes(tempFileWriter{Writer: io.Discard}, reader, nil)
+	c.Assert(err, gc.ErrorMatches, "hashing charm: boom")
+	c.Check(errors.Is(err, ErrTempFileIO), jc.IsFalse)
+}
+
 func (s *storeSuite) TestStoreFromReader(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -147,12 +501,16 @@ func (s *storeSuite) TestStoreFromReader(c *gc.C) {
 			return uuid, nil
 		})
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
-	storeResult, digest, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7])
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	storeResult, digest, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
 	c.Assert(err, jc.ErrorIsNil)
 
 	c.Check(storeResult.ObjectStoreUUID, gc.DeepEquals, uuid)
 	c.Check(storeResult.UniqueName, gc.Equals, uniqueName)
+	c.Check(storeResult.Source, gc.Equals, charm.LocalSource)
 
 	c.Check(digest, gc.DeepEquals, contentDigest)
 
@@ -160,6 +518,211 @@ func (s *storeSuite) TestStoreFromReader(c *gc.C) {
 	c.Check(contents, gc.Equals, "hello world")
 }
 
+func (s *storeSuite) TestStoreFromReaderWithSHA512Digest(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+	reader, err := os.Open(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The object store still expects the SHA384 hash, even though it isn't
+	// one of the configured digests.
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(objectstoretesting.GenObjectStoreUUID(c), nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Digests:           []HashAlgorithm{SHA512},
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	storeResult, digest, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
+	c.Assert(err, jc.ErrorIsNil)
+	defer storeResult.Cleanup()
+
+	contentDigest.SHA512 = calculateSHA512(c, "hello world")
+	c.Check(digest, gc.DeepEquals, contentDigest)
+}
+
+func (s *storeSuite) TestStoreFromReaderUnsupportedDigest(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+	reader, err := os.Open(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Digests:           []HashAlgorithm{"md5"},
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, _, err = storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
+	c.Assert(err, jc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *storeSuite) TestStoreFromReaderCleanup(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	tmpDir := c.MkDir()
+	s.PatchEnvironment("TMPDIR", tmpDir)
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+	reader, err := os.Open(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(objectstoretesting.GenObjectStoreUUID(c), nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	storeResult, _, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
+	c.Assert(err, jc.ErrorIsNil)
+
+	entries, err := os.ReadDir(tmpDir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, gc.HasLen, 1)
+	c.Check(strings.HasPrefix(entries[0].Name(), "charm-"), jc.IsTrue)
+
+	// Cleaning up without ever reading from the charm must remove the
+	// temporary file.
+	err = storeResult.Cleanup()
+	c.Assert(err, jc.ErrorIsNil)
+
+	entries, err = os.ReadDir(tmpDir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+
+	// Cleaning up or closing again is a no-op.
+	c.Check(storeResult.Cleanup(), jc.ErrorIsNil)
+	c.Check(storeResult.Charm.Close(), jc.ErrorIsNil)
+}
+
+func (s *storeSuite) TestStoreFromReaderDroppedResultIsReleased(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	tmpDir := c.MkDir()
+	s.PatchEnvironment("TMPDIR", tmpDir)
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+	reader, err := os.Open(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		Return(objectstoretesting.GenObjectStoreUUID(c), nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+
+	// Drop the result without cleaning it up, so that the only thing
+	// releasing the temporary file is the finalizer.
+	func() {
+		_, _, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
+		c.Assert(err, jc.ErrorIsNil)
+	}()
+
+	timeout := time.After(testing.LongWait)
+	for {
+		runtime.GC()
+
+		entries, err := os.ReadDir(tmpDir)
+		c.Assert(err, jc.ErrorIsNil)
+		if len(entries) == 0 {
+			return
+		}
+
+		select {
+		case <-timeout:
+			c.Fatalf("temporary file %q was not released", entries[0].Name())
+		case <-time.After(testing.ShortWait):
+		}
+	}
+}
+
+func (s *storeSuite) TestStoreFromReaderWithTempFilePool(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	tmpDir := c.MkDir()
+	pool := NewTempFilePool(tmpDir, 1, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		TempFiles:         pool,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+
+	dir := c.MkDir()
+	var names []string
+	for _, content := range []string{"hello world", "bye"} {
+		path, contentDigest := s.createTempFile(c, dir, content)
+		reader, err := os.Open(path)
+		c.Assert(err, jc.ErrorIsNil)
+
+		s.objectStore.EXPECT().
+			PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+			Return(objectstoretesting.GenObjectStoreUUID(c), nil)
+
+		storeResult, digest, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(digest, gc.DeepEquals, contentDigest)
+
+		// The reused file only holds the current charm.
+		data, err := io.ReadAll(storeResult.Charm)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(string(data), gc.Equals, content)
+
+		names = append(names, storeResult.Charm.(*charmReaderCloser).file.Name())
+		c.Assert(storeResult.Cleanup(), jc.ErrorIsNil)
+	}
+
+	// The second charm reused the temporary file of the first.
+	c.Check(names[1], gc.Equals, names[0])
+
+	entries, err := os.ReadDir(tmpDir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 1)
+
+	c.Assert(pool.Close(), jc.ErrorIsNil)
+	entries, err = os.ReadDir(tmpDir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+}
+
+func (s *storeSuite) TestStoreFromReaderCleanupEmptyResult(c *gc.C) {
+	c.Check(StoreFromReaderResult{}.Cleanup(), jc.ErrorIsNil)
+}
+
+func (s *storeSuite) TestStoreFromReaderErrorRemovesTempFile(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	tmpDir := c.MkDir()
+	s.PatchEnvironment("TMPDIR", tmpDir)
+
+	dir := c.MkDir()
+	path, _ := s.createTempFile(c, dir, "hello world")
+	reader, err := os.Open(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, _, err = storage.StoreFromReader(context.Background(), reader, "blah", charm.LocalSource)
+	c.Assert(err, jc.ErrorIs, ErrCharmHashMismatch)
+
+	entries, err := os.ReadDir(tmpDir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+}
+
 func (s *storeSuite) TestStoreFromReaderEmptyReader(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -167,8 +730,11 @@ func (s *storeSuite) TestStoreFromReaderEmptyReader(c *gc.C) {
 	_, contentDigest := s.createTempFile(c, dir, "hello world")
 	reader := io.NopCloser(strings.NewReader(""))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
-	_, _, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7])
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, _, err := storage.StoreFromReader(context.Background(), reader, contentDigest.SHA256[:7], charm.LocalSource)
 	c.Assert(err, jc.ErrorIs, ErrCharmHashMismatch)
 }
 
@@ -180,8 +746,11 @@ func (s *storeSuite) TestStoreFromReaderInvalidHash(c *gc.C) {
 	reader, err := os.Open(path)
 	c.Assert(err, jc.ErrorIsNil)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
-	_, _, err = storage.StoreFromReader(context.Background(), reader, "blah")
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, _, err = storage.StoreFromReader(context.Background(), reader, "blah", charm.LocalSource)
 	c.Assert(err, jc.ErrorIs, ErrCharmHashMismatch)
 }
 
@@ -191,7 +760,10 @@ func (s *storeSuite) TestGet(c *gc.C) {
 	archive := io.NopCloser(strings.NewReader("archive-content"))
 	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(archive, 0, nil)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
 	reader, err := storage.Get(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -205,7 +777,10 @@ func (s *storeSuite) TestGetFailed(c *gc.C) {
 
 	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(nil, 0, errors.Errorf("boom"))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
 
 	_, err := storage.Get(context.Background(), "foo")
 	c.Assert(err, gc.ErrorMatches, ".*boom")
@@ -216,18 +791,208 @@ func (s *storeSuite) TestGetNotFound(c *gc.C) {
 
 	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(nil, 0, objectstoreerrors.ObjectNotFound)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
 	_, err := storage.Get(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIs, ErrNotFound)
 }
 
+func (s *storeSuite) TestGetObjectStoreRetriesTransientErrors(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	archive := io.NopCloser(strings.NewReader("archive-content"))
+	objectStore := NewMockObjectStore(ctrl)
+	objectStore.EXPECT().Get(gomock.Any(), "foo").Return(archive, 0, nil)
+
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	gomock.InOrder(
+		objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(nil, database.ErrChangeStreamDying),
+		objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(nil, errors.Errorf("database is locked")),
+		objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(objectStore, nil),
+	)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+		Clock:             testclock.NewDilatedWallClock(0),
+	})
+
+	reader, err := storage.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	content, err := io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(content), gc.Equals, "archive-content")
+}
+
+func (s *storeSuite) TestGetObjectStoreTransientErrorsExhausted(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(nil, database.ErrChangeStreamDying).Times(getObjectStoreAttempts)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+		Clock:             testclock.NewDilatedWallClock(0),
+	})
+
+	_, err := storage.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, database.ErrChangeStreamDying)
+	c.Check(err, jc.ErrorIs, ErrObjectStoreUnavailable)
+}
+
+func (s *storeSuite) TestGetObjectStoreDoesNotRetryOtherErrors(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(nil, errors.Errorf("boom"))
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+		Clock:             testclock.NewDilatedWallClock(0),
+	})
+
+	_, err := storage.Get(context.Background(), "foo")
+	c.Assert(err, gc.ErrorMatches, `getting object store: boom`)
+	c.Check(err, jc.ErrorIs, ErrObjectStoreUnavailable)
+}
+
+func (s *storeSuite) TestGetObjectStoreContextCancelled(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).DoAndReturn(func(context.Context) (objectstore.ObjectStore, error) {
+		cancel()
+		return nil, database.ErrChangeStreamDying
+	})
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+
+	_, err := storage.Get(ctx, "foo")
+	c.Assert(err, jc.ErrorIs, context.Canceled)
+}
+
+func (s *storeSuite) TestGetVerified(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	archive := io.NopCloser(strings.NewReader("archive-content"))
+	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(archive, 0, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	reader, err := storage.GetVerified(context.Background(), "foo", calculateSHA384(c, "archive-content"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	content, err := io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(content), gc.Equals, "archive-content")
+
+	err = reader.Close()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *storeSuite) TestGetVerifiedPartialRead(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	archive := io.NopCloser(strings.NewReader("archive-content"))
+	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(archive, 0, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	reader, err := storage.GetVerified(context.Background(), "foo", calculateSHA384(c, "archive-content"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	buf := make([]byte, 7)
+	_, err = io.ReadFull(reader, buf)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(buf), gc.Equals, "archive")
+
+	err = reader.Close()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *storeSuite) TestGetVerifiedHashMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	archive := io.NopCloser(strings.NewReader("archive-content"))
+	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(archive, 0, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	reader, err := storage.GetVerified(context.Background(), "foo", calculateSHA384(c, "other-content"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = reader.Close()
+	c.Assert(err, jc.ErrorIs, ErrCharmHashMismatch)
+}
+
+func (s *storeSuite) TestGetVerifiedDoubleClose(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	archive := &closeCountingReader{Reader: strings.NewReader("archive-content")}
+	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(archive, 0, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	reader, err := storage.GetVerified(context.Background(), "foo", calculateSHA384(c, "other-content"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The second Close returns the result of the first, without closing
+	// the archive again or hashing an already consumed reader.
+	err = reader.Close()
+	c.Assert(err, jc.ErrorIs, ErrCharmHashMismatch)
+	err = reader.Close()
+	c.Assert(err, jc.ErrorIs, ErrCharmHashMismatch)
+	c.Check(archive.closed, gc.Equals, 1)
+}
+
+func (s *storeSuite) TestGetVerifiedNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(nil, 0, objectstoreerrors.ObjectNotFound)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.GetVerified(context.Background(), "foo", "sha384")
+	c.Assert(err, jc.ErrorIs, ErrNotFound)
+}
+
 func (s *storeSuite) TestGetBySHA256Prefix(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	archive := io.NopCloser(strings.NewReader("archive-content"))
 	s.objectStore.EXPECT().GetBySHA256Prefix(gomock.Any(), "02638299").Return(archive, 0, nil)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
 	reader, err := storage.GetBySHA256Prefix(context.Background(), "02638299")
 	c.Assert(err, jc.ErrorIsNil)
 	content, err := io.ReadAll(reader)
@@ -240,7 +1005,10 @@ func (s *storeSuite) TestGetBySHA256PrefixFailed(c *gc.C) {
 
 	s.objectStore.EXPECT().GetBySHA256Prefix(gomock.Any(), "02638299").Return(nil, 0, errors.Errorf("boom"))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
 	_, err := storage.GetBySHA256Prefix(context.Background(), "02638299")
 	c.Assert(err, gc.ErrorMatches, ".*boom")
 }
@@ -250,16 +1018,62 @@ func (s *storeSuite) TestGetBySHA256NotFound(c *gc.C) {
 
 	s.objectStore.EXPECT().GetBySHA256Prefix(gomock.Any(), "02638299").Return(nil, 0, objectstoreerrors.ObjectNotFound)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
 	_, err := storage.GetBySHA256Prefix(context.Background(), "02638299")
 	c.Assert(err, jc.ErrorIs, ErrNotFound)
 }
 
+func (s *storeSuite) TestWatchDeletions(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ch := make(chan []string, 1)
+	ch <- []string{"foo"}
+	w := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.CleanKill(c, w)
+
+	objectStore := &deletionWatchingObjectStore{
+		MockObjectStore: NewMockObjectStore(ctrl),
+		watcher:         w,
+	}
+	objectStoreGetter := NewMockModelObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(objectStore, nil)
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	result, err := storage.WatchDeletions(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case names := <-result.Changes():
+		c.Check(names, jc.DeepEquals, []string{"foo"})
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for deletions")
+	}
+}
+
+func (s *storeSuite) TestWatchDeletionsNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storage := NewCharmStore(Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	_, err := storage.WatchDeletions(context.Background())
+	c.Assert(err, jc.ErrorIs, coreerrors.NotSupported)
+}
+
 func (s *storeSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.objectStore = NewMockObjectStore(ctrl)
 	s.objectStoreGetter = NewMockModelObjectStoreGetter(ctrl)
+	s.charms = NewMockStoredCharms(ctrl)
 
 	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil).AnyTimes()
 
@@ -288,9 +1102,56 @@ func calculateSHA384(c *gc.C, content string) string {
 	return hex.EncodeToString(hash.Sum(nil))
 }
 
+func calculateSHA512(c *gc.C, content string) string {
+	hash := sha512.New()
+	_, err := hash.Write([]byte(content))
+	c.Assert(err, jc.ErrorIsNil)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 func calculateSHA256(c *gc.C, content string) string {
 	hash := sha256.New()
 	_, err := hash.Write([]byte(content))
 	c.Assert(err, jc.ErrorIsNil)
 	return hex.EncodeToString(hash.Sum(nil))
 }
+
+type deletionWatchingObjectStore struct {
+	*MockObjectStore
+	watcher watcher.StringsWatcher
+}
+
+func (s *deletionWatchingObjectStore) WatchDeletions(context.Context) (watcher.StringsWatcher, error) {
+	return s.watcher, nil
+}
+
+type usageReporter struct {
+	used int64
+	err  error
+}
+
+func (r usageReporter) Usage(context.Context) (int64, error) {
+	return r.used, r.err
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+type closeCountingReader struct {
+	io.Reader
+	closed int
+}
+
+func (r *closeCountingReader) Close() error {
+	r.closed++
+	return nil
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/application/charm/store (interfaces: StoredCharms)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package store -destination storedcharms_mock_test.go github.com/juju/juju/domain/application/charm/store StoredCharms
+//
+
+// Package store is a generated GoMock package.
+package store
+
+import (
+	context "context"
+	reflect "reflect"
+
+	objectstore "github.com/juju/juju/core/objectstore"
+	charm "github.com/juju/juju/domain/application/charm"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStoredCharms is a mock of StoredCharms interface.
+type MockStoredCharms struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoredCharmsMockRecorder
+}
+
+// MockStoredCharmsMockRecorder is the mock recorder for MockStoredCharms.
+type MockStoredCharmsMockRecorder struct {
+	mock *MockStoredCharms
+}
+
+// NewMockStoredCharms creates a new mock instance.
+func NewMockStoredCharms(ctrl *gomock.Controller) *MockStoredCharms {
+	mock := &MockStoredCharms{ctrl: ctrl}
+	mock.recorder = &MockStoredCharmsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoredCharms) EXPECT() *MockStoredCharmsMockRecorder {
+	return m.recorder
+}
+
+// GetCharmArchiveBySHA384 mocks base method.
+func (m *MockStoredCharms) GetCharmArchiveBySHA384(arg0 context.Context, arg1 string, arg2 charm.CharmSource) (string, objectstore.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmArchiveBySHA384", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(objectstore.UUID)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCharmArchiveBySHA384 indicates an expected call of GetCharmArchiveBySHA384.
+func (mr *MockStoredCharmsMockRecorder) GetCharmArchiveBySHA384(arg0, arg1, arg2 any) *MockStoredCharmsGetCharmArchiveBySHA384Call {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmArchiveBySHA384", reflect.TypeOf((*MockStoredCharms)(nil).GetCharmArchiveBySHA384), arg0, arg1, arg2)
+	return &MockStoredCharmsGetCharmArchiveBySHA384Call{Call: call}
+}
+
+// MockStoredCharmsGetCharmArchiveBySHA384Call wrap *gomock.Call
+type MockStoredCharmsGetCharmArchiveBySHA384Call struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoredCharmsGetCharmArchiveBySHA384Call) Return(arg0 string, arg1 objectstore.UUID, arg2 error) *MockStoredCharmsGetCharmArchiveBySHA384Call {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoredCharmsGetCharmArchiveBySHA384Call) Do(f func(context.Context, string, charm.CharmSource) (string, objectstore.UUID, error)) *MockStoredCharmsGetCharmArchiveBySHA384Call {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoredCharmsGetCharmArchiveBySHA384Call) DoAndReturn(f func(context.Context, string, charm.CharmSource) (string, objectstore.UUID, error)) *MockStoredCharmsGetCharmArchiveBySHA384Call {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package store
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// TempFilePool is a bounded free list of temporary files, used to back charm
+// readers. Files returned to the pool are truncated and reused, rather than
+// removed and recreated for every charm. This reduces filesystem churn when
+// many charms are ingested in a short period of time.
+type TempFilePool struct {
+	dir    string
+	size   int
+	logger logger.Logger
+
+	mu     sync.Mutex
+	free   []*os.File
+	closed bool
+}
+
+// NewTempFilePool returns a new pool that keeps at most size unused temporary
+// files in dir. If dir is empty, the default directory for temporary files
+// is used.
+func NewTempFilePool(dir string, size int, logger logger.Logger) *TempFilePool {
+	return &TempFilePool{
+		dir:    dir,
+		size:   size,
+		logger: logger,
+	}
+}
+
+// Get returns an empty temporary file, reusing one from the free list if one
+// is available.
+func (p *TempFilePool) Get() (*os.File, error) {
+	p.mu.Lock()
+	if n := len(p.free); n > 0 {
+		file := p.free[n-1]
+		p.free = p.free[:n-1]
+		p.mu.Unlock()
+		return file, nil
+	}
+	p.mu.Unlock()
+
+	return os.CreateTemp(p.dir, "charm-")
+}
+
+// Put returns the file to the pool. The file is truncated before it is added
+// to the free list. If the file can't be truncated, or the pool is full or
+// closed, the file is closed and removed instead.
+func (p *TempFilePool) Put(file *os.File) error {
+	if err := resetTempFile(file); err != nil {
+		p.logger.Infof(context.Background(), "not reusing temporary file: %v", err)
+		return removeTempFile(file, p.logger)
+	}
+
+	p.mu.Lock()
+	if !p.closed && len(p.free) < p.size {
+		p.free = append(p.free, file)
+		p.mu.Unlock()
+		return nil
+	}
+	p.mu.Unlock()
+
+	return removeTempFile(file, p.logger)
+}
+
+// Close removes all the files in the free list. Files returned to the pool
+// after it has been closed are removed.
+func (p *TempFilePool) Close() error {
+	p.mu.Lock()
+	files := p.free
+	p.free = nil
+	p.closed = true
+	p.mu.Unlock()
+
+	var errs []error
+	for _, file := range files {
+		if err := removeTempFile(file, p.logger); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// resetTempFile truncates the file and rewinds it, so that no data from a
+// previous charm is visible to the next user of the file.
+func resetTempFile(file *os.File) error {
+	if err := file.Truncate(0); err != nil {
+		return errors.Errorf("truncating temporary file: %w", err).Add(ErrTempFileIO)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return errors.Errorf("seeking temporary file: %w", err).Add(ErrTempFileIO)
+	}
+	return nil
+}
+
+// removeTempFile closes and removes the file, returning any error from
+// closing it.
+func removeTempFile(file *os.File, logger logger.Logger) error {
+	closeErr := file.Close()
+	if removeErr := os.Remove(file.Name()); removeErr != nil {
+		// We don't need to log this as error, as the file will be removed
+		// when the process exits or by the OS. It's not a direct action
+		// by the user, hence Info.
+		logger.Infof(context.Background(), "removing temporary file: %v", removeErr)
+	}
+	return closeErr
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package store
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type tempFilePoolSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&tempFilePoolSuite{})
+
+func (s *tempFilePoolSuite) TestGetCreatesFile(c *gc.C) {
+	dir := c.MkDir()
+	pool := NewTempFilePool(dir, 1, loggertesting.WrapCheckLog(c))
+
+	file, err := pool.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	defer file.Close()
+
+	c.Check(s.entries(c, dir), gc.HasLen, 1)
+}
+
+func (s *tempFilePoolSuite) TestPutReusesTruncatedFile(c *gc.C) {
+	dir := c.MkDir()
+	pool := NewTempFilePool(dir, 1, loggertesting.WrapCheckLog(c))
+
+	file, err := pool.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = file.WriteString("hello world")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = pool.Put(file)
+	c.Assert(err, jc.ErrorIsNil)
+
+	reused, err := pool.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(reused.Name(), gc.Equals, file.Name())
+
+	// No data from the previous user is visible.
+	data, err := io.ReadAll(reused)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(data, gc.HasLen, 0)
+
+	c.Assert(pool.Put(reused), jc.ErrorIsNil)
+	c.Assert(pool.Close(), jc.ErrorIsNil)
+}
+
+func (s *tempFilePoolSuite) TestPutRemovesFileWhenFull(c *gc.C) {
+	dir := c.MkDir()
+	pool := NewTempFilePool(dir, 1, loggertesting.WrapCheckLog(c))
+
+	file1, err := pool.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	file2, err := pool.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.entries(c, dir), gc.HasLen, 2)
+
+	c.Assert(pool.Put(file1), jc.ErrorIsNil)
+	c.Assert(pool.Put(file2), jc.ErrorIsNil)
+
+	// Only one file is kept on the free list.
+	entries := s.entries(c, dir)
+	c.Assert(entries, gc.HasLen, 1)
+	c.Check(filepath.Join(dir, entries[0].Name()), gc.Equals, file1.Name())
+
+	c.Assert(pool.Close(), jc.ErrorIsNil)
+}
+
+func (s *tempFilePoolSuite) TestCloseRemovesFreeFiles(c *gc.C) {
+	dir := c.MkDir()
+	pool := NewTempFilePool(dir, 2, loggertesting.WrapCheckLog(c))
+
+	file1, err := pool.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	file2, err := pool.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pool.Put(file1), jc.ErrorIsNil)
+
+	err = pool.Close()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.entries(c, dir), gc.HasLen, 1)
+
+	// Files returned after the pool is closed are removed.
+	c.Assert(pool.Put(file2), jc.ErrorIsNil)
+	c.Check(s.entries(c, dir), gc.HasLen, 0)
+}
+
+func (s *tempFilePoolSuite) entries(c *gc.C, dir string) []os.DirEntry {
+	entries, err := os.ReadDir(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	return entries
+}
@@ -113,6 +113,10 @@ const (
 	// found.
 	CharmNotFound = errors.ConstError("charm not found")
 
+	// CharmSourceConflict describes an error that occurs when a local charm
+	// would use the archive of a charm from charmhub.
+	CharmSourceConflict = errors.ConstError("charm source conflict")
+
 	// LXDProfileNotFound describes an error that occurs when an LXD profile
 	// cannot be found.
 	LXDProfileNotFound = errors.ConstError("LXD profile not found")
@@ -294,4 +298,8 @@ const (
 	// InvalidStorageMountPoint describes an error that occurs when
 	// a storage attachment's location cannot be mounted on the node.
 	InvalidStorageMountPoint = errors.ConstError("invalid storage mount point")
+
+	// WorkloadVersionNotValid describes an error that occurs when a unit's
+	// workload version is too long or contains control characters.
+	WorkloadVersionNotValid = errors.ConstError("workload version not valid")
 )
@@ -292,6 +292,16 @@ type ApplicationState interface {
 	// [applicationerrors.ApplicationNotFound] is returned.
 	SetApplicationConstraints(ctx context.Context, appID coreapplication.ID, cons constraints.Constraints) error
 
+	// MergeApplicationConstraints reads the constraints of the specified
+	// application, passes them to the input merge function and sets the
+	// constraints it returns, all in a single transaction. The merged
+	// constraints are returned.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	MergeApplicationConstraints(
+		ctx context.Context, appID coreapplication.ID, merge func(constraints.Constraints) (constraints.Constraints, error),
+	) (constraints.Constraints, error)
+
 	// GetApplicationCharmOrigin returns the platform and channel for the
 	// specified application ID.
 	// If no application is found, an error satisfying
@@ -369,6 +379,13 @@ type ApplicationState interface {
 
 	// GetDeviceConstraints returns the device constraints for an application.
 	GetDeviceConstraints(ctx context.Context, appID coreapplication.ID) (map[string]devices.Constraints, error)
+
+	// GetApplicationsBoundToSpace returns the sorted names of the
+	// applications with an endpoint bound to the input space, either
+	// explicitly or through the application's default space.
+	// If the space doesn't exist, an error satisfying
+	// [applicationerrors.SpaceNotFound] is returned.
+	GetApplicationsBoundToSpace(ctx context.Context, name network.SpaceName) ([]string, error)
 }
 
 func validateCharmAndApplicationParams(
@@ -848,6 +865,20 @@ func (s *Service) GetApplicationLife(ctx context.Context, appName string) (corel
 	return appLife.Value()
 }
 
+// GetApplicationsBoundToSpace returns the sorted names of the applications
+// with an endpoint bound to the named space, either explicitly or through the
+// application's default space.
+//
+// The following errors may be returned:
+//   - [applicationerrors.SpaceNotFound] if the space does not exist.
+func (s *Service) GetApplicationsBoundToSpace(ctx context.Context, spaceName network.SpaceName) ([]string, error) {
+	apps, err := s.st.GetApplicationsBoundToSpace(ctx, spaceName)
+	if err != nil {
+		return nil, errors.Errorf("getting applications bound to space %q: %w", spaceName, err)
+	}
+	return apps, nil
+}
+
 // IsSubordinateApplication returns true if the application is a subordinate
 // application.
 // The following errors may be returned:
@@ -1007,14 +1038,14 @@ func (s *Service) ResolveCharmDownload(ctx context.Context, appID coreapplicatio
 	}
 
 	// Make sure it's actually a valid charm.
-	charm, err := internalcharm.ReadCharmArchive(resolve.Path)
+	archive, err := internalcharm.ReadCharmArchive(resolve.Path)
 	if err != nil {
 		return errors.Errorf("reading charm archive %q: %w", resolve.Path, err)
 	}
 
 	// Encode the charm before we even attempt to store it. The charm storage
 	// backend could be the other side of the globe.
-	domainCharm, warnings, err := encodeCharm(charm)
+	domainCharm, warnings, err := encodeCharm(archive)
 	if err != nil {
 		return errors.Errorf("encoding charm %q: %w", resolve.Path, err)
 	} else if len(warnings) > 0 {
@@ -1024,7 +1055,7 @@ func (s *Service) ResolveCharmDownload(ctx context.Context, appID coreapplicatio
 	// Use the hash from the reservation, incase the caller has the wrong hash.
 	// The resulting objectStoreUUID will enable RI between the charm and the
 	// object store.
-	result, err := s.charmStore.Store(ctx, resolve.Path, resolve.Size, resolve.SHA384)
+	result, err := s.charmStore.Store(ctx, resolve.Path, resolve.Size, resolve.SHA384, charm.CharmHubSource)
 	if errors.Is(err, objectstoreerrors.ErrHashAndSizeAlreadyExists) {
 		// If the hash already exists but has a different size, then we've
 		// got a hash conflict. There isn't anything we can do about this, so
@@ -1055,7 +1086,7 @@ func (s *Service) ResolveCharmDownload(ctx context.Context, appID coreapplicatio
 // ResolveControllerCharmDownload resolves the controller charm download slot.
 func (s *Service) ResolveControllerCharmDownload(ctx context.Context, resolve application.ResolveControllerCharmDownload) (application.ResolvedControllerCharmDownload, error) {
 	// Make sure it's actually a valid charm.
-	charm, err := internalcharm.ReadCharmArchive(resolve.Path)
+	archive, err := internalcharm.ReadCharmArchive(resolve.Path)
 	if err != nil {
 		return application.ResolvedControllerCharmDownload{}, errors.Errorf("reading charm archive %q: %w", resolve.Path, err)
 	}
@@ -1063,7 +1094,7 @@ func (s *Service) ResolveControllerCharmDownload(ctx context.Context, resolve ap
 	// Use the hash from the reservation, incase the caller has the wrong hash.
 	// The resulting objectStoreUUID will enable RI between the charm and the
 	// object store.
-	result, err := s.charmStore.Store(ctx, resolve.Path, resolve.Size, resolve.SHA384)
+	result, err := s.charmStore.Store(ctx, resolve.Path, resolve.Size, resolve.SHA384, charm.CharmHubSource)
 	if errors.Is(err, objectstoreerrors.ErrHashAndSizeAlreadyExists) {
 		// If the hash already exists but has a different size, then we've
 		// got a hash conflict. There isn't anything we can do about this, so
@@ -1080,7 +1111,7 @@ func (s *Service) ResolveControllerCharmDownload(ctx context.Context, resolve ap
 
 	// Resolve the charm download, which will set itself to available.
 	return application.ResolvedControllerCharmDownload{
-		Charm:           charm,
+		Charm:           archive,
 		ObjectStoreUUID: result.ObjectStoreUUID,
 
 		// This is correct, we want to use the unique name of the stored charm
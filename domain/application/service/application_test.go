@@ -25,6 +25,7 @@ import (
 	"github.com/juju/juju/core/devices"
 	coreerrors "github.com/juju/juju/core/errors"
 	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/core/network"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
 	corestorage "github.com/juju/juju/core/storage"
 	coreunit "github.com/juju/juju/core/unit"
@@ -121,6 +122,25 @@ func (s *applicationServiceSuite) TestGetApplicationIDByUnitName(c *gc.C) {
 	c.Check(obtainedAppID, gc.DeepEquals, expectedAppID)
 }
 
+func (s *applicationServiceSuite) TestGetApplicationsBoundToSpace(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationsBoundToSpace(gomock.Any(), network.SpaceName("space1")).Return([]string{"bar", "foo"}, nil)
+
+	apps, err := s.service.GetApplicationsBoundToSpace(context.Background(), "space1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, jc.DeepEquals, []string{"bar", "foo"})
+}
+
+func (s *applicationServiceSuite) TestGetApplicationsBoundToSpaceNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationsBoundToSpace(gomock.Any(), network.SpaceName("space1")).Return(nil, applicationerrors.SpaceNotFound)
+
+	_, err := s.service.GetApplicationsBoundToSpace(context.Background(), "space1")
+	c.Assert(err, jc.ErrorIs, applicationerrors.SpaceNotFound)
+}
+
 func (s *applicationServiceSuite) TestGetCharmModifiedVersion(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -187,7 +207,7 @@ func (s *applicationServiceSuite) TestResolveCharmDownload(c *gc.C) {
 	}
 
 	s.state.EXPECT().GetAsyncCharmDownloadInfo(gomock.Any(), appUUID).Return(info, nil)
-	s.charmStore.EXPECT().Store(gomock.Any(), path, int64(42), "hash-384").Return(store.StoreResult{
+	s.charmStore.EXPECT().Store(gomock.Any(), path, int64(42), "hash-384", applicationcharm.CharmHubSource).Return(store.StoreResult{
 		UniqueName:      "somepath",
 		ObjectStoreUUID: objectStoreUUID,
 	}, nil)
@@ -323,7 +343,7 @@ func (s *applicationServiceSuite) TestResolveCharmDownloadNotStored(c *gc.C) {
 	}
 
 	s.state.EXPECT().GetAsyncCharmDownloadInfo(gomock.Any(), appUUID).Return(info, nil)
-	s.charmStore.EXPECT().Store(gomock.Any(), path, int64(42), "hash-384").Return(store.StoreResult{}, errors.Errorf("not found %w", coreerrors.NotFound))
+	s.charmStore.EXPECT().Store(gomock.Any(), path, int64(42), "hash-384", applicationcharm.CharmHubSource).Return(store.StoreResult{}, errors.Errorf("not found %w", coreerrors.NotFound))
 
 	err := s.service.ResolveCharmDownload(context.Background(), appUUID, application.ResolveCharmDownload{
 		CharmUUID: charmUUID,
@@ -365,7 +385,7 @@ func (s *applicationServiceSuite) TestResolveCharmDownloadAlreadyStored(c *gc.C)
 	}
 
 	s.state.EXPECT().GetAsyncCharmDownloadInfo(gomock.Any(), appUUID).Return(info, nil)
-	s.charmStore.EXPECT().Store(gomock.Any(), path, int64(42), "hash-384").Return(store.StoreResult{
+	s.charmStore.EXPECT().Store(gomock.Any(), path, int64(42), "hash-384", applicationcharm.CharmHubSource).Return(store.StoreResult{
 		UniqueName:      "somepath",
 		ObjectStoreUUID: objectStoreUUID,
 	}, nil)
@@ -171,15 +171,16 @@ type CharmState interface {
 type CharmStore interface {
 	// Store the charm at the specified path into the object store. It is
 	// expected that the archive already exists at the specified path. If the
-	// file isn't found, a [ErrNotFound] is returned.
-	Store(ctx context.Context, path string, size int64, hash string) (store.StoreResult, error)
+	// file isn't found, a [ErrNotFound] is returned. The source of the
+	// charm is optional.
+	Store(ctx context.Context, path string, size int64, hash string, source charm.CharmSource) (store.StoreResult, error)
 
 	// StoreFromReader stores the charm from the provided reader into the object
 	// store. The caller is expected to remove the temporary file after the
 	// call.
 	// sha256Prefix is the prefix characters of the SHA256 hash of the charm
-	// archive.
-	StoreFromReader(ctx context.Context, reader io.Reader, sha256Prefix string) (store.StoreFromReaderResult, store.Digest, error)
+	// archive. The source of the charm is optional.
+	StoreFromReader(ctx context.Context, reader io.Reader, sha256Prefix string, source charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error)
 
 	// GetCharm retrieves a ReadCloser for the charm archive at the give path
 	// from the underlying storage.
@@ -495,6 +496,50 @@ func (s *Service) GetCharmActions(ctx context.Context, locator charm.CharmLocato
 	return decoded, nil
 }
 
+// GetCharmActionsByApplicationName returns the actions defined in the charm
+// archive of the named application.
+//
+// If the application does not exist, a
+// [applicationerrors.ApplicationNotFound] error is returned. If the charm
+// archive can't be found, a [applicationerrors.CharmNotFound] error is
+// returned.
+func (s *Service) GetCharmActionsByApplicationName(ctx context.Context, name string) (internalcharm.Actions, error) {
+	if !isValidApplicationName(name) {
+		return internalcharm.Actions{}, applicationerrors.ApplicationNameNotValid
+	}
+
+	charmID, err := s.st.GetCharmIDByApplicationName(ctx, name)
+	if err != nil {
+		return internalcharm.Actions{}, errors.Capture(err)
+	}
+
+	archivePath, _, err := s.st.GetCharmArchiveMetadata(ctx, charmID)
+	if err != nil {
+		return internalcharm.Actions{}, errors.Errorf("getting charm archive metadata: %w", err)
+	}
+
+	reader, err := s.charmStore.Get(ctx, archivePath)
+	if errors.Is(err, store.ErrNotFound) {
+		return internalcharm.Actions{}, applicationerrors.CharmNotFound
+	} else if err != nil {
+		return internalcharm.Actions{}, errors.Errorf("getting charm archive: %w", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return internalcharm.Actions{}, errors.Errorf("reading charm archive: %w", err)
+	}
+	archive, err := internalcharm.ReadCharmArchiveBytes(data)
+	if err != nil {
+		return internalcharm.Actions{}, errors.Errorf("parsing charm archive: %w", err)
+	}
+	if parsed := archive.Actions(); parsed != nil {
+		return *parsed, nil
+	}
+	return internalcharm.Actions{}, nil
+}
+
 // GetCharmConfig returns the config for the charm using the charm name,
 // source and revision.
 //
@@ -728,15 +773,15 @@ func (s *Service) ResolveUploadCharm(ctx context.Context, args charm.ResolveUplo
 
 func (s *Service) resolveLocalUploadedCharm(ctx context.Context, args charm.ResolveUploadCharm) (charm.CharmLocator, error) {
 	// Store the charm and validate it against the sha256 prefix.
-	result, digest, err := s.charmStore.StoreFromReader(ctx, args.Reader, args.SHA256Prefix)
+	result, digest, err := s.charmStore.StoreFromReader(ctx, args.Reader, args.SHA256Prefix, charm.LocalSource)
 	if err != nil {
 		return charm.CharmLocator{}, errors.Errorf("resolving uploaded charm: %w", err)
 	}
 
-	// Ensure we close the charm reader.
+	// Ensure we clean up the charm reader and its temporary file.
 	defer func() {
-		if err := result.Charm.Close(); err != nil {
-			s.logger.Errorf(ctx, "closing reader: %v", err)
+		if err := result.Cleanup(); err != nil {
+			s.logger.Errorf(ctx, "cleaning up charm reader: %v", err)
 		}
 	}()
 
@@ -813,15 +858,15 @@ func (s *Service) resolveMigratingUploadedCharm(ctx context.Context, args charm.
 		return charm.CharmLocator{}, errors.Errorf("locating existing charm: %w", err)
 	}
 
-	result, digest, err := s.charmStore.StoreFromReader(ctx, args.Reader, args.SHA256Prefix)
+	result, digest, err := s.charmStore.StoreFromReader(ctx, args.Reader, args.SHA256Prefix, source)
 	if err != nil {
 		return charm.CharmLocator{}, errors.Errorf("resolving uploaded charm: %w", err)
 	}
 
-	// Ensure we close the charm reader.
+	// Ensure we clean up the charm reader and its temporary file.
 	defer func() {
-		if err := result.Charm.Close(); err != nil {
-			s.logger.Errorf(ctx, "closing reader: %v", err)
+		if err := result.Cleanup(); err != nil {
+			s.logger.Errorf(ctx, "cleaning up charm reader: %v", err)
 		}
 	}()
 
@@ -4,6 +4,7 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
@@ -678,6 +679,50 @@ func (s *charmServiceSuite) TestGetCharmArchive(c *gc.C) {
 	c.Check(string(content), gc.Equals, "archive-content")
 }
 
+func (s *charmServiceSuite) TestGetCharmActionsByApplicationName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := charmtesting.GenCharmID(c)
+	data, err := os.ReadFile(testcharms.Repo.CharmArchivePath(c.MkDir(), "dummy"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "foo").Return(id, nil)
+	s.state.EXPECT().GetCharmArchiveMetadata(gomock.Any(), id).Return("archive-path", "hash", nil)
+	s.charmStore.EXPECT().Get(gomock.Any(), "archive-path").Return(io.NopCloser(bytes.NewReader(data)), nil)
+
+	actions, err := s.service.GetCharmActionsByApplicationName(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(actions.ActionSpecs, jc.DeepEquals, testcharms.Repo.CharmDir("dummy").Actions().ActionSpecs)
+}
+
+func (s *charmServiceSuite) TestGetCharmActionsByApplicationNameApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "foo").Return("", applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetCharmActionsByApplicationName(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *charmServiceSuite) TestGetCharmActionsByApplicationNameArchiveNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := charmtesting.GenCharmID(c)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "foo").Return(id, nil)
+	s.state.EXPECT().GetCharmArchiveMetadata(gomock.Any(), id).Return("archive-path", "hash", nil)
+	s.charmStore.EXPECT().Get(gomock.Any(), "archive-path").Return(nil, store.ErrNotFound)
+
+	_, err := s.service.GetCharmActionsByApplicationName(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
+func (s *charmServiceSuite) TestGetCharmActionsByApplicationNameInvalidName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetCharmActionsByApplicationName(context.Background(), "!foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
 func (s *charmServiceSuite) TestGetCharmArchiveBySHA256Prefix(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1455,8 +1500,8 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmNotImporting(c *gc.C
 		Provenance: charm.ProvenanceUpload,
 	}
 
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", charm.LocalSource).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, jc.ErrorIsNil)
 
@@ -1502,7 +1547,7 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmNotImportingFailedRe
 
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
 
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").Return(store.StoreFromReaderResult{
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", charm.LocalSource).Return(store.StoreFromReaderResult{
 		ObjectStoreUUID: objectStoreUUID,
 		UniqueName:      "unique-name",
 	}, store.Digest{
@@ -1539,8 +1584,8 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmNotImportingFailedSe
 		Provenance: charm.ProvenanceUpload,
 	}
 
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", charm.LocalSource).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, jc.ErrorIsNil)
 
@@ -1590,8 +1635,8 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmImporting(c *gc.C) {
 	}
 
 	s.state.EXPECT().GetCharmID(gomock.Any(), "test", 1, charm.LocalSource).Return(charmID, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", charm.LocalSource).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, jc.ErrorIsNil)
 
@@ -1673,7 +1718,7 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmImportingFailedStore
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
 
 	s.state.EXPECT().GetCharmID(gomock.Any(), "test", 1, charm.LocalSource).Return(charmID, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").Return(store.StoreFromReaderResult{
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", charm.LocalSource).Return(store.StoreFromReaderResult{
 		ObjectStoreUUID: objectStoreUUID,
 		UniqueName:      "unique-name",
 	}, store.Digest{
@@ -1712,8 +1757,8 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmImportingFailedResol
 	}
 
 	s.state.EXPECT().GetCharmID(gomock.Any(), "test", 1, charm.LocalSource).Return(charmID, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", charm.LocalSource).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, jc.ErrorIsNil)
 
@@ -1127,6 +1127,45 @@ func (c *MockStateGetApplicationUnitsForExportCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// GetApplicationsBoundToSpace mocks base method.
+func (m *MockState) GetApplicationsBoundToSpace(ctx context.Context, name network.SpaceName) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationsBoundToSpace", ctx, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationsBoundToSpace indicates an expected call of GetApplicationsBoundToSpace.
+func (mr *MockStateMockRecorder) GetApplicationsBoundToSpace(ctx, name any) *MockStateGetApplicationsBoundToSpaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsBoundToSpace", reflect.TypeOf((*MockState)(nil).GetApplicationsBoundToSpace), ctx, name)
+	return &MockStateGetApplicationsBoundToSpaceCall{Call: call}
+}
+
+// MockStateGetApplicationsBoundToSpaceCall wrap *gomock.Call
+type MockStateGetApplicationsBoundToSpaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationsBoundToSpaceCall) Return(arg0 []string, arg1 error) *MockStateGetApplicationsBoundToSpaceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationsBoundToSpaceCall) Do(f func(context.Context, network.SpaceName) ([]string, error)) *MockStateGetApplicationsBoundToSpaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationsBoundToSpaceCall) DoAndReturn(f func(context.Context, network.SpaceName) ([]string, error)) *MockStateGetApplicationsBoundToSpaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationsForExport mocks base method.
 func (m *MockState) GetApplicationsForExport(ctx context.Context) ([]application0.ExportApplication, error) {
 	m.ctrl.T.Helper()
@@ -3403,6 +3442,45 @@ func (c *MockStateListCharmLocatorsByNamesCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// MergeApplicationConstraints mocks base method.
+func (m *MockState) MergeApplicationConstraints(ctx context.Context, appID application.ID, merge func(constraints0.Constraints) (constraints0.Constraints, error)) (constraints0.Constraints, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeApplicationConstraints", ctx, appID, merge)
+	ret0, _ := ret[0].(constraints0.Constraints)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeApplicationConstraints indicates an expected call of MergeApplicationConstraints.
+func (mr *MockStateMockRecorder) MergeApplicationConstraints(ctx, appID, merge any) *MockStateMergeApplicationConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeApplicationConstraints", reflect.TypeOf((*MockState)(nil).MergeApplicationConstraints), ctx, appID, merge)
+	return &MockStateMergeApplicationConstraintsCall{Call: call}
+}
+
+// MockStateMergeApplicationConstraintsCall wrap *gomock.Call
+type MockStateMergeApplicationConstraintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateMergeApplicationConstraintsCall) Return(arg0 constraints0.Constraints, arg1 error) *MockStateMergeApplicationConstraintsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateMergeApplicationConstraintsCall) Do(f func(context.Context, application.ID, func(constraints0.Constraints) (constraints0.Constraints, error)) (constraints0.Constraints, error)) *MockStateMergeApplicationConstraintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateMergeApplicationConstraintsCall) DoAndReturn(f func(context.Context, application.ID, func(constraints0.Constraints) (constraints0.Constraints, error)) (constraints0.Constraints, error)) *MockStateMergeApplicationConstraintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MergeExposeSettings mocks base method.
 func (m *MockState) MergeExposeSettings(ctx context.Context, appID application.ID, exposedEndpoints map[string]application0.ExposedEndpoint) error {
 	m.ctrl.T.Helper()
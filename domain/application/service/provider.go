@@ -265,6 +265,49 @@ func (s *ProviderService) SetApplicationConstraints(ctx context.Context, appID c
 	return s.st.SetApplicationConstraints(ctx, appID, constraints.DecodeConstraints(cons))
 }
 
+// MergeApplicationConstraints merges the given constraints over the existing
+// constraints of the specified application ID, and sets the result. The
+// merged constraints are returned.
+// Constraints in delta take precedence over any existing constraints they
+// conflict with, according to the provider's constraints validator.
+// If the merged constraints are invalid (e.g. an instance type unknown to the
+// provider), an error is returned and the application constraints are left
+// unchanged.
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *ProviderService) MergeApplicationConstraints(ctx context.Context, appID coreapplication.ID, delta coreconstraints.Value) (coreconstraints.Value, error) {
+	if err := appID.Validate(); err != nil {
+		return coreconstraints.Value{}, errors.Errorf("application ID: %w", err)
+	}
+
+	validator, err := s.constraintsValidator(ctx)
+	if err != nil {
+		return coreconstraints.Value{}, errors.Capture(err)
+	} else if validator == nil {
+		// The provider doesn't support constraints validation, so we
+		// merge without any provider specific conflicts.
+		validator = coreconstraints.NewValidator()
+	}
+
+	// The existing constraints are read, merged and written back in a single
+	// transaction, so that concurrent merges can not lose each other's
+	// changes.
+	merged, err := s.st.MergeApplicationConstraints(ctx, appID, func(existing constraints.Constraints) (constraints.Constraints, error) {
+		merged, err := validator.Merge(constraints.EncodeConstraints(existing), delta)
+		if err != nil {
+			return constraints.Constraints{}, errors.Errorf("merging application constraints: %w", err)
+		}
+		if err := s.validateConstraintsWith(ctx, validator, merged); err != nil {
+			return constraints.Constraints{}, err
+		}
+		return constraints.DecodeConstraints(merged), nil
+	})
+	if err != nil {
+		return coreconstraints.Value{}, errors.Capture(err)
+	}
+	return constraints.EncodeConstraints(merged), nil
+}
+
 func (s *ProviderService) constraintsValidator(ctx context.Context) (coreconstraints.Validator, error) {
 	provider, err := s.provider(ctx)
 	if errors.Is(err, coreerrors.NotSupported) {
@@ -528,7 +571,10 @@ func (s *ProviderService) validateConstraints(ctx context.Context, cons corecons
 	} else if validator == nil {
 		return nil
 	}
+	return s.validateConstraintsWith(ctx, validator, cons)
+}
 
+func (s *ProviderService) validateConstraintsWith(ctx context.Context, validator coreconstraints.Validator, cons coreconstraints.Value) error {
 	unsupported, err := validator.Validate(cons)
 	if len(unsupported) > 0 {
 		s.logger.Warningf(ctx,
@@ -1432,6 +1432,108 @@ func (s *providerServiceSuite) TestSetConstraints(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *providerServiceSuite) TestMergeApplicationConstraintsInvalidAppID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.MergeApplicationConstraints(context.Background(), "bad-app-id", coreconstraints.Value{})
+	c.Assert(err, gc.ErrorMatches, "application ID: id \"bad-app-id\" not valid")
+}
+
+func (s *providerServiceSuite) TestMergeApplicationConstraints(c *gc.C) {
+	ctrl := s.setupMocksWithProvider(c,
+		func(ctx context.Context) (Provider, error) {
+			return s.provider, nil
+		},
+		func(ctx context.Context) (SupportedFeatureProvider, error) {
+			return s.supportedFeaturesProvider, nil
+		},
+		func(ctx context.Context) (CAASApplicationProvider, error) {
+			return s.caasApplicationProvider, nil
+		})
+	defer ctrl.Finish()
+
+	id := applicationtesting.GenApplicationUUID(c)
+	existing := coreconstraints.Value{Arch: ptr("amd64"), Mem: ptr(uint64(8))}
+	delta := coreconstraints.Value{Mem: ptr(uint64(16))}
+	merged := coreconstraints.Value{Arch: ptr("amd64"), Mem: ptr(uint64(16))}
+
+	validator := NewMockValidator(ctrl)
+	s.provider.EXPECT().ConstraintsValidator(gomock.Any()).Return(validator, nil)
+	validator.EXPECT().Merge(existing, delta).Return(merged, nil)
+	validator.EXPECT().Validate(merged).Return(nil, nil)
+	s.state.EXPECT().MergeApplicationConstraints(gomock.Any(), id, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coreapplication.ID, merge func(constraints.Constraints) (constraints.Constraints, error)) (constraints.Constraints, error) {
+			return merge(constraints.DecodeConstraints(existing))
+		})
+
+	result, err := s.service.MergeApplicationConstraints(context.Background(), id, delta)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, merged)
+}
+
+func (s *providerServiceSuite) TestMergeApplicationConstraintsInvalid(c *gc.C) {
+	ctrl := s.setupMocksWithProvider(c,
+		func(ctx context.Context) (Provider, error) {
+			return s.provider, nil
+		},
+		func(ctx context.Context) (SupportedFeatureProvider, error) {
+			return s.supportedFeaturesProvider, nil
+		},
+		func(ctx context.Context) (CAASApplicationProvider, error) {
+			return s.caasApplicationProvider, nil
+		})
+	defer ctrl.Finish()
+
+	id := applicationtesting.GenApplicationUUID(c)
+	delta := coreconstraints.Value{InstanceType: ptr("unknown")}
+
+	validator := NewMockValidator(ctrl)
+	s.provider.EXPECT().ConstraintsValidator(gomock.Any()).Return(validator, nil)
+	validator.EXPECT().Merge(coreconstraints.Value{}, delta).Return(coreconstraints.Value{}, errors.New(`invalid constraint value: instance-type=unknown`))
+	s.state.EXPECT().MergeApplicationConstraints(gomock.Any(), id, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coreapplication.ID, merge func(constraints.Constraints) (constraints.Constraints, error)) (constraints.Constraints, error) {
+			return merge(constraints.Constraints{})
+		})
+
+	_, err := s.service.MergeApplicationConstraints(context.Background(), id, delta)
+	c.Assert(err, gc.ErrorMatches, "merging application constraints: invalid constraint value: instance-type=unknown")
+}
+
+func (s *providerServiceSuite) TestMergeApplicationConstraintsProviderNotSupported(c *gc.C) {
+	ctrl := s.setupMocksWithProvider(c, func(ctx context.Context) (Provider, error) {
+		return s.provider, coreerrors.NotSupported
+	}, func(ctx context.Context) (SupportedFeatureProvider, error) {
+		return s.supportedFeaturesProvider, coreerrors.NotSupported
+	}, func(ctx context.Context) (CAASApplicationProvider, error) {
+		return s.caasApplicationProvider, coreerrors.NotSupported
+	})
+	defer ctrl.Finish()
+
+	id := applicationtesting.GenApplicationUUID(c)
+	existing := coreconstraints.Value{Arch: ptr("amd64"), Mem: ptr(uint64(8))}
+	merged := coreconstraints.Value{Arch: ptr("amd64"), Mem: ptr(uint64(8)), CpuCores: ptr(uint64(2))}
+
+	s.state.EXPECT().MergeApplicationConstraints(gomock.Any(), id, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coreapplication.ID, merge func(constraints.Constraints) (constraints.Constraints, error)) (constraints.Constraints, error) {
+			return merge(constraints.DecodeConstraints(existing))
+		})
+
+	result, err := s.service.MergeApplicationConstraints(context.Background(), id, coreconstraints.Value{CpuCores: ptr(uint64(2))})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, merged)
+}
+
+func (s *providerServiceSuite) TestMergeApplicationConstraintsNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := applicationtesting.GenApplicationUUID(c)
+	s.provider.EXPECT().ConstraintsValidator(gomock.Any()).Return(nil, nil)
+	s.state.EXPECT().MergeApplicationConstraints(gomock.Any(), id, gomock.Any()).Return(constraints.Constraints{}, applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.MergeApplicationConstraints(context.Background(), id, coreconstraints.Value{})
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *providerServiceSuite) TestAddUnitsEmptyConstraints(c *gc.C) {
 	ctrl := s.setupMocksWithProvider(c,
 		func(ctx context.Context) (Provider, error) {
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination service_mock_test.go github.com/juju/juju/domain/application/service CharmStore
+//	mockgen -typed -package service -destination domain/application/service/service_mock_test.go github.com/juju/juju/domain/application/service CharmStore
 //
 
 // Package service is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	io "io"
 	reflect "reflect"
 
+	charm "github.com/juju/juju/domain/application/charm"
 	store "github.com/juju/juju/domain/application/charm/store"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -120,18 +121,18 @@ func (c *MockCharmStoreGetBySHA256PrefixCall) DoAndReturn(f func(context.Context
 }
 
 // Store mocks base method.
-func (m *MockCharmStore) Store(arg0 context.Context, arg1 string, arg2 int64, arg3 string) (store.StoreResult, error) {
+func (m *MockCharmStore) Store(arg0 context.Context, arg1 string, arg2 int64, arg3 string, arg4 charm.CharmSource) (store.StoreResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Store", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Store", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(store.StoreResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Store indicates an expected call of Store.
-func (mr *MockCharmStoreMockRecorder) Store(arg0, arg1, arg2, arg3 any) *MockCharmStoreStoreCall {
+func (mr *MockCharmStoreMockRecorder) Store(arg0, arg1, arg2, arg3, arg4 any) *MockCharmStoreStoreCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockCharmStore)(nil).Store), arg0, arg1, arg2, arg3)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockCharmStore)(nil).Store), arg0, arg1, arg2, arg3, arg4)
 	return &MockCharmStoreStoreCall{Call: call}
 }
 
@@ -147,21 +148,21 @@ func (c *MockCharmStoreStoreCall) Return(arg0 store.StoreResult, arg1 error) *Mo
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockCharmStoreStoreCall) Do(f func(context.Context, string, int64, string) (store.StoreResult, error)) *MockCharmStoreStoreCall {
+func (c *MockCharmStoreStoreCall) Do(f func(context.Context, string, int64, string, charm.CharmSource) (store.StoreResult, error)) *MockCharmStoreStoreCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockCharmStoreStoreCall) DoAndReturn(f func(context.Context, string, int64, string) (store.StoreResult, error)) *MockCharmStoreStoreCall {
+func (c *MockCharmStoreStoreCall) DoAndReturn(f func(context.Context, string, int64, string, charm.CharmSource) (store.StoreResult, error)) *MockCharmStoreStoreCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // StoreFromReader mocks base method.
-func (m *MockCharmStore) StoreFromReader(arg0 context.Context, arg1 io.Reader, arg2 string) (store.StoreFromReaderResult, store.Digest, error) {
+func (m *MockCharmStore) StoreFromReader(arg0 context.Context, arg1 io.Reader, arg2 string, arg3 charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreFromReader", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "StoreFromReader", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(store.StoreFromReaderResult)
 	ret1, _ := ret[1].(store.Digest)
 	ret2, _ := ret[2].(error)
@@ -169,9 +170,9 @@ func (m *MockCharmStore) StoreFromReader(arg0 context.Context, arg1 io.Reader, a
 }
 
 // StoreFromReader indicates an expected call of StoreFromReader.
-func (mr *MockCharmStoreMockRecorder) StoreFromReader(arg0, arg1, arg2 any) *MockCharmStoreStoreFromReaderCall {
+func (mr *MockCharmStoreMockRecorder) StoreFromReader(arg0, arg1, arg2, arg3 any) *MockCharmStoreStoreFromReaderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreFromReader", reflect.TypeOf((*MockCharmStore)(nil).StoreFromReader), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreFromReader", reflect.TypeOf((*MockCharmStore)(nil).StoreFromReader), arg0, arg1, arg2, arg3)
 	return &MockCharmStoreStoreFromReaderCall{Call: call}
 }
 
@@ -187,13 +188,13 @@ func (c *MockCharmStoreStoreFromReaderCall) Return(arg0 store.StoreFromReaderRes
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockCharmStoreStoreFromReaderCall) Do(f func(context.Context, io.Reader, string) (store.StoreFromReaderResult, store.Digest, error)) *MockCharmStoreStoreFromReaderCall {
+func (c *MockCharmStoreStoreFromReaderCall) Do(f func(context.Context, io.Reader, string, charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error)) *MockCharmStoreStoreFromReaderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockCharmStoreStoreFromReaderCall) DoAndReturn(f func(context.Context, io.Reader, string) (store.StoreFromReaderResult, store.Digest, error)) *MockCharmStoreStoreFromReaderCall {
+func (c *MockCharmStoreStoreFromReaderCall) DoAndReturn(f func(context.Context, io.Reader, string, charm.CharmSource) (store.StoreFromReaderResult, store.Digest, error)) *MockCharmStoreStoreFromReaderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
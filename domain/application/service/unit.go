@@ -6,6 +6,9 @@ package service
 import (
 	"context"
 	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/leadership"
@@ -532,11 +535,41 @@ func (s *Service) GetUnitNamesOnMachine(ctx context.Context, machineName machine
 	return names, nil
 }
 
+// maxWorkloadVersionLength is the maximum length, in bytes, of a unit's
+// workload version.
+const maxWorkloadVersionLength = 128
+
+// validateWorkloadVersion checks that the workload version can be safely
+// rendered in status output. It must be valid UTF-8, no longer than
+// maxWorkloadVersionLength and contain no control characters.
+func validateWorkloadVersion(version string) error {
+	if len(version) > maxWorkloadVersionLength {
+		return errors.Errorf("workload version longer than %d bytes", maxWorkloadVersionLength).
+			Add(applicationerrors.WorkloadVersionNotValid)
+	}
+	if !utf8.ValidString(version) {
+		return errors.Errorf("workload version is not valid UTF-8").
+			Add(applicationerrors.WorkloadVersionNotValid)
+	}
+	if strings.IndexFunc(version, unicode.IsControl) >= 0 {
+		return errors.Errorf("workload version contains control characters").
+			Add(applicationerrors.WorkloadVersionNotValid)
+	}
+	return nil
+}
+
 // SetUnitWorkloadVersion sets the workload version for the given unit.
+//
+// The following errors may be returned:
+//   - [applicationerrors.WorkloadVersionNotValid] if the version is too long
+//     or contains control characters.
 func (s *Service) SetUnitWorkloadVersion(ctx context.Context, unitName coreunit.Name, version string) error {
 	if err := unitName.Validate(); err != nil {
 		return errors.Capture(err)
 	}
+	if err := validateWorkloadVersion(version); err != nil {
+		return errors.Capture(err)
+	}
 
 	return s.st.SetUnitWorkloadVersion(ctx, unitName, version)
 }
@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"reflect"
+	"strings"
 	"time"
 
 	jc "github.com/juju/testing/checkers"
@@ -492,6 +493,31 @@ func (s *unitServiceSuite) TestSetUnitWorkloadVersionInvalidName(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, coreunit.InvalidUnitName)
 }
 
+func (s *unitServiceSuite) TestSetUnitWorkloadVersionNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitName := coreunit.Name("foo/666")
+
+	for _, version := range []string{
+		strings.Repeat("v", 129),
+		"v1.0.0\n",
+		"v1\x1b[31m",
+		"v1\xff",
+	} {
+		err := s.service.SetUnitWorkloadVersion(context.Background(), unitName, version)
+		c.Check(err, jc.ErrorIs, applicationerrors.WorkloadVersionNotValid, gc.Commentf("version %q", version))
+	}
+}
+
+func (s *unitServiceSuite) TestValidateWorkloadVersion(c *gc.C) {
+	c.Check(validateWorkloadVersion(""), jc.ErrorIsNil)
+	c.Check(validateWorkloadVersion("8.0.32-0ubuntu0.22.04.2"), jc.ErrorIsNil)
+	c.Check(validateWorkloadVersion("版本 1.0"), jc.ErrorIsNil)
+	c.Check(validateWorkloadVersion(strings.Repeat("v", 128)), jc.ErrorIsNil)
+	c.Check(validateWorkloadVersion(strings.Repeat("v", 129)), gc.ErrorMatches, "workload version longer than 128 bytes")
+	c.Check(validateWorkloadVersion("v1\tbeta"), gc.ErrorMatches, "workload version contains control characters")
+}
+
 func (s *unitServiceSuite) TestGetUnitWorkloadVersion(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
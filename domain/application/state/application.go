@@ -479,6 +479,7 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"application_exposed_endpoint_space",
 		"application_exposed_endpoint_cidr",
 		"application_endpoint",
+		"application_endpoint_binding_history",
 		"application_extra_endpoint",
 		"application_storage_directive",
 		"application_workload_version",
@@ -2532,6 +2533,22 @@ func (st *State) GetApplicationConstraints(ctx context.Context, appID coreapplic
 		return constraints.Constraints{}, errors.Capture(err)
 	}
 
+	var result constraints.Constraints
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+
+		result, err = st.getApplicationConstraints(ctx, tx, appID)
+		return errors.Capture(err)
+	}); err != nil {
+		return constraints.Constraints{}, errors.Errorf("querying application constraints for application %q: %w", appID, err)
+	}
+
+	return result, nil
+}
+
+func (st *State) getApplicationConstraints(ctx context.Context, tx *sqlair.TX, appID coreapplication.ID) (constraints.Constraints, error) {
 	ident := applicationID{ID: appID}
 
 	query := `
@@ -2546,19 +2563,9 @@ WHERE application_uuid = $applicationID.uuid;
 	}
 
 	var result applicationConstraints
-	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
-			return errors.Capture(err)
-		}
-
-		err := tx.Query(ctx, stmt, ident).GetAll(&result)
-		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
-			return errors.Capture(err)
-		}
-
-		return nil
-	}); err != nil {
-		return constraints.Constraints{}, errors.Errorf("querying application constraints for application %q: %w", appID, err)
+	err = tx.Query(ctx, stmt, ident).GetAll(&result)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return constraints.Constraints{}, errors.Capture(err)
 	}
 
 	return decodeConstraints(result), nil
@@ -2578,6 +2585,54 @@ func (st *State) SetApplicationConstraints(ctx context.Context, appID coreapplic
 		return errors.Capture(err)
 	}
 
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+		return st.setApplicationConstraints(ctx, tx, appID, cons)
+	})
+}
+
+// MergeApplicationConstraints reads the constraints of the specified
+// application, passes them to the input merge function and sets the
+// constraints it returns, all in a single transaction. The merged constraints
+// are returned.
+// If the merge function returns an error, the application constraints are
+// left unchanged.
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (st *State) MergeApplicationConstraints(
+	ctx context.Context, appID coreapplication.ID, merge func(constraints.Constraints) (constraints.Constraints, error),
+) (constraints.Constraints, error) {
+	db, err := st.DB()
+	if err != nil {
+		return constraints.Constraints{}, errors.Capture(err)
+	}
+
+	var merged constraints.Constraints
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+
+		existing, err := st.getApplicationConstraints(ctx, tx, appID)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		merged, err = merge(existing)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		return st.setApplicationConstraints(ctx, tx, appID, merged)
+	}); err != nil {
+		return constraints.Constraints{}, errors.Errorf("merging application constraints for application %q: %w", appID, err)
+	}
+	return merged, nil
+}
+
+func (st *State) setApplicationConstraints(ctx context.Context, tx *sqlair.TX, appID coreapplication.ID, cons constraints.Constraints) error {
 	cUUID, err := uuid.NewUUID()
 	if err != nil {
 		return errors.Capture(err)
@@ -2674,95 +2729,89 @@ ON CONFLICT (application_uuid) DO NOTHING
 		return errors.Errorf("preparing insert application constraints query: %w", err)
 	}
 
-	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
-			return errors.Capture(err)
-		}
-
-		var containerTypeID containerTypeID
-		if cons.Container != nil {
-			err = tx.Query(ctx, selectContainerTypeIDStmt, containerTypeVal{Value: string(*cons.Container)}).Get(&containerTypeID)
-			if errors.Is(err, sqlair.ErrNoRows) {
-				st.logger.Warningf(ctx, "cannot set constraints, container type %q does not exist", *cons.Container)
-				return applicationerrors.InvalidApplicationConstraints
-			}
-			if err != nil {
-				return errors.Capture(err)
-			}
+	var containerTypeID containerTypeID
+	if cons.Container != nil {
+		err = tx.Query(ctx, selectContainerTypeIDStmt, containerTypeVal{Value: string(*cons.Container)}).Get(&containerTypeID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			st.logger.Warningf(ctx, "cannot set constraints, container type %q does not exist", *cons.Container)
+			return applicationerrors.InvalidApplicationConstraints
 		}
-
-		// First check if the constraint already exists, in that case
-		// we need to update it, unsetting the nil values.
-		var retrievedConstraintUUID constraintUUID
-		err := tx.Query(ctx, selectConstraintUUIDStmt, applicationUUID{ApplicationUUID: appID.String()}).Get(&retrievedConstraintUUID)
-		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		if err != nil {
 			return errors.Capture(err)
-		} else if err == nil {
-			cUUIDStr = retrievedConstraintUUID.ConstraintUUID
 		}
+	}
 
-		// Cleanup tags, spaces and zones from their join tables.
-		if err := tx.Query(ctx, deleteConstraintTagsStmt, constraintUUID{ConstraintUUID: cUUIDStr}).Run(); err != nil {
-			return errors.Capture(err)
-		}
-		if err := tx.Query(ctx, deleteConstraintSpacesStmt, constraintUUID{ConstraintUUID: cUUIDStr}).Run(); err != nil {
-			return errors.Capture(err)
-		}
-		if err := tx.Query(ctx, deleteConstraintZonesStmt, constraintUUID{ConstraintUUID: cUUIDStr}).Run(); err != nil {
-			return errors.Capture(err)
-		}
+	// First check if the constraint already exists, in that case
+	// we need to update it, unsetting the nil values.
+	var retrievedConstraintUUID constraintUUID
+	err = tx.Query(ctx, selectConstraintUUIDStmt, applicationUUID{ApplicationUUID: appID.String()}).Get(&retrievedConstraintUUID)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return errors.Capture(err)
+	} else if err == nil {
+		cUUIDStr = retrievedConstraintUUID.ConstraintUUID
+	}
 
-		constraints := encodeConstraints(cUUIDStr, cons, containerTypeID.ID)
+	// Cleanup tags, spaces and zones from their join tables.
+	if err := tx.Query(ctx, deleteConstraintTagsStmt, constraintUUID{ConstraintUUID: cUUIDStr}).Run(); err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, deleteConstraintSpacesStmt, constraintUUID{ConstraintUUID: cUUIDStr}).Run(); err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, deleteConstraintZonesStmt, constraintUUID{ConstraintUUID: cUUIDStr}).Run(); err != nil {
+		return errors.Capture(err)
+	}
 
-		if err := tx.Query(ctx, insertConstraintsStmt, constraints).Run(); err != nil {
-			return errors.Capture(err)
-		}
+	constraints := encodeConstraints(cUUIDStr, cons, containerTypeID.ID)
 
-		if cons.Tags != nil {
-			for _, tag := range *cons.Tags {
-				constraintTag := setConstraintTag{ConstraintUUID: cUUIDStr, Tag: tag}
-				if err := tx.Query(ctx, insertConstraintTagsStmt, constraintTag).Run(); err != nil {
-					return errors.Capture(err)
-				}
+	if err := tx.Query(ctx, insertConstraintsStmt, constraints).Run(); err != nil {
+		return errors.Capture(err)
+	}
+
+	if cons.Tags != nil {
+		for _, tag := range *cons.Tags {
+			constraintTag := setConstraintTag{ConstraintUUID: cUUIDStr, Tag: tag}
+			if err := tx.Query(ctx, insertConstraintTagsStmt, constraintTag).Run(); err != nil {
+				return errors.Capture(err)
 			}
 		}
+	}
 
-		if cons.Spaces != nil {
-			for _, space := range *cons.Spaces {
-				// Make sure the space actually exists.
-				var spaceUUID spaceUUID
-				err := tx.Query(ctx, selectSpaceStmt, spaceName{Name: space.SpaceName}).Get(&spaceUUID)
-				if errors.Is(err, sqlair.ErrNoRows) {
-					st.logger.Warningf(ctx, "cannot set constraints, space %q does not exist", space)
-					return applicationerrors.InvalidApplicationConstraints
-				}
-				if err != nil {
-					return errors.Capture(err)
-				}
+	if cons.Spaces != nil {
+		for _, space := range *cons.Spaces {
+			// Make sure the space actually exists.
+			var spaceUUID spaceUUID
+			err := tx.Query(ctx, selectSpaceStmt, spaceName{Name: space.SpaceName}).Get(&spaceUUID)
+			if errors.Is(err, sqlair.ErrNoRows) {
+				st.logger.Warningf(ctx, "cannot set constraints, space %q does not exist", space)
+				return applicationerrors.InvalidApplicationConstraints
+			}
+			if err != nil {
+				return errors.Capture(err)
+			}
 
-				constraintSpace := setConstraintSpace{ConstraintUUID: cUUIDStr, Space: space.SpaceName, Exclude: space.Exclude}
-				if err := tx.Query(ctx, insertConstraintSpacesStmt, constraintSpace).Run(); err != nil {
-					return errors.Capture(err)
-				}
+			constraintSpace := setConstraintSpace{ConstraintUUID: cUUIDStr, Space: space.SpaceName, Exclude: space.Exclude}
+			if err := tx.Query(ctx, insertConstraintSpacesStmt, constraintSpace).Run(); err != nil {
+				return errors.Capture(err)
 			}
 		}
+	}
 
-		if cons.Zones != nil {
-			for _, zone := range *cons.Zones {
-				constraintZone := setConstraintZone{ConstraintUUID: cUUIDStr, Zone: zone}
-				if err := tx.Query(ctx, insertConstraintZonesStmt, constraintZone).Run(); err != nil {
-					return errors.Capture(err)
-				}
+	if cons.Zones != nil {
+		for _, zone := range *cons.Zones {
+			constraintZone := setConstraintZone{ConstraintUUID: cUUIDStr, Zone: zone}
+			if err := tx.Query(ctx, insertConstraintZonesStmt, constraintZone).Run(); err != nil {
+				return errors.Capture(err)
 			}
 		}
+	}
 
-		return errors.Capture(
-			tx.Query(ctx, insertAppConstraintsStmt, setApplicationConstraint{
-				ApplicationUUID: appID.String(),
-				ConstraintUUID:  cUUIDStr,
-			}).Run(),
-		)
-	})
+	return errors.Capture(
+		tx.Query(ctx, insertAppConstraintsStmt, setApplicationConstraint{
+			ApplicationUUID: appID.String(),
+			ConstraintUUID:  cUUIDStr,
+		}).Run(),
+	)
 }
 
 // GetDeviceConstraints returns the device constraints for an application.
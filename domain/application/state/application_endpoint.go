@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"maps"
 	"slices"
@@ -17,8 +18,11 @@ import (
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/network"
 	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain/application"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	domainlife "github.com/juju/juju/domain/life"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
 )
 
 // insertApplicationEndpointsParams contains parameters required to insert
@@ -90,9 +94,467 @@ func (st *State) insertApplicationEndpoints(ctx context.Context, tx *sqlair.TX,
 		}
 	}
 
+	// Record the bindings in the same transaction, so that the history can't
+	// diverge from the bindings themselves. The endpoints are new, so there
+	// is no previous binding.
+	changes := make(map[string]bindingChange, len(params.bindings))
+	for endpoint, space := range params.bindings {
+		changes[endpoint] = bindingChange{newSpace: space}
+	}
+	if err := st.recordEndpointBindingChanges(ctx, tx, params.appID, changes); err != nil {
+		return internalerrors.Errorf("recording endpoint binding changes: %w", err)
+	}
+
+	return nil
+}
+
+// MergeApplicationEndpointBindings merges the input bindings, keyed on
+// endpoint name, into the endpoint bindings of the application. The empty
+// endpoint name sets the application default space. Endpoints missing from
+// the input keep their current binding. Every binding changed is recorded in
+// the binding history, in the same transaction.
+//
+// The following error types can be expected to be returned:
+//   - [applicationerrors.ApplicationNotFound] is returned if the application
+//     doesn't exist.
+//   - [applicationerrors.ApplicationNotAlive] is returned if the application
+//     is not alive.
+//   - [applicationerrors.SpaceNotFound] is returned if one of the spaces
+//     doesn't exist.
+//   - [applicationerrors.CharmRelationNotFound] is returned if one of the
+//     endpoints doesn't exist.
+func (st *State) MergeApplicationEndpointBindings(
+	ctx context.Context,
+	appID coreapplication.ID,
+	bindings map[string]network.SpaceName,
+) error {
+	if len(bindings) == 0 {
+		return nil
+	}
+
+	db, err := st.DB()
+	if err != nil {
+		return internalerrors.Capture(err)
+	}
+
+	updateEndpointStmt, err := st.Prepare(`
+UPDATE application_endpoint
+SET    space_uuid = (
+    SELECT uuid
+    FROM   space
+    WHERE  name = $endpointBinding.space_name
+)
+WHERE  application_uuid = $endpointBinding.application_uuid
+AND    charm_relation_uuid = $endpointBinding.charm_endpoint_uuid
+`, endpointBinding{})
+	if err != nil {
+		return internalerrors.Errorf("preparing update application endpoint: %w", err)
+	}
+
+	updateExtraEndpointStmt, err := st.Prepare(`
+UPDATE application_extra_endpoint
+SET    space_uuid = (
+    SELECT uuid
+    FROM   space
+    WHERE  name = $endpointBinding.space_name
+)
+WHERE  application_uuid = $endpointBinding.application_uuid
+AND    charm_extra_binding_uuid = $endpointBinding.charm_endpoint_uuid
+`, endpointBinding{})
+	if err != nil {
+		return internalerrors.Errorf("preparing update application extra endpoint: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationAlive(ctx, tx, appID); err != nil {
+			return internalerrors.Capture(err)
+		}
+
+		endpoints, extraEndpoints, defaultSpace, err := st.getExplicitEndpointBindings(ctx, tx, appID)
+		if err != nil {
+			return internalerrors.Errorf("getting endpoint bindings: %w", err)
+		}
+
+		if err := st.checkSpaceNames(ctx, tx, slices.Collect(maps.Values(bindings))); err != nil {
+			return internalerrors.Errorf("checking space names: %w", err)
+		}
+		unknown := set.NewStrings(slices.Collect(maps.Keys(bindings))...)
+		unknown.Remove("")
+		for _, e := range append(slices.Clone(endpoints), extraEndpoints...) {
+			unknown.Remove(e.EndpointName)
+		}
+		if unknown.Size() > 0 {
+			return internalerrors.
+				Errorf("charm relation(s) or extra binding %q not found", strings.Join(unknown.SortedValues(), ",")).
+				Add(applicationerrors.CharmRelationNotFound)
+		}
+
+		changes := make(map[string]bindingChange)
+		if space, ok := bindings[""]; ok {
+			if err := st.updateDefaultSpace(ctx, tx, appID, bindings); err != nil {
+				return internalerrors.Errorf("updating default space: %w", err)
+			}
+			changes[""] = bindingChange{oldSpace: defaultSpace, newSpace: space}
+		}
+
+		update := func(stmt *sqlair.Statement, current []endpointBinding) error {
+			for _, e := range current {
+				space, ok := bindings[e.EndpointName]
+				if !ok || network.SpaceName(e.SpaceName) == space {
+					continue
+				}
+				changes[e.EndpointName] = bindingChange{
+					oldSpace: network.SpaceName(e.SpaceName),
+					newSpace: space,
+				}
+				e.SpaceName = string(space)
+				if err := tx.Query(ctx, stmt, e).Run(); err != nil {
+					return internalerrors.Errorf("updating binding of endpoint %q: %w", e.EndpointName, err)
+				}
+			}
+			return nil
+		}
+		if err := update(updateEndpointStmt, endpoints); err != nil {
+			return internalerrors.Capture(err)
+		}
+		if err := update(updateExtraEndpointStmt, extraEndpoints); err != nil {
+			return internalerrors.Capture(err)
+		}
+
+		if err := st.recordEndpointBindingChanges(ctx, tx, appID, changes); err != nil {
+			return internalerrors.Errorf("recording endpoint binding changes: %w", err)
+		}
+		return nil
+	})
+}
+
+// getExplicitEndpointBindings returns the explicit bindings of the relation
+// endpoints and the extra endpoints of the application, along with the name
+// of the application default space.
+func (st *State) getExplicitEndpointBindings(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appID coreapplication.ID,
+) ([]endpointBinding, []endpointBinding, network.SpaceName, error) {
+	ident := applicationID{ID: appID}
+	endpointStmt, err := st.Prepare(`
+SELECT ae.application_uuid AS &endpointBinding.application_uuid,
+       ae.charm_relation_uuid AS &endpointBinding.charm_endpoint_uuid,
+       cr.name AS &endpointBinding.endpoint_name,
+       COALESCE(s.name, '') AS &endpointBinding.space_name
+FROM   application_endpoint ae
+JOIN   charm_relation cr ON cr.uuid = ae.charm_relation_uuid
+LEFT JOIN space s ON s.uuid = ae.space_uuid
+WHERE  ae.application_uuid = $applicationID.uuid
+`, endpointBinding{}, ident)
+	if err != nil {
+		return nil, nil, "", internalerrors.Errorf("preparing application endpoints query: %w", err)
+	}
+
+	extraEndpointStmt, err := st.Prepare(`
+SELECT aee.application_uuid AS &endpointBinding.application_uuid,
+       aee.charm_extra_binding_uuid AS &endpointBinding.charm_endpoint_uuid,
+       ceb.name AS &endpointBinding.endpoint_name,
+       COALESCE(s.name, '') AS &endpointBinding.space_name
+FROM   application_extra_endpoint aee
+JOIN   charm_extra_binding ceb ON ceb.uuid = aee.charm_extra_binding_uuid
+LEFT JOIN space s ON s.uuid = aee.space_uuid
+WHERE  aee.application_uuid = $applicationID.uuid
+`, endpointBinding{}, ident)
+	if err != nil {
+		return nil, nil, "", internalerrors.Errorf("preparing application extra endpoints query: %w", err)
+	}
+
+	defaultSpaceStmt, err := st.Prepare(`
+SELECT s.name AS &endpointSpaceName.space_name
+FROM   application a
+JOIN   space s ON s.uuid = a.space_uuid
+WHERE  a.uuid = $applicationID.uuid
+`, endpointSpaceName{}, ident)
+	if err != nil {
+		return nil, nil, "", internalerrors.Errorf("preparing application default space query: %w", err)
+	}
+
+	var defaultSpace endpointSpaceName
+	err = tx.Query(ctx, defaultSpaceStmt, ident).Get(&defaultSpace)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil, "", applicationerrors.ApplicationNotFound
+	} else if err != nil {
+		return nil, nil, "", internalerrors.Errorf("getting application default space: %w", err)
+	}
+
+	var endpoints []endpointBinding
+	err = tx.Query(ctx, endpointStmt, ident).GetAll(&endpoints)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil, "", internalerrors.Errorf("getting application endpoints: %w", err)
+	}
+
+	var extraEndpoints []endpointBinding
+	err = tx.Query(ctx, extraEndpointStmt, ident).GetAll(&extraEndpoints)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil, "", internalerrors.Errorf("getting application extra endpoints: %w", err)
+	}
+
+	return endpoints, extraEndpoints, network.SpaceName(defaultSpace.SpaceName), nil
+}
+
+// bindingChange holds the space an endpoint was bound to before and after a
+// change. An empty space name means there is no explicit binding.
+type bindingChange struct {
+	oldSpace network.SpaceName
+	newSpace network.SpaceName
+}
+
+// recordEndpointBindingChanges records the given endpoint binding changes,
+// keyed on endpoint name, in the binding history of the application. Changes
+// which leave the binding unchanged are not recorded.
+func (st *State) recordEndpointBindingChanges(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appID coreapplication.ID,
+	changes map[string]bindingChange,
+) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO application_endpoint_binding_history (*)
+VALUES ($endpointBindingChange.*)
+`, endpointBindingChange{})
+	if err != nil {
+		return internalerrors.Errorf("preparing insert binding change: %w", err)
+	}
+
+	nullEmpty := func(s network.SpaceName) sql.NullString {
+		return sql.NullString{String: string(s), Valid: s != ""}
+	}
+
+	now := st.clock.Now().UTC()
+	for _, endpoint := range slices.Sorted(maps.Keys(changes)) {
+		change := changes[endpoint]
+		if change.oldSpace == change.newSpace {
+			continue
+		}
+
+		changeUUID, err := uuid.NewUUID()
+		if err != nil {
+			return internalerrors.Capture(err)
+		}
+		if err := tx.Query(ctx, insertStmt, endpointBindingChange{
+			UUID:            changeUUID.String(),
+			ApplicationUUID: appID,
+			EndpointName:    endpoint,
+			OldSpaceName:    nullEmpty(change.oldSpace),
+			NewSpaceName:    nullEmpty(change.newSpace),
+			ChangedAt:       now,
+		}).Run(); err != nil {
+			return internalerrors.Errorf("inserting binding change for endpoint %q: %w", endpoint, err)
+		}
+	}
 	return nil
 }
 
+// GetEndpointBindingHistory returns the changes made to the endpoint bindings
+// of the application with the given ID, oldest first. The history is removed
+// along with the application, so it only covers applications which still
+// exist.
+//
+// The following error types can be expected to be returned:
+//   - [applicationerrors.ApplicationNotFound] is returned if the application
+//     doesn't exist.
+func (st *State) GetEndpointBindingHistory(ctx context.Context, appID coreapplication.ID) ([]application.BindingChange, error) {
+	ident := applicationID{ID: appID}
+	stmt, err := st.Prepare(`
+SELECT &endpointBindingChange.*
+FROM   application_endpoint_binding_history
+WHERE  application_uuid = $applicationID.uuid
+ORDER BY changed_at, rowid
+`, endpointBindingChange{}, ident)
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing binding history query: %w", err)
+	}
+
+	var changes []endpointBindingChange
+	err = st.readTxn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationLife(ctx, tx, appID, domainlife.Dead); err != nil {
+			return internalerrors.Capture(err)
+		}
+		err := tx.Query(ctx, stmt, ident).GetAll(&changes)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("getting binding history for application %q: %w", appID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	result := make([]application.BindingChange, len(changes))
+	for i, change := range changes {
+		result[i] = application.BindingChange{
+			Endpoint:  change.EndpointName,
+			OldSpace:  network.SpaceName(change.OldSpaceName.String),
+			NewSpace:  network.SpaceName(change.NewSpaceName.String),
+			ChangedAt: change.ChangedAt,
+		}
+	}
+	return result, nil
+}
+
+// GetApplicationEndpointBindings returns every endpoint of the application,
+// both relation and extra endpoints, mapped to the name of the space it is
+// bound to. Endpoints without an explicit binding are mapped to the
+// application's default space, which is also returned under the empty
+// endpoint name.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] is returned if the application
+//     doesn't exist.
+func (st *State) GetApplicationEndpointBindings(ctx context.Context, appID coreapplication.ID) (map[string]network.SpaceName, error) {
+	ident := applicationID{ID: appID}
+	endpointStmt, err := st.Prepare(`
+SELECT cr.name AS &endpointSpaceName.endpoint_name,
+       COALESCE(s.name, ds.name) AS &endpointSpaceName.space_name
+FROM   application_endpoint ae
+JOIN   application a ON a.uuid = ae.application_uuid
+JOIN   charm_relation cr ON cr.uuid = ae.charm_relation_uuid
+LEFT JOIN space s ON s.uuid = ae.space_uuid
+JOIN   space ds ON ds.uuid = a.space_uuid
+WHERE  ae.application_uuid = $applicationID.uuid
+`, endpointSpaceName{}, ident)
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing application endpoints query: %w", err)
+	}
+
+	extraEndpointStmt, err := st.Prepare(`
+SELECT ceb.name AS &endpointSpaceName.endpoint_name,
+       COALESCE(s.name, ds.name) AS &endpointSpaceName.space_name
+FROM   application_extra_endpoint aee
+JOIN   application a ON a.uuid = aee.application_uuid
+JOIN   charm_extra_binding ceb ON ceb.uuid = aee.charm_extra_binding_uuid
+LEFT JOIN space s ON s.uuid = aee.space_uuid
+JOIN   space ds ON ds.uuid = a.space_uuid
+WHERE  aee.application_uuid = $applicationID.uuid
+`, endpointSpaceName{}, ident)
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing application extra endpoints query: %w", err)
+	}
+
+	defaultSpaceStmt, err := st.Prepare(`
+SELECT s.name AS &endpointSpaceName.space_name
+FROM   application a
+JOIN   space s ON s.uuid = a.space_uuid
+WHERE  a.uuid = $applicationID.uuid
+`, endpointSpaceName{}, ident)
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing application default space query: %w", err)
+	}
+
+	var (
+		endpoints      []endpointSpaceName
+		extraEndpoints []endpointSpaceName
+		defaultSpace   endpointSpaceName
+	)
+	err = st.readTxn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, defaultSpaceStmt, ident).Get(&defaultSpace)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return applicationerrors.ApplicationNotFound
+		} else if err != nil {
+			return internalerrors.Errorf("getting application default space: %w", err)
+		}
+
+		err = tx.Query(ctx, endpointStmt, ident).GetAll(&endpoints)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("getting application endpoints: %w", err)
+		}
+
+		err = tx.Query(ctx, extraEndpointStmt, ident).GetAll(&extraEndpoints)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("getting application extra endpoints: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	bindings := make(map[string]network.SpaceName, len(endpoints)+len(extraEndpoints)+1)
+	for _, e := range endpoints {
+		bindings[e.EndpointName] = network.SpaceName(e.SpaceName)
+	}
+	for _, e := range extraEndpoints {
+		bindings[e.EndpointName] = network.SpaceName(e.SpaceName)
+	}
+	bindings[""] = network.SpaceName(defaultSpace.SpaceName)
+	return bindings, nil
+}
+
+// GetApplicationsBoundToSpace returns the sorted names of the applications
+// with an endpoint bound to the input space, either explicitly or by
+// following the application's default space. An application whose endpoints
+// are all explicitly bound to other spaces is not bound to its default space.
+//
+// The following errors may be returned:
+//   - [applicationerrors.SpaceNotFound] is returned if the space doesn't
+//     exist.
+func (st *State) GetApplicationsBoundToSpace(ctx context.Context, name network.SpaceName) ([]string, error) {
+	space := spaceName{Name: string(name)}
+	spaceStmt, err := st.Prepare(`
+SELECT &spaceUUID.uuid
+FROM   space
+WHERE  name = $spaceName.name
+`, spaceUUID{}, space)
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing space query: %w", err)
+	}
+
+	// An endpoint with no space of its own follows the application's
+	// default binding, as does an application without any endpoints.
+	appsStmt, err := st.Prepare(`
+SELECT DISTINCT a.name AS &applicationName.name
+FROM   application a
+LEFT JOIN (
+    SELECT application_uuid, space_uuid
+    FROM   application_endpoint
+    UNION ALL
+    SELECT application_uuid, space_uuid
+    FROM   application_extra_endpoint
+) e ON e.application_uuid = a.uuid
+WHERE  COALESCE(e.space_uuid, a.space_uuid) = $spaceUUID.uuid
+ORDER BY a.name
+`, applicationName{}, spaceUUID{})
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing applications in space query: %w", err)
+	}
+
+	var apps []applicationName
+	err = st.readTxn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var uuid spaceUUID
+		err := tx.Query(ctx, spaceStmt, space).Get(&uuid)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("space %q not found", name).Add(applicationerrors.SpaceNotFound)
+		} else if err != nil {
+			return internalerrors.Errorf("getting space %q: %w", name, err)
+		}
+
+		err = tx.Query(ctx, appsStmt, uuid).GetAll(&apps)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("getting applications in space %q: %w", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	names := make([]string, len(apps))
+	for i, app := range apps {
+		names[i] = app.Name
+	}
+	return names, nil
+}
+
 // insertApplicationEndpoint inserts an application endpoint into the database,
 // associating it with a relation and space.
 func (st *State) insertApplicationEndpoint(
@@ -6,6 +6,7 @@ package state
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/clock"
@@ -17,6 +18,7 @@ import (
 	corecharm "github.com/juju/juju/core/charm"
 	charmtesting "github.com/juju/juju/core/charm/testing"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/application"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -122,6 +124,49 @@ func (s *applicationEndpointStateSuite) TestInsertApplicationNoCharmRelationWith
 	c.Check(s.fetchApplicationEndpoints(c), jc.DeepEquals, []applicationEndpoint{})
 }
 
+// TestInsertApplicationNoCharmRelationWithDefaultEndpointAndExtraBinding
+// verifies that when only the default space is bound and the charm has an
+// extra binding but no relation, the extra endpoint follows the updated
+// default space.
+func (s *applicationEndpointStateSuite) TestInsertApplicationNoCharmRelationWithDefaultEndpointAndExtraBinding(c *gc.C) {
+	// Arrange: No relation, one extra endpoint
+	db, err := s.state.DB()
+	c.Assert(err, jc.ErrorIsNil)
+	extraUUID := s.addExtraBinding(c, "extra")
+	bindings := map[string]network.SpaceName{
+		"": s.addSpaceReturningName(c, "beta"),
+	}
+
+	// Act: the extra endpoint is created without a space
+	err = db.Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return s.state.insertApplicationEndpoints(context.Background(), tx, insertApplicationEndpointsParams{
+			appID:     s.appID,
+			charmUUID: s.charmUUID,
+			bindings:  bindings,
+		})
+	})
+
+	// Assert: Should have
+	//  - default space updated to beta.
+	//  - no application endpoint,
+	//  - an application extra endpoint without spacename, which resolves to
+	//    the default space.
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.getApplicationDefaultSpace(c), gc.Equals, "beta")
+	c.Check(s.fetchApplicationEndpoints(c), jc.DeepEquals, []applicationEndpoint{})
+	c.Check(s.fetchApplicationExtraEndpoints(c), jc.DeepEquals, []applicationEndpoint{
+		{
+			charmRelationUUID: extraUUID,
+		},
+	})
+	resolved, err := s.state.GetApplicationEndpointBindings(context.Background(), s.appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(resolved, jc.DeepEquals, map[string]network.SpaceName{
+		"":      "beta",
+		"extra": "beta",
+	})
+}
+
 // TestInsertApplicationNoBindings tests the insertion of application
 // endpoints with no bindings
 func (s *applicationEndpointStateSuite) TestInsertApplicationNoBindings(c *gc.C) {
@@ -485,6 +530,298 @@ func (s *applicationEndpointStateSuite) TestGetEndpointBindingsApplicationNotFou
 	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+// TestGetApplicationEndpointBindings verifies that the bindings of an
+// application are returned by space name, falling back to the default space.
+func (s *applicationEndpointStateSuite) TestGetApplicationEndpointBindings(c *gc.C) {
+	// Arrange: one relation and one extra endpoint bound explicitly, and
+	// one of each without a space.
+	relationUUID1 := s.addRelation(c, "charmRelation1")
+	relationUUID2 := s.addRelation(c, "charmRelation2")
+	extraBindingUUID1 := s.addExtraBinding(c, "extra1")
+	extraBindingUUID2 := s.addExtraBinding(c, "extra2")
+	spaceUUID1 := s.addSpace(c, "space1")
+	spaceUUID2 := s.addSpace(c, "space2")
+	s.addApplicationEndpoint(c, spaceUUID1, relationUUID1)
+	s.addApplicationEndpointNullSpace(c, relationUUID2)
+	s.addApplicationExtraEndpoint(c, spaceUUID2, extraBindingUUID1)
+	s.addApplicationExtraEndpointNullSpace(c, extraBindingUUID2)
+
+	// Arrange: Set the application default space.
+	defaultSpaceUUID := s.addSpace(c, "default")
+	s.setApplicationDefaultSpace(c, defaultSpaceUUID)
+
+	// Act:
+	bindings, err := s.state.GetApplicationEndpointBindings(context.Background(), s.appID)
+
+	// Assert: unbound endpoints resolve to the default space.
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(bindings, gc.DeepEquals, map[string]network.SpaceName{
+		"charmRelation1": "space1",
+		"charmRelation2": "default",
+		"extra1":         "space2",
+		"extra2":         "default",
+		"":               "default",
+	})
+}
+
+func (s *applicationEndpointStateSuite) TestGetApplicationEndpointBindingsOnlyDefault(c *gc.C) {
+	bindings, err := s.state.GetApplicationEndpointBindings(context.Background(), s.appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(bindings, gc.DeepEquals, map[string]network.SpaceName{
+		"": network.AlphaSpaceName,
+	})
+}
+
+func (s *applicationEndpointStateSuite) TestGetApplicationEndpointBindingsApplicationNotFound(c *gc.C) {
+	_, err := s.state.GetApplicationEndpointBindings(context.Background(), "unknown-app-uuid")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationEndpointStateSuite) TestGetApplicationsBoundToSpaceDefault(c *gc.C) {
+	apps, err := s.state.GetApplicationsBoundToSpace(context.Background(), network.AlphaSpaceName)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, gc.DeepEquals, []string{"foo"})
+}
+
+func (s *applicationEndpointStateSuite) TestGetApplicationsBoundToSpaceExplicit(c *gc.C) {
+	// Arrange: one relation and one extra endpoint bound to different
+	// spaces, and a third space with nothing bound to it.
+	relationUUID := s.addRelation(c, "charmRelation")
+	extraBindingUUID := s.addExtraBinding(c, "extra")
+	spaceUUID1 := s.addSpace(c, "space1")
+	spaceUUID2 := s.addSpace(c, "space2")
+	s.addSpace(c, "space3")
+	s.addApplicationEndpoint(c, spaceUUID1, relationUUID)
+	s.addApplicationExtraEndpoint(c, spaceUUID2, extraBindingUUID)
+
+	// Act & Assert:
+	apps, err := s.state.GetApplicationsBoundToSpace(context.Background(), "space1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, gc.DeepEquals, []string{"foo"})
+
+	apps, err = s.state.GetApplicationsBoundToSpace(context.Background(), "space2")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, gc.DeepEquals, []string{"foo"})
+
+	apps, err = s.state.GetApplicationsBoundToSpace(context.Background(), "space3")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, gc.HasLen, 0)
+
+	// Every endpoint is explicitly bound elsewhere, so nothing follows the
+	// default space any more.
+	apps, err = s.state.GetApplicationsBoundToSpace(context.Background(), network.AlphaSpaceName)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, gc.HasLen, 0)
+}
+
+func (s *applicationEndpointStateSuite) TestGetApplicationsBoundToSpaceNullSpace(c *gc.C) {
+	// Arrange: one endpoint bound to a space, and an extra endpoint
+	// following the application's default space.
+	relationUUID := s.addRelation(c, "charmRelation")
+	extraBindingUUID := s.addExtraBinding(c, "extra")
+	spaceUUID1 := s.addSpace(c, "space1")
+	s.addApplicationEndpoint(c, spaceUUID1, relationUUID)
+	s.addApplicationExtraEndpointNullSpace(c, extraBindingUUID)
+
+	// Act & Assert:
+	apps, err := s.state.GetApplicationsBoundToSpace(context.Background(), "space1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, gc.DeepEquals, []string{"foo"})
+
+	apps, err = s.state.GetApplicationsBoundToSpace(context.Background(), network.AlphaSpaceName)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(apps, gc.DeepEquals, []string{"foo"})
+}
+
+func (s *applicationEndpointStateSuite) TestGetApplicationsBoundToSpaceNotFound(c *gc.C) {
+	_, err := s.state.GetApplicationsBoundToSpace(context.Background(), "unknown")
+	c.Assert(err, jc.ErrorIs, applicationerrors.SpaceNotFound)
+}
+
+func (s *applicationEndpointStateSuite) TestReadTxn(c *gc.C) {
+	stmt, err := s.state.Prepare(`
+SELECT &applicationID.uuid
+FROM   application
+WHERE  uuid = $applicationID.uuid
+`, applicationID{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var got applicationID
+	err = s.state.readTxn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, applicationID{ID: s.appID}).Get(&got)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got.ID, gc.Equals, s.appID)
+}
+
+func (s *applicationEndpointStateSuite) TestReadTxnReturnsError(c *gc.C) {
+	boom := errors.New("boom")
+	err := s.state.readTxn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return boom
+	})
+	c.Check(err, jc.ErrorIs, boom)
+}
+
+// TestGetEndpointBindingHistory verifies that the bindings set when inserting
+// application endpoints are recorded in the binding history.
+func (s *applicationEndpointStateSuite) TestGetEndpointBindingHistory(c *gc.C) {
+	// Arrange: Insert endpoints, binding one of them and the default space.
+	db, err := s.state.DB()
+	c.Assert(err, jc.ErrorIsNil)
+	s.addRelation(c, "default")
+	s.addRelation(c, "bound")
+	bindings := map[string]network.SpaceName{
+		"":      s.addSpaceReturningName(c, "gamma"),
+		"bound": s.addSpaceReturningName(c, "beta"),
+	}
+	err = db.Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return s.state.insertApplicationEndpoints(context.Background(), tx, insertApplicationEndpointsParams{
+			appID:     s.appID,
+			charmUUID: s.charmUUID,
+			bindings:  bindings,
+		})
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Act:
+	history, err := s.state.GetEndpointBindingHistory(context.Background(), s.appID)
+
+	// Assert: Only the explicit bindings are recorded.
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 2)
+	for i := range history {
+		c.Check(history[i].ChangedAt.IsZero(), jc.IsFalse)
+		history[i].ChangedAt = time.Time{}
+	}
+	c.Check(history, jc.DeepEquals, []application.BindingChange{
+		{Endpoint: "", NewSpace: "gamma"},
+		{Endpoint: "bound", NewSpace: "beta"},
+	})
+}
+
+// TestGetEndpointBindingHistoryNoBindings verifies that no history is
+// recorded for endpoints bound to the application default space.
+func (s *applicationEndpointStateSuite) TestGetEndpointBindingHistoryNoBindings(c *gc.C) {
+	// Arrange:
+	db, err := s.state.DB()
+	c.Assert(err, jc.ErrorIsNil)
+	s.addRelation(c, "default")
+	err = db.Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return s.state.insertApplicationEndpoints(context.Background(), tx, insertApplicationEndpointsParams{
+			appID:     s.appID,
+			charmUUID: s.charmUUID,
+		})
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Act:
+	history, err := s.state.GetEndpointBindingHistory(context.Background(), s.appID)
+
+	// Assert:
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, gc.HasLen, 0)
+}
+
+func (s *applicationEndpointStateSuite) TestGetEndpointBindingHistoryApplicationNotFound(c *gc.C) {
+	// Act:
+	_, err := s.state.GetEndpointBindingHistory(context.Background(), "bad-uuid")
+
+	// Assert:
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+// TestMergeApplicationEndpointBindings verifies that merging bindings
+// updates the bound endpoints and the default space, and records every
+// binding changed in the binding history.
+func (s *applicationEndpointStateSuite) TestMergeApplicationEndpointBindings(c *gc.C) {
+	// Arrange: one relation and one extra endpoint bound explicitly, and
+	// one relation following the default space.
+	relationUUID1 := s.addRelation(c, "charmRelation1")
+	relationUUID2 := s.addRelation(c, "charmRelation2")
+	extraBindingUUID := s.addExtraBinding(c, "extra")
+	spaceUUID1 := s.addSpace(c, "space1")
+	spaceUUID2 := s.addSpace(c, "space2")
+	s.addSpace(c, "space3")
+	s.addApplicationEndpoint(c, spaceUUID1, relationUUID1)
+	s.addApplicationEndpointNullSpace(c, relationUUID2)
+	s.addApplicationExtraEndpoint(c, spaceUUID2, extraBindingUUID)
+
+	// Act: rebind the default space and two of the endpoints, leaving the
+	// extra endpoint unchanged.
+	err := s.state.MergeApplicationEndpointBindings(context.Background(), s.appID, map[string]network.SpaceName{
+		"":               "space3",
+		"charmRelation1": "space2",
+		"charmRelation2": "space1",
+		"extra":          "space2",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Assert:
+	bindings, err := s.state.GetApplicationEndpointBindings(context.Background(), s.appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(bindings, gc.DeepEquals, map[string]network.SpaceName{
+		"charmRelation1": "space2",
+		"charmRelation2": "space1",
+		"extra":          "space2",
+		"":               "space3",
+	})
+
+	history, err := s.state.GetEndpointBindingHistory(context.Background(), s.appID)
+	c.Assert(err, jc.ErrorIsNil)
+	for i := range history {
+		c.Check(history[i].ChangedAt.IsZero(), jc.IsFalse)
+		history[i].ChangedAt = time.Time{}
+	}
+	c.Check(history, jc.DeepEquals, []application.BindingChange{
+		{Endpoint: "", OldSpace: network.AlphaSpaceName, NewSpace: "space3"},
+		{Endpoint: "charmRelation1", OldSpace: "space1", NewSpace: "space2"},
+		{Endpoint: "charmRelation2", NewSpace: "space1"},
+	})
+}
+
+func (s *applicationEndpointStateSuite) TestMergeApplicationEndpointBindingsUnknownEndpoint(c *gc.C) {
+	// Arrange:
+	relationUUID := s.addRelation(c, "charmRelation")
+	s.addApplicationEndpointNullSpace(c, relationUUID)
+	s.addSpace(c, "space1")
+
+	// Act:
+	err := s.state.MergeApplicationEndpointBindings(context.Background(), s.appID, map[string]network.SpaceName{
+		"charmRelation": "space1",
+		"unknown":       "space1",
+	})
+
+	// Assert: nothing is changed or recorded.
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmRelationNotFound)
+	history, err := s.state.GetEndpointBindingHistory(context.Background(), s.appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, gc.HasLen, 0)
+}
+
+func (s *applicationEndpointStateSuite) TestMergeApplicationEndpointBindingsUnknownSpace(c *gc.C) {
+	// Arrange:
+	relationUUID := s.addRelation(c, "charmRelation")
+	s.addApplicationEndpointNullSpace(c, relationUUID)
+
+	// Act:
+	err := s.state.MergeApplicationEndpointBindings(context.Background(), s.appID, map[string]network.SpaceName{
+		"charmRelation": "unknown",
+	})
+
+	// Assert:
+	c.Assert(err, jc.ErrorIs, applicationerrors.SpaceNotFound)
+}
+
+func (s *applicationEndpointStateSuite) TestMergeApplicationEndpointBindingsApplicationNotFound(c *gc.C) {
+	// Act:
+	err := s.state.MergeApplicationEndpointBindings(context.Background(), "bad-uuid", map[string]network.SpaceName{
+		"": network.AlphaSpaceName,
+	})
+
+	// Assert:
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *applicationEndpointStateSuite) addApplicationEndpoint(c *gc.C, spaceUUID, relationUUID string) string {
 	endpointUUID := uuid.MustNewUUID().String()
 	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
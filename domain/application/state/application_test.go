@@ -3326,6 +3326,66 @@ func (s *applicationStateSuite) TestSetConstraintsApplicationNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *applicationStateSuite) TestMergeApplicationConstraints(c *gc.C) {
+	id := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationConstraints(context.Background(), id, constraints.Constraints{
+		Mem:      ptr(uint64(8)),
+		CpuCores: ptr(uint64(2)),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	merged, err := s.state.MergeApplicationConstraints(context.Background(), id,
+		func(existing constraints.Constraints) (constraints.Constraints, error) {
+			c.Check(existing, gc.DeepEquals, constraints.Constraints{
+				Mem:      ptr(uint64(8)),
+				CpuCores: ptr(uint64(2)),
+			})
+			existing.Mem = ptr(uint64(16))
+			return existing, nil
+		})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(merged, gc.DeepEquals, constraints.Constraints{
+		Mem:      ptr(uint64(16)),
+		CpuCores: ptr(uint64(2)),
+	})
+
+	cons, err := s.state.GetApplicationConstraints(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cons, gc.DeepEquals, merged)
+}
+
+func (s *applicationStateSuite) TestMergeApplicationConstraintsMergeError(c *gc.C) {
+	id := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationConstraints(context.Background(), id, constraints.Constraints{
+		Mem: ptr(uint64(8)),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.MergeApplicationConstraints(context.Background(), id,
+		func(existing constraints.Constraints) (constraints.Constraints, error) {
+			return constraints.Constraints{}, errors.New("boom")
+		})
+	c.Assert(err, gc.ErrorMatches, ".*boom")
+
+	// The constraints are left unchanged.
+	cons, err := s.state.GetApplicationConstraints(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cons, gc.DeepEquals, constraints.Constraints{
+		Mem: ptr(uint64(8)),
+	})
+}
+
+func (s *applicationStateSuite) TestMergeApplicationConstraintsApplicationNotFound(c *gc.C) {
+	_, err := s.state.MergeApplicationConstraints(context.Background(), "foo",
+		func(existing constraints.Constraints) (constraints.Constraints, error) {
+			c.Fatalf("merge function called for a missing application")
+			return existing, nil
+		})
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *applicationStateSuite) TestGetApplicationCharmOriginEmptyChannel(c *gc.C) {
 	id := s.createApplication(c, "foo", life.Alive)
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/core/application"
 	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/objectstore"
 	domainapplication "github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/architecture"
 	"github.com/juju/juju/domain/application/charm"
@@ -318,6 +319,59 @@ WHERE uuid = $charmID.uuid;
 	return archivePath.ArchivePath, nil
 }
 
+// GetCharmArchiveBySHA384 returns the archive path and object store UUID of
+// a charm with the given source, whose archive has the given SHA384 hash and
+// is still in the object store under that path.
+// If there is no such charm, a [errors.CharmNotFound] error is returned.
+func (s *State) GetCharmArchiveBySHA384(ctx context.Context, sha384 string, source charm.CharmSource) (string, objectstore.UUID, error) {
+	db, err := s.DB()
+	if err != nil {
+		return "", "", errors.Capture(err)
+	}
+
+	sourceID, err := encodeCharmSource(source)
+	if err != nil {
+		return "", "", errors.Errorf("encoding charm source: %w", err)
+	}
+
+	var location charmArchiveLocation
+	ident := charmArchiveSHA384{
+		SHA384:   sha384,
+		SourceID: sourceID,
+	}
+
+	query := `
+SELECT c.archive_path AS &charmArchiveLocation.archive_path,
+       c.object_store_uuid AS &charmArchiveLocation.object_store_uuid
+FROM charm AS c
+JOIN object_store_metadata AS m ON m.uuid = c.object_store_uuid
+JOIN object_store_metadata_path AS p ON p.path = c.archive_path AND p.metadata_uuid = m.uuid
+WHERE m.sha_384 = $charmArchiveSHA384.sha_384
+AND c.source_id = $charmArchiveSHA384.source_id
+ORDER BY c.create_time
+LIMIT 1;
+`
+
+	stmt, err := s.Prepare(query, location, ident)
+	if err != nil {
+		return "", "", errors.Errorf("preparing query: %w", err)
+	}
+
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, ident).Get(&location); err != nil {
+			if errors.Is(err, sqlair.ErrNoRows) {
+				return applicationerrors.CharmNotFound
+			}
+			return err
+		}
+		return nil
+	}); err != nil {
+		return "", "", errors.Errorf("getting charm archive by sha384: %w", err)
+	}
+
+	return location.ArchivePath, objectstore.UUID(location.ObjectStoreUUID), nil
+}
+
 // GetCharmArchiveMetadata returns the archive storage path and the sha256 hash
 // for the charm using the charm ID.
 // If the charm does not exist, a [errors.CharmNotFound] error is returned.
@@ -623,7 +677,8 @@ func (s *State) GetCharm(ctx context.Context, id corecharm.ID) (charm.Charm, *ch
 }
 
 // SetCharm persists the charm metadata, actions, config and manifest to
-// state.
+// state. If a local charm would use the archive path of a charm from
+// charmhub, [applicationerrors.CharmSourceConflict] is returned.
 func (s *State) SetCharm(ctx context.Context, ch charm.Charm, downloadInfo *charm.DownloadInfo, requiresSequencing bool) (corecharm.ID, charm.CharmLocator, error) {
 	// This check is defensive, as the service layer should not allow this to
 	// happen, but it causes confusion if it does happen.
@@ -876,7 +931,9 @@ WHERE charm.uuid = $charmID.uuid;
 
 // ResolveMigratingUploadedCharm resolves the charm that is migrating from
 // the uploaded state to the available state. If the charm is not found, a
-// [applicationerrors.CharmNotFound] error is returned.
+// [applicationerrors.CharmNotFound] error is returned. If a local charm would
+// use the archive path of a charm from charmhub,
+// [applicationerrors.CharmSourceConflict] is returned.
 func (s *State) ResolveMigratingUploadedCharm(ctx context.Context, id corecharm.ID, info charm.ResolvedMigratingUploadedCharm) (charm.CharmLocator, error) {
 	db, err := s.DB()
 	if err != nil {
@@ -940,6 +997,10 @@ WHERE uuid = $charmID.uuid;
 			return errors.Errorf("updating charm state: %w", err)
 		}
 
+		if err := s.checkCharmArchiveSource(ctx, tx, id); err != nil {
+			return errors.Capture(err)
+		}
+
 		// Insert the charm download info.
 		if err := s.setCharmDownloadInfo(ctx, tx, id, info.DownloadInfo); err != nil {
 			return errors.Errorf("setting charm download info: %w", err)
@@ -262,6 +262,167 @@ func (s *charmStateSuite) TestSetCharmGetCharmID(c *gc.C) {
 	c.Check(charmID, gc.Equals, id)
 }
 
+func (s *charmStateSuite) TestSetCharmLocalSourceConflict(c *gc.C) {
+	// Two separate states, which share nothing but the database, must both
+	// see the conflict.
+	st1 := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+	st2 := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	_, _, err := st1.SetCharm(context.Background(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "foo",
+		},
+		Manifest:      s.minimalManifest(c),
+		Source:        charm.CharmHubSource,
+		Revision:      42,
+		ReferenceName: "foo",
+		Hash:          "hash",
+		ArchivePath:   "archive",
+		Version:       "deadbeef",
+		Architecture:  architecture.AMD64,
+	}, &charm.DownloadInfo{
+		Provenance:         charm.ProvenanceDownload,
+		CharmhubIdentifier: "ident",
+		DownloadURL:        "https://example.com/foo",
+		DownloadSize:       42,
+	}, false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, _, err = st2.SetCharm(context.Background(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "bar",
+		},
+		Manifest:      s.minimalManifest(c),
+		Source:        charm.LocalSource,
+		Revision:      1,
+		ReferenceName: "bar",
+		Hash:          "hash",
+		ArchivePath:   "archive",
+		Version:       "deadbeef",
+	}, nil, false)
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmSourceConflict)
+
+	// The local charm was not persisted.
+	_, err = st1.GetCharmID(context.Background(), "bar", 1, charm.LocalSource)
+	c.Check(err, jc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
+func (s *charmStateSuite) TestSetCharmCharmhubSharesLocalArchive(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	// Only local charms are refused the archive of a charmhub charm.
+	_, _, err := st.SetCharm(context.Background(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "bar",
+		},
+		Manifest:      s.minimalManifest(c),
+		Source:        charm.LocalSource,
+		Revision:      1,
+		ReferenceName: "bar",
+		Hash:          "hash",
+		ArchivePath:   "archive",
+		Version:       "deadbeef",
+	}, nil, false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, _, err = st.SetCharm(context.Background(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "foo",
+		},
+		Manifest:      s.minimalManifest(c),
+		Source:        charm.CharmHubSource,
+		Revision:      42,
+		ReferenceName: "foo",
+		Hash:          "hash",
+		ArchivePath:   "archive",
+		Version:       "deadbeef",
+		Architecture:  architecture.AMD64,
+	}, &charm.DownloadInfo{
+		Provenance:         charm.ProvenanceDownload,
+		CharmhubIdentifier: "ident",
+		DownloadURL:        "https://example.com/foo",
+		DownloadSize:       42,
+	}, false)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *charmStateSuite) TestGetCharmArchiveBySHA384(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	objectStoreUUID := s.setCharmWithArchive(c, st, charm.CharmHubSource)
+
+	path, uuid, err := st.GetCharmArchiveBySHA384(context.Background(), "bar", charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(path, gc.Equals, "archive")
+	c.Check(uuid, gc.Equals, objectStoreUUID)
+}
+
+func (s *charmStateSuite) TestGetCharmArchiveBySHA384DifferentSource(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.setCharmWithArchive(c, st, charm.CharmHubSource)
+
+	_, _, err := st.GetCharmArchiveBySHA384(context.Background(), "bar", charm.LocalSource)
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
+func (s *charmStateSuite) TestGetCharmArchiveBySHA384DifferentHash(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.setCharmWithArchive(c, st, charm.CharmHubSource)
+
+	_, _, err := st.GetCharmArchiveBySHA384(context.Background(), "foo", charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
+func (s *charmStateSuite) TestGetCharmArchiveBySHA384PathRemoved(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.setCharmWithArchive(c, st, charm.CharmHubSource)
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM object_store_metadata_path WHERE path = 'archive'`)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, _, err = st.GetCharmArchiveBySHA384(context.Background(), "bar", charm.CharmHubSource)
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
+// setCharmWithArchive sets a charm from the given source, whose archive is
+// stored in the object store at "archive", with the SHA384 hash "bar".
+func (s *charmStateSuite) setCharmWithArchive(c *gc.C, st *State, source charm.CharmSource) objectstore.UUID {
+	objectStoreUUID := s.createObjectStoreBlob(c, "archive")
+
+	ch := charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "foo",
+		},
+		Manifest:        s.minimalManifest(c),
+		Source:          source,
+		Revision:        42,
+		ReferenceName:   "foo",
+		Hash:            "hash",
+		ArchivePath:     "archive",
+		ObjectStoreUUID: objectStoreUUID,
+		Version:         "deadbeef",
+		Architecture:    architecture.AMD64,
+	}
+	var info *charm.DownloadInfo
+	if source == charm.CharmHubSource {
+		info = &charm.DownloadInfo{
+			Provenance:         charm.ProvenanceDownload,
+			CharmhubIdentifier: "ident",
+			DownloadURL:        "https://example.com/foo",
+			DownloadSize:       42,
+		}
+	}
+	_, _, err := st.SetCharm(context.Background(), ch, info, false)
+	c.Assert(err, jc.ErrorIsNil)
+	return objectStoreUUID
+}
+
 func (s *charmStateSuite) TestGetCharmIDWithNoCharm(c *gc.C) {
 	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
 
@@ -3443,6 +3604,58 @@ func (s *charmStateSuite) TestResolveMigratingUploaded(c *gc.C) {
 	c.Check(available, gc.Equals, true)
 }
 
+func (s *charmStateSuite) TestResolveMigratingUploadedCharmSourceConflict(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	objectStoreUUID := s.createObjectStoreBlob(c, "archive")
+
+	_, _, err := st.SetCharm(context.Background(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "foo",
+		},
+		Manifest:        s.minimalManifest(c),
+		Source:          charm.CharmHubSource,
+		Revision:        42,
+		ReferenceName:   "foo",
+		Hash:            "hash",
+		ArchivePath:     "archive",
+		ObjectStoreUUID: objectStoreUUID,
+		Version:         "deadbeef",
+		Architecture:    architecture.AMD64,
+	}, &charm.DownloadInfo{
+		Provenance:         charm.ProvenanceDownload,
+		CharmhubIdentifier: "ident",
+		DownloadURL:        "https://example.com/foo",
+		DownloadSize:       42,
+	}, false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	id, _, err := st.SetCharm(context.Background(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "bar",
+		},
+		Manifest:      s.minimalManifest(c),
+		Source:        charm.LocalSource,
+		Revision:      1,
+		ReferenceName: "bar",
+		Hash:          "hash",
+		Version:       "deadbeef",
+	}, nil, false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.ResolveMigratingUploadedCharm(context.Background(), id, charm.ResolvedMigratingUploadedCharm{
+		ObjectStoreUUID: objectStoreUUID,
+		ArchivePath:     "archive",
+		Hash:            "hash",
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmSourceConflict)
+
+	// The charm was left unresolved.
+	available, err := st.IsCharmAvailable(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(available, jc.IsFalse)
+}
+
 func (s *charmStateSuite) TestGetLatestPendingCharmhubCharmNotFound(c *gc.C) {
 	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
 
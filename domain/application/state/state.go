@@ -40,6 +40,20 @@ func NewState(factory database.TxnRunnerFactory, clock clock.Clock, logger logge
 	}
 }
 
+// readTxn runs the input function in a read-only transaction, so that reads
+// do not take the write lock and contend with writers. The function must not
+// execute any statement that writes.
+func (s *State) readTxn(ctx context.Context, fn func(context.Context, *sqlair.TX) error) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if r, ok := db.(database.ReadOnlyTxnRunner); ok {
+		return r.ReadTxn(ctx, fn)
+	}
+	return db.Txn(ctx, fn)
+}
+
 func (s *State) checkCharmExists(ctx context.Context, tx *sqlair.TX, id charmID) error {
 	selectQuery := `
 SELECT &charmID.*
@@ -234,6 +248,42 @@ func (s *State) setCharmState(
 		return errors.Errorf("inserting charm hash: %w", err)
 	}
 
+	if err := s.checkCharmArchiveSource(ctx, tx, id); err != nil {
+		return errors.Capture(err)
+	}
+
+	return nil
+}
+
+// checkCharmArchiveSource returns an error satisfying
+// [applicationerrors.CharmSourceConflict] if the charm is a local charm, and
+// its archive path is used by a charm from charmhub. It is called in the
+// transaction that writes the archive path, after it has been written, so
+// that concurrent writers can't both pass the check.
+func (s *State) checkCharmArchiveSource(ctx context.Context, tx *sqlair.TX, id corecharm.ID) error {
+	ident := charmID{UUID: id}
+
+	query := `
+SELECT COUNT(*) AS &countResult.count
+FROM charm AS c
+JOIN charm AS other ON other.archive_path = c.archive_path AND other.uuid != c.uuid
+WHERE c.uuid = $charmID.uuid
+AND c.source_id = 0
+AND other.source_id = 1
+AND c.archive_path != ''
+`
+	stmt, err := s.Prepare(query, ident, countResult{})
+	if err != nil {
+		return errors.Errorf("preparing query: %w", err)
+	}
+
+	var result countResult
+	if err := tx.Query(ctx, stmt, ident).Get(&result); err != nil {
+		return errors.Errorf("checking charm archive source: %w", err)
+	}
+	if result.Count > 0 {
+		return errors.Errorf("local charm %q uses the archive of a charmhub charm: %w", id, applicationerrors.CharmSourceConflict)
+	}
 	return nil
 }
 
@@ -630,6 +630,19 @@ type charmArchivePath struct {
 	ArchivePath string `db:"archive_path"`
 }
 
+// charmArchiveLocation is used to get the location of a charm archive in the
+// object store.
+type charmArchiveLocation struct {
+	ArchivePath     string `db:"archive_path"`
+	ObjectStoreUUID string `db:"object_store_uuid"`
+}
+
+// charmArchiveSHA384 is used to look up charm archives by their hash.
+type charmArchiveSHA384 struct {
+	SHA384   string `db:"sha_384"`
+	SourceID int    `db:"source_id"`
+}
+
 // charmArchivePathAndHash is used to get the archive path and hash of a charm.
 type charmArchivePathAndHash struct {
 	ArchivePath string `db:"archive_path"`
@@ -789,6 +802,33 @@ type setApplicationEndpoint struct {
 	Space         *string                   `db:"space"`
 }
 
+// endpointSpaceName holds the name of an endpoint, and the name of the
+// space it is effectively bound to.
+type endpointSpaceName struct {
+	EndpointName string `db:"endpoint_name"`
+	SpaceName    string `db:"space_name"`
+}
+
+// endpointBinding holds the explicit binding of an application endpoint,
+// which is identified by the charm relation or extra binding it was created
+// from. An empty space name means the endpoint follows the application
+// default space.
+type endpointBinding struct {
+	ApplicationID     coreapplication.ID `db:"application_uuid"`
+	CharmEndpointUUID string             `db:"charm_endpoint_uuid"`
+	EndpointName      string             `db:"endpoint_name"`
+	SpaceName         string             `db:"space_name"`
+}
+
+type endpointBindingChange struct {
+	UUID            string             `db:"uuid"`
+	ApplicationUUID coreapplication.ID `db:"application_uuid"`
+	EndpointName    string             `db:"endpoint_name"`
+	OldSpaceName    sql.NullString     `db:"old_space_name"`
+	NewSpaceName    sql.NullString     `db:"new_space_name"`
+	ChangedAt       time.Time          `db:"changed_at"`
+}
+
 type setApplicationExtraEndpoint struct {
 	ApplicationID coreapplication.ID `db:"application_uuid"`
 	RelationUUID  string             `db:"charm_extra_binding_uuid"`
@@ -4,6 +4,8 @@
 package application
 
 import (
+	"time"
+
 	"github.com/juju/collections/set"
 
 	"github.com/juju/juju/core/application"
@@ -338,6 +340,22 @@ type ExposedEndpoint struct {
 	ExposeToCIDRs set.Strings
 }
 
+// BindingChange records a change to the space an application endpoint is
+// bound to.
+type BindingChange struct {
+	// Endpoint is the name of the endpoint whose binding changed. An empty
+	// name is the application default binding.
+	Endpoint string
+	// OldSpace is the name of the space the endpoint was bound to before the
+	// change. It is empty if the endpoint had no explicit binding.
+	OldSpace network.SpaceName
+	// NewSpace is the name of the space the endpoint is bound to after the
+	// change. It is empty if the endpoint follows the application default.
+	NewSpace network.SpaceName
+	// ChangedAt is the time the binding changed.
+	ChangedAt time.Time
+}
+
 // ExportApplication contains parameters for exporting an application.
 type ExportApplication struct {
 	UUID                 application.ID
@@ -5,7 +5,10 @@ package service
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 
+	"github.com/juju/clock"
 	"golang.org/x/crypto/acme/autocert"
 
 	coreerrors "github.com/juju/juju/core/errors"
@@ -23,19 +26,29 @@ type State interface {
 
 	// Delete implements autocert.Cache.Delete.
 	Delete(ctx context.Context, name string) error
+
+	// GetAll returns the contents of every autocert in the cache, keyed by
+	// name.
+	GetAll(ctx context.Context) (map[string][]byte, error)
+
+	// DeleteMany removes the named autocerts from the cache. Names that are
+	// not in the cache are ignored.
+	DeleteMany(ctx context.Context, names []string) error
 }
 
 // Service provides the API for working with autocert cache. This service
 // implements autocert.Cache interface.
 type Service struct {
 	st     State
+	clock  clock.Clock
 	logger logger.Logger
 }
 
 // NewService returns a new service reference wrapping the input state.
-func NewService(st State, logger logger.Logger) *Service {
+func NewService(st State, clock clock.Clock, logger logger.Logger) *Service {
 	return &Service{
 		st:     st,
+		clock:  clock,
 		logger: logger,
 	}
 }
@@ -61,3 +74,55 @@ func (s *Service) Delete(ctx context.Context, name string) error {
 	s.logger.Tracef(ctx, "removing autocert %s from the autocert cache", name)
 	return s.st.Delete(ctx, name)
 }
+
+// PruneExpired removes the autocerts whose certificate has expired from the
+// cache, returning the number of entries removed. Entries that don't hold a
+// certificate, such as the ACME account key, are never removed.
+func (s *Service) PruneExpired(ctx context.Context) (int, error) {
+	autocerts, err := s.st.GetAll(ctx)
+	if err != nil {
+		return 0, errors.Errorf("getting autocerts: %w", err)
+	}
+
+	now := s.clock.Now()
+	var expired []string
+	for name, data := range autocerts {
+		cert, ok := leafCertificate(data)
+		if !ok {
+			continue
+		}
+		if now.After(cert.NotAfter) {
+			s.logger.Debugf(ctx, "pruning autocert %s which expired at %v", name, cert.NotAfter)
+			expired = append(expired, name)
+		}
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	if err := s.st.DeleteMany(ctx, expired); err != nil {
+		return 0, errors.Errorf("deleting expired autocerts: %w", err)
+	}
+	return len(expired), nil
+}
+
+// leafCertificate returns the first certificate in the PEM encoded autocert
+// data. The autocert cache stores the private key followed by the
+// certificate chain, leaf first.
+func leafCertificate(data []byte) (*x509.Certificate, bool) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, false
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, false
+		}
+		return cert, true
+	}
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination service_mock_test.go github.com/juju/juju/domain/autocert/service State
+//	mockgen -typed -package service -destination domain/autocert/service/service_mock_test.go github.com/juju/juju/domain/autocert/service State
 //
 
 // Package service is a generated GoMock package.
@@ -77,6 +77,44 @@ func (c *MockStateDeleteCall) DoAndReturn(f func(context.Context, string) error)
 	return c
 }
 
+// DeleteMany mocks base method.
+func (m *MockState) DeleteMany(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockStateMockRecorder) DeleteMany(arg0, arg1 any) *MockStateDeleteManyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockState)(nil).DeleteMany), arg0, arg1)
+	return &MockStateDeleteManyCall{Call: call}
+}
+
+// MockStateDeleteManyCall wrap *gomock.Call
+type MockStateDeleteManyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteManyCall) Return(arg0 error) *MockStateDeleteManyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteManyCall) Do(f func(context.Context, []string) error) *MockStateDeleteManyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteManyCall) DoAndReturn(f func(context.Context, []string) error) *MockStateDeleteManyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Get mocks base method.
 func (m *MockState) Get(arg0 context.Context, arg1 string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +154,45 @@ func (c *MockStateGetCall) DoAndReturn(f func(context.Context, string) ([]byte,
 	return c
 }
 
+// GetAll mocks base method.
+func (m *MockState) GetAll(arg0 context.Context) (map[string][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].(map[string][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStateMockRecorder) GetAll(arg0 any) *MockStateGetAllCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockState)(nil).GetAll), arg0)
+	return &MockStateGetAllCall{Call: call}
+}
+
+// MockStateGetAllCall wrap *gomock.Call
+type MockStateGetAllCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetAllCall) Return(arg0 map[string][]byte, arg1 error) *MockStateGetAllCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetAllCall) Do(f func(context.Context) (map[string][]byte, error)) *MockStateGetAllCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetAllCall) DoAndReturn(f func(context.Context) (map[string][]byte, error)) *MockStateGetAllCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Put mocks base method.
 func (m *MockState) Put(arg0 context.Context, arg1 string, arg2 []byte) error {
 	m.ctrl.T.Helper()
@@ -5,7 +5,17 @@ package service
 
 import (
 	"context"
-
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gomock "go.uber.org/mock/gomock"
@@ -39,7 +49,7 @@ func (s *serviceSuite) TestCheckCacheMiss(c *gc.C) {
 	certName := "test-cert-name"
 	s.state.EXPECT().Get(gomock.Any(), certName).Return(nil, errors.Errorf("autocert %s: %w", certName, coreerrors.NotFound))
 
-	svc := NewService(s.state, loggertesting.WrapCheckLog(c))
+	svc := NewService(s.state, clock.WallClock, loggertesting.WrapCheckLog(c))
 
 	certbytes, err := svc.Get(context.Background(), certName)
 	c.Assert(certbytes, gc.IsNil)
@@ -52,9 +62,79 @@ func (s *serviceSuite) TestCheckAnyError(c *gc.C) {
 	certName := "test-cert-name"
 	s.state.EXPECT().Get(gomock.Any(), certName).Return(nil, errors.New("state error"))
 
-	svc := NewService(s.state, loggertesting.WrapCheckLog(c))
+	svc := NewService(s.state, clock.WallClock, loggertesting.WrapCheckLog(c))
 
 	certbytes, err := svc.Get(context.Background(), certName)
 	c.Assert(certbytes, gc.IsNil)
 	c.Assert(err, gc.ErrorMatches, "state error")
 }
+
+func (s *serviceSuite) TestPruneExpired(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	s.state.EXPECT().GetAll(gomock.Any()).Return(map[string][]byte{
+		"expired.example.com": autocertData(c, now.Add(-time.Hour)),
+		"current.example.com": autocertData(c, now.Add(time.Hour)),
+		"acme_account+key":    pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("key")}),
+		"invalid.example.com": []byte("not pem"),
+		"corrupt.example.com": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")}),
+	}, nil)
+	s.state.EXPECT().DeleteMany(gomock.Any(), []string{"expired.example.com"}).Return(nil)
+
+	svc := NewService(s.state, testclock.NewClock(now), loggertesting.WrapCheckLog(c))
+
+	pruned, err := svc.PruneExpired(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pruned, gc.Equals, 1)
+}
+
+func (s *serviceSuite) TestPruneExpiredNothingExpired(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	s.state.EXPECT().GetAll(gomock.Any()).Return(map[string][]byte{
+		"current.example.com": autocertData(c, now.Add(time.Hour)),
+	}, nil)
+
+	svc := NewService(s.state, testclock.NewClock(now), loggertesting.WrapCheckLog(c))
+
+	pruned, err := svc.PruneExpired(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pruned, gc.Equals, 0)
+}
+
+func (s *serviceSuite) TestPruneExpiredDeleteError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	s.state.EXPECT().GetAll(gomock.Any()).Return(map[string][]byte{
+		"expired.example.com": autocertData(c, now.Add(-time.Hour)),
+	}, nil)
+	s.state.EXPECT().DeleteMany(gomock.Any(), []string{"expired.example.com"}).Return(errors.New("boom"))
+
+	svc := NewService(s.state, testclock.NewClock(now), loggertesting.WrapCheckLog(c))
+
+	_, err := svc.PruneExpired(context.Background())
+	c.Assert(err, gc.ErrorMatches, "deleting expired autocerts: boom")
+}
+
+// autocertData returns autocert cache data, a private key followed by a
+// certificate, for a certificate that expires at notAfter.
+func autocertData(c *gc.C, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, jc.ErrorIsNil)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	c.Assert(err, jc.ErrorIsNil)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+}
@@ -136,3 +136,58 @@ WHERE  name = $dbAutocert.name`
 
 	return errors.Capture(err)
 }
+
+// GetAll returns the contents of every autocert in the cache, keyed by name.
+func (st *State) GetAll(ctx context.Context) (map[string][]byte, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT (name, data) AS (&dbAutocert.*)
+FROM   autocert_cache`, dbAutocert{})
+	if err != nil {
+		return nil, errors.Errorf("preparing autocert select statement: %w", err)
+	}
+
+	var autocerts []dbAutocert
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&autocerts)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	}); err != nil {
+		return nil, errors.Errorf("querying autocert cache: %w", err)
+	}
+
+	result := make(map[string][]byte, len(autocerts))
+	for _, autocert := range autocerts {
+		result[autocert.Name] = []byte(autocert.Data)
+	}
+	return result, nil
+}
+
+// DeleteMany removes the named autocerts from the cache. Names that are not
+// in the cache are ignored.
+func (st *State) DeleteMany(ctx context.Context, autocertNames []string) error {
+	if len(autocertNames) == 0 {
+		return nil
+	}
+
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`DELETE FROM autocert_cache WHERE name IN ($names[:])`, names{})
+	if err != nil {
+		return errors.Errorf("preparing autocert cache delete statement: %w", err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, names(autocertNames)).Run()
+	})
+	return errors.Capture(err)
+}
@@ -280,3 +280,45 @@ Hn+GmxZA
 	_, err = st.Get(context.Background(), "cert1")
 	c.Assert(err, jc.ErrorIs, autocerterrors.NotFound)
 }
+
+func (s *stateSuite) TestGetAll(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.Put(context.Background(), "cert1", []byte("data1"))
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.Put(context.Background(), "cert2", []byte("data2"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	autocerts, err := st.GetAll(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(autocerts, jc.DeepEquals, map[string][]byte{
+		"cert1": []byte("data1"),
+		"cert2": []byte("data2"),
+	})
+}
+
+func (s *stateSuite) TestGetAllEmpty(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	autocerts, err := st.GetAll(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(autocerts, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestDeleteMany(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	for _, name := range []string{"cert1", "cert2", "cert3"} {
+		err := st.Put(context.Background(), name, []byte(name))
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	err := st.DeleteMany(context.Background(), []string{"cert1", "cert3", "missing"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	autocerts, err := st.GetAll(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(autocerts, jc.DeepEquals, map[string][]byte{
+		"cert2": []byte("cert2"),
+	})
+}
@@ -18,3 +18,6 @@ type dbAutocert struct {
 	// autocert_cache_encoding table.
 	Encoding int `db:"encoding"`
 }
+
+// names is a list of autocert names, used for bulk operations.
+type names []string
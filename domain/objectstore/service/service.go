@@ -54,6 +54,10 @@ type State interface {
 	// RemoveMetadata removes the specified path for the persistence metadata.
 	RemoveMetadata(ctx context.Context, path string) error
 
+	// GetUsage returns the total size, in bytes, of the objects in the
+	// object store.
+	GetUsage(ctx context.Context) (int64, error)
+
 	// InitialWatchStatement returns the table and the initial watch statement
 	// for the persistence metadata.
 	InitialWatchStatement() (string, string)
@@ -158,6 +162,16 @@ func (s *Service) ListMetadata(ctx context.Context) ([]objectstore.Metadata, err
 	return m, nil
 }
 
+// Usage returns the total size, in bytes, of the objects in the object
+// store. Objects stored under more than one path are only counted once.
+func (s *Service) Usage(ctx context.Context) (int64, error) {
+	usage, err := s.st.GetUsage(ctx)
+	if err != nil {
+		return 0, errors.Errorf("retrieving usage: %w", err)
+	}
+	return usage, nil
+}
+
 // PutMetadata adds a new specified path for the persistence metadata. If any
 // hash is missing, a [objectstoreerrors.ErrMissingHash] error is returned. It
 // is expected that the caller supplies both hashes or none and they should be
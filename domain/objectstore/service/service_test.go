@@ -18,6 +18,7 @@ import (
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
 	"github.com/juju/juju/core/watcher/watchertest"
 	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -156,6 +157,25 @@ func (s *serviceSuite) TestGetMetadataBySHA256PrefixInvalid(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrInvalidHashPrefix)
 }
 
+func (s *serviceSuite) TestUsage(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUsage(gomock.Any()).Return(int64(666), nil)
+
+	usage, err := NewService(s.state).Usage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.Equals, int64(666))
+}
+
+func (s *serviceSuite) TestUsageError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUsage(gomock.Any()).Return(int64(0), errors.Errorf("boom"))
+
+	_, err := NewService(s.state).Usage(context.Background())
+	c.Assert(err, gc.ErrorMatches, `retrieving usage: boom`)
+}
+
 func (s *serviceSuite) TestListMetadata(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -159,6 +159,45 @@ func (c *MockStateGetMetadataBySHA256PrefixCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// GetUsage mocks base method.
+func (m *MockState) GetUsage(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockStateMockRecorder) GetUsage(arg0 any) *MockStateGetUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockState)(nil).GetUsage), arg0)
+	return &MockStateGetUsageCall{Call: call}
+}
+
+// MockStateGetUsageCall wrap *gomock.Call
+type MockStateGetUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUsageCall) Return(arg0 int64, arg1 error) *MockStateGetUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUsageCall) Do(f func(context.Context) (int64, error)) *MockStateGetUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUsageCall) DoAndReturn(f func(context.Context) (int64, error)) *MockStateGetUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatement mocks base method.
 func (m *MockState) InitialWatchStatement() (string, string) {
 	m.ctrl.T.Helper()
@@ -162,6 +162,34 @@ FROM v_object_store_metadata`, dbMetadata{})
 	return transform.Slice(metadata, decodeDbMetadata), nil
 }
 
+// GetUsage returns the total size, in bytes, of the objects in the object
+// store. Objects stored under more than one path are only counted once.
+func (s *State) GetUsage(ctx context.Context) (int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var usage dbUsage
+	stmt, err := s.Prepare(`
+SELECT COALESCE(SUM(size), 0) AS &dbUsage.size
+FROM object_store_metadata`, usage)
+	if err != nil {
+		return 0, errors.Errorf("preparing select usage statement: %w", err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt).Get(&usage); err != nil {
+			return errors.Errorf("retrieving usage: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return usage.Size, nil
+}
+
 // PutMetadata adds a new specified path for the persistence metadata.
 func (s *State) PutMetadata(ctx context.Context, metadata coreobjectstore.Metadata) (coreobjectstore.UUID, error) {
 	db, err := s.DB()
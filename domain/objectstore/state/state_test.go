@@ -474,6 +474,46 @@ func (s *stateSuite) TestListMetadata(c *gc.C) {
 	c.Check(metadatas[0], gc.DeepEquals, metadata)
 }
 
+func (s *stateSuite) TestGetUsage(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.PutMetadata(context.Background(), coreobjectstore.Metadata{
+		SHA256: "sha256-1",
+		SHA384: "sha384-1",
+		Path:   "blah-foo-1",
+		Size:   666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = st.PutMetadata(context.Background(), coreobjectstore.Metadata{
+		SHA256: "sha256-2",
+		SHA384: "sha384-2",
+		Path:   "blah-foo-2",
+		Size:   42,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The same object stored under another path is only counted once.
+	_, err = st.PutMetadata(context.Background(), coreobjectstore.Metadata{
+		SHA256: "sha256-2",
+		SHA384: "sha384-2",
+		Path:   "blah-foo-3",
+		Size:   42,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	usage, err := st.GetUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.Equals, int64(708))
+}
+
+func (s *stateSuite) TestGetUsageNoRows(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	usage, err := st.GetUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.Equals, int64(0))
+}
+
 func (s *stateSuite) TestListMetadataNoRows(c *gc.C) {
 	st := NewState(s.TxnRunnerFactory())
 
@@ -29,6 +29,12 @@ type sha256IdentPrefix struct {
 	SHA256Prefix string `db:"sha_256_prefix"`
 }
 
+// dbUsage represents the total size of the objects in the object store.
+type dbUsage struct {
+	// Size is the total size of the objects.
+	Size int64 `db:"size"`
+}
+
 // dbMetadataPath represents the database serialisable metadata path for an
 // object.
 type dbMetadataPath struct {
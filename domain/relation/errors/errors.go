@@ -58,6 +58,10 @@ const (
 	// that already exists between applications.
 	RelationAlreadyExists = errors.ConstError("already exists")
 
+	// RelationLifeTransitionNotValid describes an error that occurs when a
+	// relation cannot be moved to the requested life from its current life.
+	RelationLifeTransitionNotValid = errors.ConstError("relation life transition not valid")
+
 	// RelationNotAlive describes an error that occurs when trying to update a
 	// relation that is not alive.
 	RelationNotAlive = errors.ConstError("relation is not alive")
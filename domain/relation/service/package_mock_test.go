@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/relation/service State,WatcherFactory
+//	mockgen -typed -package service -destination domain/relation/service/package_mock_test.go github.com/juju/juju/domain/relation/service State,WatcherFactory
 //
 
 // Package service is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	application "github.com/juju/juju/core/application"
+	life "github.com/juju/juju/core/life"
 	relation "github.com/juju/juju/core/relation"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
@@ -318,6 +319,45 @@ func (c *MockStateGetApplicationIDByNameCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// GetCompatibleEndpointPairs mocks base method.
+func (m *MockState) GetCompatibleEndpointPairs(arg0 context.Context, arg1, arg2 relation0.CandidateEndpointIdentifier) ([]relation0.EndpointPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompatibleEndpointPairs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]relation0.EndpointPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompatibleEndpointPairs indicates an expected call of GetCompatibleEndpointPairs.
+func (mr *MockStateMockRecorder) GetCompatibleEndpointPairs(arg0, arg1, arg2 any) *MockStateGetCompatibleEndpointPairsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompatibleEndpointPairs", reflect.TypeOf((*MockState)(nil).GetCompatibleEndpointPairs), arg0, arg1, arg2)
+	return &MockStateGetCompatibleEndpointPairsCall{Call: call}
+}
+
+// MockStateGetCompatibleEndpointPairsCall wrap *gomock.Call
+type MockStateGetCompatibleEndpointPairsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetCompatibleEndpointPairsCall) Return(arg0 []relation0.EndpointPair, arg1 error) *MockStateGetCompatibleEndpointPairsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetCompatibleEndpointPairsCall) Do(f func(context.Context, relation0.CandidateEndpointIdentifier, relation0.CandidateEndpointIdentifier) ([]relation0.EndpointPair, error)) *MockStateGetCompatibleEndpointPairsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetCompatibleEndpointPairsCall) DoAndReturn(f func(context.Context, relation0.CandidateEndpointIdentifier, relation0.CandidateEndpointIdentifier) ([]relation0.EndpointPair, error)) *MockStateGetCompatibleEndpointPairsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetGoalStateRelationDataForApplication mocks base method.
 func (m *MockState) GetGoalStateRelationDataForApplication(arg0 context.Context, arg1 application.ID) ([]relation0.GoalStateRelationData, error) {
 	m.ctrl.T.Helper()
@@ -592,6 +632,45 @@ func (c *MockStateGetRelationApplicationSettingsCall) DoAndReturn(f func(context
 	return c
 }
 
+// GetRelationDataSize mocks base method.
+func (m *MockState) GetRelationDataSize(arg0 context.Context, arg1 relation.UUID) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationDataSize", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationDataSize indicates an expected call of GetRelationDataSize.
+func (mr *MockStateMockRecorder) GetRelationDataSize(arg0, arg1 any) *MockStateGetRelationDataSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationDataSize", reflect.TypeOf((*MockState)(nil).GetRelationDataSize), arg0, arg1)
+	return &MockStateGetRelationDataSizeCall{Call: call}
+}
+
+// MockStateGetRelationDataSizeCall wrap *gomock.Call
+type MockStateGetRelationDataSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRelationDataSizeCall) Return(arg0 map[string]int, arg1 error) *MockStateGetRelationDataSizeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRelationDataSizeCall) Do(f func(context.Context, relation.UUID) (map[string]int, error)) *MockStateGetRelationDataSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRelationDataSizeCall) DoAndReturn(f func(context.Context, relation.UUID) (map[string]int, error)) *MockStateGetRelationDataSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRelationDetails mocks base method.
 func (m *MockState) GetRelationDetails(arg0 context.Context, arg1 relation.UUID) (relation0.RelationDetailsResult, error) {
 	m.ctrl.T.Helper()
@@ -709,6 +788,45 @@ func (c *MockStateGetRelationEndpointUUIDCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetRelationLife mocks base method.
+func (m *MockState) GetRelationLife(arg0 context.Context, arg1 relation.UUID) (life.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationLife", arg0, arg1)
+	ret0, _ := ret[0].(life.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationLife indicates an expected call of GetRelationLife.
+func (mr *MockStateMockRecorder) GetRelationLife(arg0, arg1 any) *MockStateGetRelationLifeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationLife", reflect.TypeOf((*MockState)(nil).GetRelationLife), arg0, arg1)
+	return &MockStateGetRelationLifeCall{Call: call}
+}
+
+// MockStateGetRelationLifeCall wrap *gomock.Call
+type MockStateGetRelationLifeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRelationLifeCall) Return(arg0 life.Value, arg1 error) *MockStateGetRelationLifeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRelationLifeCall) Do(f func(context.Context, relation.UUID) (life.Value, error)) *MockStateGetRelationLifeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRelationLifeCall) DoAndReturn(f func(context.Context, relation.UUID) (life.Value, error)) *MockStateGetRelationLifeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRelationUUIDByID mocks base method.
 func (m *MockState) GetRelationUUIDByID(arg0 context.Context, arg1 int) (relation.UUID, error) {
 	m.ctrl.T.Helper()
@@ -1215,6 +1333,44 @@ func (c *MockStateSetRelationApplicationSettingsCall) DoAndReturn(f func(context
 	return c
 }
 
+// SetRelationDying mocks base method.
+func (m *MockState) SetRelationDying(arg0 context.Context, arg1 relation.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRelationDying", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRelationDying indicates an expected call of SetRelationDying.
+func (mr *MockStateMockRecorder) SetRelationDying(arg0, arg1 any) *MockStateSetRelationDyingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRelationDying", reflect.TypeOf((*MockState)(nil).SetRelationDying), arg0, arg1)
+	return &MockStateSetRelationDyingCall{Call: call}
+}
+
+// MockStateSetRelationDyingCall wrap *gomock.Call
+type MockStateSetRelationDyingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetRelationDyingCall) Return(arg0 error) *MockStateSetRelationDyingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetRelationDyingCall) Do(f func(context.Context, relation.UUID) error) *MockStateSetRelationDyingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetRelationDyingCall) DoAndReturn(f func(context.Context, relation.UUID) error) *MockStateSetRelationDyingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetRelationUnitSettings mocks base method.
 func (m *MockState) SetRelationUnitSettings(arg0 context.Context, arg1 relation.UnitUUID, arg2 map[string]string) error {
 	m.ctrl.T.Helper()
@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/logger"
 	corerelation "github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/unit"
@@ -106,6 +107,17 @@ type State interface {
 	// GetApplicationIDByName returns the application ID of the given application.
 	GetApplicationIDByName(ctx context.Context, appName string) (application.ID, error)
 
+	// GetCompatibleEndpointPairs returns every pair of endpoints which could
+	// be used to relate the two given endpoint identifiers.
+	//
+	// The following error types can be expected to be returned:
+	//   - [relationerrors.RelationEndpointNotFound] is returned if no endpoint
+	//     can be found for one of the identifiers.
+	GetCompatibleEndpointPairs(
+		ctx context.Context,
+		epIdentifier1, epIdentifier2 relation.CandidateEndpointIdentifier,
+	) ([]relation.EndpointPair, error)
+
 	// GetMapperDataForWatchLifeSuspendedStatus returns data needed to evaluate a relation
 	// uuid as part of WatchLifeSuspendedStatus eventmapper.
 	//
@@ -163,6 +175,21 @@ type State interface {
 		applicationID application.ID,
 	) (map[string]string, error)
 
+	// GetRelationDataSize returns the size in bytes of the relation settings
+	// held by each unit in scope of the relation, keyed on unit name.
+	//
+	// The following error types can be expected to be returned:
+	//   - [relationerrors.RelationNotFound] is returned if the relation UUID
+	//     is not found.
+	GetRelationDataSize(ctx context.Context, relationUUID corerelation.UUID) (map[string]int, error)
+
+	// GetRelationLife returns the life of the relation with the given UUID.
+	//
+	// The following error types can be expected to be returned:
+	//   - [relationerrors.RelationNotFound] is returned if the relation UUID
+	//     is not found.
+	GetRelationLife(ctx context.Context, relationUUID corerelation.UUID) (life.Value, error)
+
 	// GetRelationUUIDByID returns the relation UUID based on the relation ID.
 	//
 	// The following error types can be expected to be returned:
@@ -259,6 +286,14 @@ type State interface {
 	//     found.
 	LeaveScope(ctx context.Context, relationUnitUUID corerelation.UnitUUID) error
 
+	// SetRelationDying advances the life of the relation with the given UUID
+	// from alive to dying. A relation that is not alive is left unchanged.
+	//
+	// The following error types can be expected to be returned:
+	//   - [relationerrors.RelationNotFound] is returned if the relation UUID
+	//     is not found.
+	SetRelationDying(ctx context.Context, relationUUID corerelation.UUID) error
+
 	// SetRelationApplicationSettings records settings for a specific application
 	// relation combination.
 	//
@@ -444,6 +479,37 @@ func (s *Service) AddRelation(ctx context.Context, ep1, ep2 string) (relation.En
 	return s.st.AddRelation(ctx, idep1, idep2)
 }
 
+// ResolveRelationEndpoints returns all the pairs of endpoints which could be
+// used to relate the two applications. Each application may be given in the
+// form <application>[:<endpoint>]. Unlike AddRelation, several matches are
+// not an error, which allows the caller to choose between them.
+//
+// The following error types can be expected to be returned:
+//   - [relationerrors.CompatibleEndpointsNotFound] is returned if the
+//     applications have no compatible endpoints.
+//   - [relationerrors.RelationEndpointNotFound] is returned if no endpoint can
+//     be found for one of the applications.
+func (s *Service) ResolveRelationEndpoints(ctx context.Context, app1, app2 string) ([]relation.EndpointPair, error) {
+	idep1, err := relation.NewCandidateEndpointIdentifier(app1)
+	if err != nil {
+		return nil, errors.Errorf("parsing endpoint identifier %q: %w", app1, err)
+	}
+	idep2, err := relation.NewCandidateEndpointIdentifier(app2)
+	if err != nil {
+		return nil, errors.Errorf("parsing endpoint identifier %q: %w", app2, err)
+	}
+
+	pairs, err := s.st.GetCompatibleEndpointPairs(ctx, idep1, idep2)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if len(pairs) == 0 {
+		return nil, errors.Errorf("resolving endpoints for %q and %q: %w",
+			app1, app2, relationerrors.CompatibleEndpointsNotFound)
+	}
+	return pairs, nil
+}
+
 // ApplicationRelationsInfo returns all EndpointRelationData for an application.
 //
 // The following error types can be expected to be returned:
@@ -521,6 +587,48 @@ func (s *Service) EnterScope(
 	return nil
 }
 
+// DestroyRelation advances the life of the relation with the given UUID to
+// dying. Only an alive relation can be destroyed, unless force is true, in
+// which case a relation that is already dying or dead is left as it is. Once
+// the relation is dying, units can no longer enter its scope, so no further
+// subordinate units are created for it by [Service.EnterScope].
+//
+// The following error types can be expected to be returned:
+//   - [relationerrors.RelationUUIDNotValid] if the relation UUID is not valid.
+//   - [relationerrors.RelationNotFound] if the relation cannot be found.
+//   - [relationerrors.RelationLifeTransitionNotValid] if the relation is not
+//     alive and force is false.
+func (s *Service) DestroyRelation(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+	force bool,
+) error {
+	if err := relationUUID.Validate(); err != nil {
+		return errors.Errorf(
+			"%w:%w", relationerrors.RelationUUIDNotValid, err)
+	}
+
+	current, err := s.st.GetRelationLife(ctx, relationUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if current != life.Alive {
+		if force {
+			// The relation is already on its way out; there is nothing to
+			// advance.
+			return nil
+		}
+		return errors.Errorf(
+			"relation %q is %s: %w", relationUUID, current, relationerrors.RelationLifeTransitionNotValid)
+	}
+
+	if err := s.st.SetRelationDying(ctx, relationUUID); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
 // GetAllRelationDetails return RelationDetailResults of all relation for the current model.
 func (s *Service) GetAllRelationDetails(ctx context.Context) ([]relation.RelationDetailsResult, error) {
 	return s.st.GetAllRelationDetails(ctx)
@@ -544,7 +652,9 @@ func (s *Service) GetGoalStateRelationDataForApplication(
 	return s.st.GetGoalStateRelationDataForApplication(ctx, applicationID)
 }
 
-// GetRelationDetails returns RelationDetails for the given relationID.
+// GetRelationDetails returns RelationDetails for the given relationID. The
+// details include both endpoints of the relation, with their interface, role
+// and scope.
 //
 // The following error types can be expected to be returned:
 //   - [relationerrors.RelationNotFound] is returned if the relation UUID
@@ -692,6 +802,28 @@ func (s *Service) GetRelationUnitSettings(
 	return s.st.GetRelationUnitSettings(ctx, relationUnitUUID)
 }
 
+// GetRelationDataSize returns the size in bytes of the relation settings
+// held by each unit in scope of the relation with the given UUID, keyed on
+// unit name. It is intended to help find units storing unexpectedly large
+// values in relation data. Units without settings report a size of zero.
+//
+// The following error types can be expected to be returned:
+//   - [relationerrors.RelationUUIDNotValid] is returned if the relation UUID
+//     is not valid.
+//   - [relationerrors.RelationNotFound] is returned if the relation UUID
+//     is not found.
+func (s *Service) GetRelationDataSize(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+) (map[string]int, error) {
+	if err := relationUUID.Validate(); err != nil {
+		return nil, errors.Errorf(
+			"%w:%w", relationerrors.RelationUUIDNotValid, err)
+	}
+
+	return s.st.GetRelationDataSize(ctx, relationUUID)
+}
+
 // GetRelationUUIDByID returns the relation UUID based on the relation ID.
 //
 // The following error types can be expected to be returned:
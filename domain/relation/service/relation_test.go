@@ -69,6 +69,61 @@ func (s *relationServiceSuite) TestAddRelation(c *gc.C) {
 	c.Check(gotEp2, gc.Equals, fakeReturn2)
 }
 
+// TestResolveRelationEndpoints verifies that all the pairs found by the state
+// are returned.
+func (s *relationServiceSuite) TestResolveRelationEndpoints(c *gc.C) {
+	// Arrange
+	defer s.setupMocks(c).Finish()
+
+	expected := []relation.EndpointPair{{
+		Endpoint1: relation.Endpoint{ApplicationName: "application-1"},
+		Endpoint2: relation.Endpoint{ApplicationName: "application-2"},
+	}, {
+		Endpoint1: relation.Endpoint{ApplicationName: "application-1"},
+		Endpoint2: relation.Endpoint{ApplicationName: "application-2"},
+	}}
+	s.state.EXPECT().GetCompatibleEndpointPairs(gomock.Any(), relation.CandidateEndpointIdentifier{
+		ApplicationName: "application-1",
+	}, relation.CandidateEndpointIdentifier{
+		ApplicationName: "application-2",
+	}).Return(expected, nil)
+
+	// Act
+	pairs, err := s.service.ResolveRelationEndpoints(context.Background(), "application-1", "application-2")
+
+	// Assert
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pairs, jc.DeepEquals, expected)
+}
+
+// TestResolveRelationEndpointsNoneCompatible verifies that an empty result
+// from the state is reported as CompatibleEndpointsNotFound.
+func (s *relationServiceSuite) TestResolveRelationEndpointsNoneCompatible(c *gc.C) {
+	// Arrange
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetCompatibleEndpointPairs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	// Act
+	_, err := s.service.ResolveRelationEndpoints(context.Background(), "application-1", "application-2")
+
+	// Assert
+	c.Assert(err, jc.ErrorIs, relationerrors.CompatibleEndpointsNotFound)
+}
+
+// TestResolveRelationEndpointsMalformed verifies that a malformed application
+// identifier is rejected before reaching the state.
+func (s *relationServiceSuite) TestResolveRelationEndpointsMalformed(c *gc.C) {
+	// Arrange
+	defer s.setupMocks(c).Finish()
+
+	// Act
+	_, err := s.service.ResolveRelationEndpoints(context.Background(), "app:ep:is:malformed", "application-2")
+
+	// Assert
+	c.Assert(err, gc.ErrorMatches, "parsing endpoint identifier \"app:ep:is:malformed\": .*")
+}
+
 // TestAddRelationFirstMalformed verifies that AddRelation returns an
 // appropriate error when the first endpoint is malformed.
 func (s *relationServiceSuite) TestAddRelationFirstMalformed(c *gc.C) {
@@ -572,6 +627,21 @@ func (s *relationServiceSuite) TestGetRelationDetailsRelationUUIDNotValid(c *gc.
 	c.Assert(err, jc.ErrorIs, relationerrors.RelationUUIDNotValid, gc.Commentf("(Assert) unexpected error: %v", err))
 }
 
+func (s *relationServiceSuite) TestGetRelationDetailsRelationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+	s.state.EXPECT().GetRelationDetails(gomock.Any(), relationUUID).Return(
+		relation.RelationDetailsResult{}, relationerrors.RelationNotFound)
+
+	// Act:
+	_, err := s.service.GetRelationDetails(context.Background(), relationUUID)
+
+	// Assert:
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
 func (s *relationServiceSuite) TestEnterScope(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -670,6 +740,93 @@ func (s *relationServiceSuite) TestLeaveScopeRelationUnitNameNotValid(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, relationerrors.RelationUUIDNotValid)
 }
 
+func (s *relationServiceSuite) TestDestroyRelation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange.
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+
+	s.state.EXPECT().GetRelationLife(gomock.Any(), relationUUID).Return(corelife.Alive, nil)
+	s.state.EXPECT().SetRelationDying(gomock.Any(), relationUUID).Return(nil)
+
+	// Act.
+	err := s.service.DestroyRelation(context.Background(), relationUUID, false)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *relationServiceSuite) TestDestroyRelationNotAlive(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange.
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+
+	s.state.EXPECT().GetRelationLife(gomock.Any(), relationUUID).Return(corelife.Dying, nil)
+
+	// Act.
+	err := s.service.DestroyRelation(context.Background(), relationUUID, false)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationLifeTransitionNotValid)
+}
+
+func (s *relationServiceSuite) TestDestroyRelationNotAliveForce(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange.
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+
+	s.state.EXPECT().GetRelationLife(gomock.Any(), relationUUID).Return(corelife.Dead, nil)
+
+	// Act.
+	err := s.service.DestroyRelation(context.Background(), relationUUID, true)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *relationServiceSuite) TestDestroyRelationAliveForce(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange.
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+
+	s.state.EXPECT().GetRelationLife(gomock.Any(), relationUUID).Return(corelife.Alive, nil)
+	s.state.EXPECT().SetRelationDying(gomock.Any(), relationUUID).Return(nil)
+
+	// Act.
+	err := s.service.DestroyRelation(context.Background(), relationUUID, true)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *relationServiceSuite) TestDestroyRelationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange.
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+
+	s.state.EXPECT().GetRelationLife(gomock.Any(), relationUUID).Return("", relationerrors.RelationNotFound)
+
+	// Act.
+	err := s.service.DestroyRelation(context.Background(), relationUUID, false)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *relationServiceSuite) TestDestroyRelationUUIDNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Act.
+	err := s.service.DestroyRelation(context.Background(), "bad-relation-uuid", false)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationUUIDNotValid)
+}
+
 func (s *relationServiceSuite) TestGetRelationUnitSettings(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -698,6 +855,35 @@ func (s *relationServiceSuite) TestGetRelationUnitSettingsUnitIDNotValid(c *gc.C
 	c.Assert(err, jc.ErrorIs, relationerrors.RelationUUIDNotValid)
 }
 
+func (s *relationServiceSuite) TestGetRelationDataSize(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+	expectedSizes := map[string]int{
+		"app/0": 42,
+		"app/1": 0,
+	}
+	s.state.EXPECT().GetRelationDataSize(gomock.Any(), relationUUID).Return(expectedSizes, nil)
+
+	// Act:
+	sizes, err := s.service.GetRelationDataSize(context.Background(), relationUUID)
+
+	// Assert:
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sizes, gc.DeepEquals, expectedSizes)
+}
+
+func (s *relationServiceSuite) TestGetRelationDataSizeRelationUUIDNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Act:
+	_, err := s.service.GetRelationDataSize(context.Background(), "bad-uuid")
+
+	// Assert:
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationUUIDNotValid)
+}
+
 func (s *relationServiceSuite) TestGetRelationApplicationSettings(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -126,6 +126,51 @@ func (st *State) AddRelation(ctx context.Context, epIdentifier1, epIdentifier2 r
 	})
 }
 
+// GetCompatibleEndpointPairs returns every pair of endpoints which could be
+// used to relate the two given endpoint identifiers. The pairs are ordered by
+// endpoint names.
+//
+// The following error types can be expected to be returned:
+//   - [relationerrors.RelationEndpointNotFound] is returned if no endpoint can
+//     be found for one of the identifiers.
+func (st *State) GetCompatibleEndpointPairs(
+	ctx context.Context,
+	epIdentifier1, epIdentifier2 relation.CandidateEndpointIdentifier,
+) ([]relation.EndpointPair, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var pairs []relation.EndpointPair
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		matches, err := st.getCompatibleEndpoints(ctx, tx, epIdentifier1, epIdentifier2)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		pairs = make([]relation.EndpointPair, 0, len(matches))
+		for _, m := range matches {
+			pairs = append(pairs, relation.EndpointPair{
+				Endpoint1: m.ep1.toRelationEndpoint(),
+				Endpoint2: m.ep2.toRelationEndpoint(),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting compatible endpoints for %q and %q: %w",
+			epIdentifier1, epIdentifier2, err)
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Endpoint1.Name != pairs[j].Endpoint1.Name {
+			return pairs[i].Endpoint1.Name < pairs[j].Endpoint1.Name
+		}
+		return pairs[i].Endpoint2.Name < pairs[j].Endpoint2.Name
+	})
+	return pairs, nil
+}
+
 // InferRelationUUIDByEndpoints infers the relation based on two endpoints.
 //
 // The following error types can be expected to be returned:
@@ -617,6 +662,137 @@ WHERE  application_uuid = $applicationID.uuid
 	return otherApp, nil
 }
 
+// GetRelationDataSize returns the size in bytes of the relation settings
+// held by each unit in scope of the relation with the given UUID, keyed on
+// unit name. The size of a unit's settings is the sum of the lengths of its
+// keys and values. Units in scope without any settings are reported with a
+// size of zero.
+//
+// The following error types can be expected to be returned:
+//   - [relationerrors.RelationNotFound] is returned if the relation UUID
+//     is not found.
+func (st *State) GetRelationDataSize(ctx context.Context, relUUID corerelation.UUID) (map[string]int, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	id := relationUUID{UUID: relUUID}
+	stmt, err := st.Prepare(`
+SELECT (ru.uuid, u.name) AS (&relationUnitUUIDAndName.*)
+FROM   relation_unit ru
+JOIN   relation_endpoint re ON re.uuid = ru.relation_endpoint_uuid
+JOIN   unit u ON u.uuid = ru.unit_uuid
+WHERE  re.relation_uuid = $relationUUID.uuid
+`, id, relationUnitUUIDAndName{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	sizes := make(map[string]int)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkExistsByUUID(ctx, tx, "relation", relUUID.String())
+		if err != nil {
+			return errors.Errorf("checking relation exists: %w", err)
+		} else if !exists {
+			return relationerrors.RelationNotFound
+		}
+
+		var relUnits []relationUnitUUIDAndName
+		err = tx.Query(ctx, stmt, id).GetAll(&relUnits)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting relation units: %w", err)
+		}
+
+		for _, relUnit := range relUnits {
+			settings, err := st.getRelationUnitSettings(ctx, tx, relUnit.RelationUnitUUID)
+			if err != nil {
+				return errors.Errorf("getting settings for unit %q: %w", relUnit.UnitName, err)
+			}
+			var size int
+			for _, setting := range settings {
+				size += len(setting.Key) + len(setting.Value)
+			}
+			sizes[relUnit.UnitName.String()] = size
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return sizes, nil
+}
+
+// GetRelationLife returns the life of the relation with the given UUID.
+//
+// The following error types can be expected to be returned:
+//   - [relationerrors.RelationNotFound] is returned if the relation UUID
+//     is not found.
+func (st *State) GetRelationLife(ctx context.Context, relationUUID corerelation.UUID) (life.Value, error) {
+	db, err := st.DB()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var relationLife life.Value
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		relationLife, err = st.getLife(ctx, tx, "relation", relationUUID.String())
+		if errors.Is(err, coreerrors.NotFound) {
+			return relationerrors.RelationNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	return relationLife, nil
+}
+
+// SetRelationDying advances the life of the relation with the given UUID
+// from alive to dying. A relation that is not alive is left unchanged.
+//
+// The following error types can be expected to be returned:
+//   - [relationerrors.RelationNotFound] is returned if the relation UUID
+//     is not found.
+func (st *State) SetRelationDying(ctx context.Context, relUUID corerelation.UUID) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	id := relationUUID{
+		UUID: relUUID,
+	}
+	stmt, err := st.Prepare(`
+UPDATE relation
+SET    life_id = 1
+WHERE  uuid = $relationUUID.uuid
+AND    life_id = 0
+`, id)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkExistsByUUID(ctx, tx, "relation", relUUID.String())
+		if err != nil {
+			return errors.Errorf("checking relation exists: %w", err)
+		} else if !exists {
+			return relationerrors.RelationNotFound
+		}
+		if err := tx.Query(ctx, stmt, id).Run(); err != nil {
+			return errors.Errorf("advancing relation life: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return nil
+}
+
 // GetRelationUUIDByID returns the relation UUID based on the relation ID.
 //
 // The following error types can be expected to be returned:
@@ -3153,14 +3329,48 @@ func (st *State) inferEndpoints(
 	tx *sqlair.TX,
 	identifier1, identifier2 relation.CandidateEndpointIdentifier) (Endpoint, Endpoint, error) {
 
+	matches, err := st.getCompatibleEndpoints(ctx, tx, identifier1, identifier2)
+	if err != nil {
+		return Endpoint{}, Endpoint{}, errors.Capture(err)
+	}
+
+	if matchCount := len(matches); matchCount == 0 {
+		return Endpoint{}, Endpoint{}, relationerrors.CompatibleEndpointsNotFound
+	} else if matchCount > 1 {
+		possibleMatches := make([]string, 0, matchCount)
+		for _, match := range matches {
+			possibleMatches = append(possibleMatches, fmt.Sprintf("\"%s %s\"", match.ep1, match.ep2))
+		}
+		return Endpoint{}, Endpoint{}, errors.Errorf("%w: %q could refer to %s",
+			relationerrors.AmbiguousRelation, fmt.Sprintf("%s %s", identifier1, identifier2),
+			strings.Join(possibleMatches, "; "))
+	}
+
+	return matches[0].ep1, matches[0].ep2, nil
+}
+
+// endpointMatch is a pair of endpoints which can be related to each other.
+type endpointMatch struct {
+	ep1 Endpoint
+	ep2 Endpoint
+}
+
+// getCompatibleEndpoints returns every pair of endpoints, one from each
+// identifier's candidates, which can be related to each other. Container
+// scoped endpoints only match if one of the applications is a subordinate.
+func (st *State) getCompatibleEndpoints(
+	ctx context.Context,
+	tx *sqlair.TX,
+	identifier1, identifier2 relation.CandidateEndpointIdentifier,
+) ([]endpointMatch, error) {
 	// Get candidate endpoints.
 	endpoints1, err := st.getCandidateEndpoints(ctx, tx, identifier1)
 	if err != nil {
-		return Endpoint{}, Endpoint{}, errors.Capture(err)
+		return nil, errors.Capture(err)
 	}
 	endpoints2, err := st.getCandidateEndpoints(ctx, tx, identifier2)
 	if err != nil {
-		return Endpoint{}, Endpoint{}, errors.Capture(err)
+		return nil, errors.Capture(err)
 	}
 
 	var noCandidates []string
@@ -3171,7 +3381,7 @@ func (st *State) inferEndpoints(
 		noCandidates = append(noCandidates, identifier2.String())
 	}
 	if len(noCandidates) > 0 {
-		return Endpoint{}, Endpoint{}, errors.Errorf("no candidates for %s: %w",
+		return nil, errors.Errorf("no candidates for %s: %w",
 			strings.Join(noCandidates, " and "),
 			relationerrors.RelationEndpointNotFound)
 	}
@@ -3184,19 +3394,15 @@ func (st *State) inferEndpoints(
 	// Check if applications are subordinates.
 	isSubordinate1, err := st.isSubordinate(ctx, tx, app1UUID)
 	if err != nil {
-		return Endpoint{}, Endpoint{}, errors.Capture(err)
+		return nil, errors.Capture(err)
 	}
 	isSubordinate2, err := st.isSubordinate(ctx, tx, app2UUID)
 	if err != nil {
-		return Endpoint{}, Endpoint{}, errors.Capture(err)
+		return nil, errors.Capture(err)
 	}
 
 	// Compute matches.
-	type match struct {
-		ep1 *Endpoint
-		ep2 *Endpoint
-	}
-	var matches []match
+	var matches []endpointMatch
 	for _, e1 := range endpoints1 {
 		ep1 := e1.toRelationEndpoint()
 		for _, e2 := range endpoints2 {
@@ -3206,24 +3412,11 @@ func (st *State) inferEndpoints(
 				continue
 			}
 			if ep1.CanRelateTo(ep2) {
-				matches = append(matches, match{ep1: &e1, ep2: &e2})
+				matches = append(matches, endpointMatch{ep1: e1, ep2: e2})
 			}
 		}
 	}
-
-	if matchCount := len(matches); matchCount == 0 {
-		return Endpoint{}, Endpoint{}, relationerrors.CompatibleEndpointsNotFound
-	} else if matchCount > 1 {
-		possibleMatches := make([]string, 0, matchCount)
-		for _, match := range matches {
-			possibleMatches = append(possibleMatches, fmt.Sprintf("\"%s %s\"", match.ep1, match.ep2))
-		}
-		return Endpoint{}, Endpoint{}, errors.Errorf("%w: %q could refer to %s",
-			relationerrors.AmbiguousRelation, fmt.Sprintf("%s %s", identifier1, identifier2),
-			strings.Join(possibleMatches, "; "))
-	}
-
-	return *matches[0].ep1, *matches[0].ep2, nil
+	return matches, nil
 }
 
 // insertNewRelation creates a new relation entry in the database and returns its UUID or an error if the operation fails.
@@ -693,6 +693,86 @@ func (s *addRelationSuite) TestInferEndpointsError(c *gc.C) {
 	}
 }
 
+// TestGetCompatibleEndpointPairs verifies that all the compatible pairings
+// between two applications are returned, rather than an ambiguity error.
+func (s *addRelationSuite) TestGetCompatibleEndpointPairs(c *gc.C) {
+	// Arrange:
+	appUUID1 := s.addApplication(c, "application-1")
+	appUUID2 := s.addApplication(c, "application-2")
+	s.addApplicationEndpoint(c, appUUID1, "other-provider", charm.RoleProvider, "other")
+	s.addApplicationEndpoint(c, appUUID1, "unrelated", charm.RoleProvider, "unrelated")
+	s.addApplicationEndpoint(c, appUUID2, "second-requirer", charm.RoleRequirer, "other")
+	s.addApplicationEndpoint(c, appUUID2, "first-requirer", charm.RoleRequirer, "other")
+
+	// Act:
+	pairs, err := s.state.GetCompatibleEndpointPairs(context.Background(),
+		s.newEndpointIdentifier(c, "application-1"),
+		s.newEndpointIdentifier(c, "application-2"))
+
+	// Assert:
+	c.Assert(err, jc.ErrorIsNil)
+	provider := relation.Endpoint{
+		ApplicationName: "application-1",
+		Relation: charm.Relation{
+			Name:      "other-provider",
+			Role:      charm.RoleProvider,
+			Interface: "other",
+			Scope:     charm.ScopeGlobal,
+		},
+	}
+	requirer := func(name string) relation.Endpoint {
+		return relation.Endpoint{
+			ApplicationName: "application-2",
+			Relation: charm.Relation{
+				Name:      name,
+				Role:      charm.RoleRequirer,
+				Interface: "other",
+				Scope:     charm.ScopeGlobal,
+			},
+		}
+	}
+	c.Check(pairs, jc.DeepEquals, []relation.EndpointPair{
+		{Endpoint1: provider, Endpoint2: requirer("first-requirer")},
+		{Endpoint1: provider, Endpoint2: requirer("second-requirer")},
+	})
+}
+
+// TestGetCompatibleEndpointPairsNoCompatible verifies that no pairs are
+// returned when the applications share no interface.
+func (s *addRelationSuite) TestGetCompatibleEndpointPairsNoCompatible(c *gc.C) {
+	// Arrange:
+	appUUID1 := s.addApplication(c, "application-1")
+	appUUID2 := s.addApplication(c, "application-2")
+	s.addApplicationEndpoint(c, appUUID1, "provider", charm.RoleProvider, "test")
+	s.addApplicationEndpoint(c, appUUID2, "requirer", charm.RoleRequirer, "other")
+
+	// Act:
+	pairs, err := s.state.GetCompatibleEndpointPairs(context.Background(),
+		s.newEndpointIdentifier(c, "application-1"),
+		s.newEndpointIdentifier(c, "application-2"))
+
+	// Assert:
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pairs, gc.HasLen, 0)
+}
+
+// TestGetCompatibleEndpointPairsEndpointNotFound verifies that an unknown
+// endpoint results in a RelationEndpointNotFound error.
+func (s *addRelationSuite) TestGetCompatibleEndpointPairsEndpointNotFound(c *gc.C) {
+	// Arrange:
+	appUUID1 := s.addApplication(c, "application-1")
+	s.addApplication(c, "application-2")
+	s.addApplicationEndpoint(c, appUUID1, "provider", charm.RoleProvider, "test")
+
+	// Act:
+	_, err := s.state.GetCompatibleEndpointPairs(context.Background(),
+		s.newEndpointIdentifier(c, "application-1"),
+		s.newEndpointIdentifier(c, "application-2"))
+
+	// Assert:
+	c.Check(err, jc.ErrorIs, relationerrors.RelationEndpointNotFound)
+}
+
 // addApplication creates and adds a new application with the specified name and
 // returns its unique identifier.
 // It creates a specific charm for this application.
@@ -1795,6 +1875,62 @@ func (s *relationSuite) TestEnterScopeUnitNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, relationerrors.UnitNotFound)
 }
 
+func (s *relationSuite) TestGetRelationLife(c *gc.C) {
+	// Arrange.
+	relationUUID := s.addRelationWithLifeAndID(c, corelife.Dying, 7)
+
+	// Act.
+	l, err := s.state.GetRelationLife(context.Background(), relationUUID)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(l, gc.Equals, corelife.Dying)
+}
+
+func (s *relationSuite) TestGetRelationLifeNotFound(c *gc.C) {
+	// Act.
+	_, err := s.state.GetRelationLife(context.Background(), "fake-relation-uuid")
+
+	// Assert.
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *relationSuite) TestSetRelationDying(c *gc.C) {
+	// Arrange.
+	relationUUID := s.addRelation(c)
+
+	// Act.
+	err := s.state.SetRelationDying(context.Background(), relationUUID)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIsNil)
+	l, err := s.state.GetRelationLife(context.Background(), relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(l, gc.Equals, corelife.Dying)
+}
+
+func (s *relationSuite) TestSetRelationDyingDeadUnchanged(c *gc.C) {
+	// Arrange.
+	relationUUID := s.addRelationWithLifeAndID(c, corelife.Dead, 7)
+
+	// Act.
+	err := s.state.SetRelationDying(context.Background(), relationUUID)
+
+	// Assert.
+	c.Assert(err, jc.ErrorIsNil)
+	l, err := s.state.GetRelationLife(context.Background(), relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(l, gc.Equals, corelife.Dead)
+}
+
+func (s *relationSuite) TestSetRelationDyingNotFound(c *gc.C) {
+	// Act.
+	err := s.state.SetRelationDying(context.Background(), "fake-relation-uuid")
+
+	// Assert.
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
 func (s *relationSuite) TestLeaveScope(c *gc.C) {
 	// Arrange: Add two endpoints.
 	endpoint1 := relation.Endpoint{
@@ -2534,6 +2670,66 @@ func (s *relationSuite) TestGetPrincipalSubordinateApplicationIDsPrincipalOnly(c
 	c.Check(obtainedSubordinate.String(), gc.Equals, "")
 }
 
+func (s *relationSuite) TestGetRelationDataSize(c *gc.C) {
+	// Arrange: Add relation with one endpoint.
+	endpoint1 := relation.Endpoint{
+		ApplicationName: s.fakeApplicationName1,
+		Relation: charm.Relation{
+			Name:      "fake-endpoint-name-1",
+			Role:      charm.RoleProvider,
+			Interface: "database",
+			Optional:  true,
+			Limit:     20,
+			Scope:     charm.ScopeContainer,
+		},
+	}
+	charmRelationUUID1 := s.addCharmRelation(c, s.fakeCharmUUID1, endpoint1.Relation)
+	applicationEndpointUUID1 := s.addApplicationEndpoint(c, s.fakeApplicationUUID1, charmRelationUUID1)
+	relationUUID := s.addRelation(c)
+	relationEndpointUUID1 := s.addRelationEndpoint(c, relationUUID, applicationEndpointUUID1)
+
+	// Arrange: Add two units to the relation, only one of which has settings.
+	unitName0 := coreunittesting.GenNewName(c, "app/0")
+	unitUUID0 := s.addUnit(c, unitName0, s.fakeApplicationUUID1, s.fakeCharmUUID1)
+	relationUnitUUID0 := s.addRelationUnit(c, unitUUID0, relationEndpointUUID1)
+	s.addRelationUnitSetting(c, relationUnitUUID0, "key1", "value1")
+	s.addRelationUnitSetting(c, relationUnitUUID0, "key2", "a-longer-value")
+
+	unitName1 := coreunittesting.GenNewName(c, "app/1")
+	unitUUID1 := s.addUnit(c, unitName1, s.fakeApplicationUUID1, s.fakeCharmUUID1)
+	s.addRelationUnit(c, unitUUID1, relationEndpointUUID1)
+
+	// Act:
+	sizes, err := s.state.GetRelationDataSize(context.Background(), relationUUID)
+
+	// Assert:
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sizes, gc.DeepEquals, map[string]int{
+		"app/0": len("key1value1") + len("key2a-longer-value"),
+		"app/1": 0,
+	})
+}
+
+func (s *relationSuite) TestGetRelationDataSizeNoUnits(c *gc.C) {
+	// Arrange:
+	relationUUID := s.addRelation(c)
+
+	// Act:
+	sizes, err := s.state.GetRelationDataSize(context.Background(), relationUUID)
+
+	// Assert:
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sizes, gc.HasLen, 0)
+}
+
+func (s *relationSuite) TestGetRelationDataSizeRelationNotFound(c *gc.C) {
+	// Act:
+	_, err := s.state.GetRelationDataSize(context.Background(), "unknown-relation-uuid")
+
+	// Assert:
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
 func (s *relationSuite) TestGetRelationUnitSettings(c *gc.C) {
 	// Arrange: Add relation with one endpoint.
 	endpoint1 := relation.Endpoint{
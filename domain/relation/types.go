@@ -51,6 +51,17 @@ type RelationDetails struct {
 	Endpoints []Endpoint
 }
 
+// Scope returns the scope of the relation. A relation is container scoped if
+// any of its endpoints is container scoped, otherwise it is global.
+func (d RelationDetails) Scope() charm.RelationScope {
+	for _, ep := range d.Endpoints {
+		if ep.Scope == charm.ScopeContainer {
+			return charm.ScopeContainer
+		}
+	}
+	return charm.ScopeGlobal
+}
+
 // RelationDetailsResult represents the current application's view of a
 // relation. This struct is used for passing results from state to the service.
 type RelationDetailsResult struct {
@@ -236,6 +247,13 @@ func (ep Endpoint) EndpointIdentifier() corerelation.EndpointIdentifier {
 	}
 }
 
+// EndpointPair is a pair of compatible endpoints which could be related to
+// each other.
+type EndpointPair struct {
+	Endpoint1 Endpoint
+	Endpoint2 Endpoint
+}
+
 // OtherApplicationForWatcher provides data needed to emit an event from
 // the PrincipalLifeSuspendedStatus watcher on other endpoints in a
 // relation.
@@ -6,6 +6,8 @@ package relation
 import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/charm"
 )
 
 type typesSuite struct{}
@@ -50,3 +52,41 @@ func (s *typesSuite) TestValidateFailEndpointsMoreThanTwo(c *gc.C) {
 	// Assert
 	c.Assert(err, gc.NotNil)
 }
+
+func (s *typesSuite) TestRelationDetailsScopeGlobal(c *gc.C) {
+	// Arrange
+	details := RelationDetails{
+		Endpoints: []Endpoint{{
+			ApplicationName: "foo",
+			Relation:        charm.Relation{Name: "db", Scope: charm.ScopeGlobal},
+		}, {
+			ApplicationName: "bar",
+			Relation:        charm.Relation{Name: "db", Scope: charm.ScopeGlobal},
+		}},
+	}
+
+	// Act
+	scope := details.Scope()
+
+	// Assert
+	c.Check(scope, gc.Equals, charm.ScopeGlobal)
+}
+
+func (s *typesSuite) TestRelationDetailsScopeContainer(c *gc.C) {
+	// Arrange
+	details := RelationDetails{
+		Endpoints: []Endpoint{{
+			ApplicationName: "foo",
+			Relation:        charm.Relation{Name: "juju-info", Scope: charm.ScopeGlobal},
+		}, {
+			ApplicationName: "logging",
+			Relation:        charm.Relation{Name: "info", Scope: charm.ScopeContainer},
+		}},
+	}
+
+	// Act
+	scope := details.Scope()
+
+	// Assert
+	c.Check(scope, gc.Equals, charm.ScopeContainer)
+}
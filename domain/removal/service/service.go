@@ -107,9 +107,37 @@ func NewWatchableService(
 // WatchRemovals watches for scheduled removal jobs.
 // The returned watcher emits the UUIDs of any inserted or updated jobs.
 func (s *WatchableService) WatchRemovals() (watcher.StringsWatcher, error) {
+	return s.WatchRemovalsContext(context.Background())
+}
+
+// WatchRemovalsContext watches for scheduled removal jobs.
+// The returned watcher emits the UUIDs of any inserted or updated jobs,
+// and is killed when the input context is cancelled.
+func (s *WatchableService) WatchRemovalsContext(ctx context.Context) (watcher.StringsWatcher, error) {
 	w, err := s.watcherFactory.NewUUIDsWatcher(s.st.NamespaceForWatchRemovals(), changestream.Changed)
 	if err != nil {
 		return nil, errors.Errorf("creating watcher for removals: %w", err)
 	}
-	return w, nil
+	return &contextWatcher{
+		StringsWatcher: w,
+		stop:           context.AfterFunc(ctx, w.Kill),
+	}, nil
+}
+
+// contextWatcher is a [watcher.StringsWatcher] that is killed when the
+// context it was created with is cancelled.
+type contextWatcher struct {
+	watcher.StringsWatcher
+
+	// stop deregisters the kill on context cancellation.
+	stop func() bool
+}
+
+// Wait (worker.Worker) waits for the watcher to stop, and returns the error
+// with which it exited. Once stopped, the watcher is no longer tied to the
+// context it was created with.
+func (w *contextWatcher) Wait() error {
+	err := w.StringsWatcher.Wait()
+	w.stop()
+	return err
 }
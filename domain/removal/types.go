@@ -8,8 +8,17 @@ import "time"
 // JobType indicates the type of entity that a removal job is for.
 type JobType uint64
 
-// RelationJob indicates a job to remove a relation.
-const RelationJob JobType = iota
+const (
+	// RelationJob indicates a job to remove a relation.
+	RelationJob JobType = iota
+
+	// UnitJob indicates a job to remove a unit.
+	UnitJob
+)
+
+// UnitNameArg is the key in a unit removal job's [Job.Arg] under which the
+// name of the unit being removed is recorded.
+const UnitNameArg = "unit-name"
 
 // Job is a removal job for a single entity.
 type Job struct {
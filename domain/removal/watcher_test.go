@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/clock"
 	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/changestream"
@@ -61,3 +62,26 @@ func (s *watcherSuite) TestWatchRemovals(c *gc.C) {
 		w.Check(watchertest.StringSliceAssert("job-uuid-1", "job-uuid-2"))
 	})
 }
+
+func (s *watcherSuite) TestWatchRemovalsContextCancelled(c *gc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "some-model-uuid")
+
+	log := loggertesting.WrapCheckLog(c)
+
+	svc := service.NewWatchableService(
+		state.NewState(func() (database.TxnRunner, error) { return s.ModelTxnRunner(), nil }, log),
+		domain.NewWatcherFactory(factory, log),
+		clock.WallClock,
+		log,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w, err := svc.WatchRemovalsContext(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Cancelling the context tears the watcher down.
+	cancel()
+	workertest.CheckKilled(c, w)
+}
@@ -96,6 +96,22 @@ func (s *Service) ResolveUnit(ctx context.Context, unitName coreunit.Name, mode
 	return s.st.ResolveUnit(ctx, unitUUID, mode)
 }
 
+// ResolveUnits marks each of the given units as resolved, continuing past
+// individual failures. The returned slice holds the error, if any, from
+// resolving the unit at the same index, as documented on [Service.ResolveUnit].
+// An error is only returned if the context is cancelled before all the units
+// have been processed.
+func (s *Service) ResolveUnits(ctx context.Context, unitNames []coreunit.Name, mode resolve.ResolveMode) ([]error, error) {
+	results := make([]error, len(unitNames))
+	for i, unitName := range unitNames {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Errorf("resolving units: %w", err)
+		}
+		results[i] = s.ResolveUnit(ctx, unitName, mode)
+	}
+	return results, nil
+}
+
 // ResolveAllUnits marks all units as resolved.
 func (s *Service) ResolveAllUnits(ctx context.Context, mode resolve.ResolveMode) error {
 	return s.st.ResolveAllUnits(ctx, mode)
@@ -151,6 +151,39 @@ func (s *serviceSuite) TestResolveUnitNotInErrorState(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, resolveerrors.UnitNotInErrorState)
 }
 
+func (s *serviceSuite) TestResolveUnits(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitUUID0 := unittesting.GenUnitUUID(c)
+	unitUUID2 := unittesting.GenUnitUUID(c)
+
+	s.state.EXPECT().GetUnitUUID(gomock.Any(), coreunit.Name("foo/0")).Return(unitUUID0, nil)
+	s.state.EXPECT().ResolveUnit(gomock.Any(), unitUUID0, resolve.ResolveModeRetryHooks).Return(nil)
+	s.state.EXPECT().GetUnitUUID(gomock.Any(), coreunit.Name("foo/1")).Return("", resolveerrors.UnitNotFound)
+	s.state.EXPECT().GetUnitUUID(gomock.Any(), coreunit.Name("foo/2")).Return(unitUUID2, nil)
+	s.state.EXPECT().ResolveUnit(gomock.Any(), unitUUID2, resolve.ResolveModeRetryHooks).Return(resolveerrors.UnitNotInErrorState)
+
+	results, err := s.service.ResolveUnits(context.Background(), []coreunit.Name{
+		"foo/0", "foo/1", "foo/2", "!!!",
+	}, resolve.ResolveModeRetryHooks)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 4)
+	c.Check(results[0], jc.ErrorIsNil)
+	c.Check(results[1], jc.ErrorIs, resolveerrors.UnitNotFound)
+	c.Check(results[2], jc.ErrorIs, resolveerrors.UnitNotInErrorState)
+	c.Check(results[3], jc.ErrorIs, coreunit.InvalidUnitName)
+}
+
+func (s *serviceSuite) TestResolveUnitsContextCancelled(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.service.ResolveUnits(ctx, []coreunit.Name{"foo/0"}, resolve.ResolveModeRetryHooks)
+	c.Assert(err, jc.ErrorIs, context.Canceled)
+}
+
 func (s *serviceSuite) TestResolveAllUnitsRetryHooks(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
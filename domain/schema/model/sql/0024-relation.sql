@@ -47,6 +47,26 @@ CREATE TABLE application_extra_endpoint (
 CREATE INDEX idx_application_extra_endpoint_app
 ON application_extra_endpoint (application_uuid);
 
+-- The application_endpoint_binding_history table records every change made
+-- to the space an application endpoint is bound to, for auditing. The
+-- endpoint name is empty for the application default binding. A NULL space
+-- name means the endpoint was bound to the application default space. The
+-- history is deleted along with the application it belongs to.
+CREATE TABLE application_endpoint_binding_history (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    application_uuid TEXT NOT NULL,
+    endpoint_name TEXT NOT NULL,
+    old_space_name TEXT,
+    new_space_name TEXT,
+    changed_at DATETIME NOT NULL,
+    CONSTRAINT fk_application_uuid
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid)
+);
+
+CREATE INDEX idx_application_endpoint_binding_history_app
+ON application_endpoint_binding_history (application_uuid);
+
 -- The relation_endpoint table links a relation to a single
 -- application endpoint. If the relation is of type peer,
 -- there will be one row in the table. If the relation has
@@ -265,6 +265,7 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 
 		// Relations
 		"application_endpoint",
+		"application_endpoint_binding_history",
 		"application_extra_endpoint",
 		"relation_application_setting",
 		"relation_application_settings_hash",
@@ -140,6 +140,7 @@ func (s *ControllerServices) Cloud() *cloudservice.WatchableService {
 func (s *ControllerServices) AutocertCache() *autocertcacheservice.Service {
 	return autocertcacheservice.NewService(
 		autocertcachestate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.clock,
 		s.logger.Child("autocertcache"),
 	)
 }
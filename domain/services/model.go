@@ -58,6 +58,8 @@ import (
 	modelproviderstate "github.com/juju/juju/domain/modelprovider/state"
 	networkservice "github.com/juju/juju/domain/network/service"
 	networkstate "github.com/juju/juju/domain/network/state"
+	objectstoreservice "github.com/juju/juju/domain/objectstore/service"
+	objectstorestate "github.com/juju/juju/domain/objectstore/state"
 	portservice "github.com/juju/juju/domain/port/service"
 	portstate "github.com/juju/juju/domain/port/state"
 	proxy "github.com/juju/juju/domain/proxy/service"
@@ -99,6 +101,22 @@ type PublicKeyImporter interface {
 	FetchPublicKeysForSubject(context.Context, *url.URL) ([]string, error)
 }
 
+// CharmStoreConfig holds the settings of the charm store used by the model's
+// application service.
+type CharmStoreConfig struct {
+	// Quota is the maximum number of bytes the model object store may hold
+	// for charms to still be stored. Zero means unlimited.
+	Quota int64
+
+	// TempFiles is the pool of temporary files backing charm readers. It is
+	// shared between models, and may be nil.
+	TempFiles *charmstore.TempFilePool
+
+	// Digests are the hash algorithms used to compute the digest of charms.
+	// If empty, the charm store defaults are used.
+	Digests []charmstore.HashAlgorithm
+}
+
 // ModelServices provides access to the services required by the apiserver.
 type ModelServices struct {
 	modelServiceFactoryBase
@@ -109,6 +127,7 @@ type ModelServices struct {
 	storageRegistry        corestorage.ModelStorageRegistryGetter
 	publicKeyImporter      PublicKeyImporter
 	leaseManager           lease.ModelLeaseManagerGetter
+	charmStoreConfig       CharmStoreConfig
 	logDir                 string
 	clock                  clock.Clock
 }
@@ -124,6 +143,7 @@ func NewModelServices(
 	storageRegistry corestorage.ModelStorageRegistryGetter,
 	publicKeyImporter PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
+	charmStoreConfig CharmStoreConfig,
 	logDir string,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -142,6 +162,7 @@ func NewModelServices(
 		storageRegistry:        storageRegistry,
 		publicKeyImporter:      publicKeyImporter,
 		leaseManager:           leaseManager,
+		charmStoreConfig:       charmStoreConfig,
 		logDir:                 logDir,
 		clock:                  clock,
 	}
@@ -216,9 +237,10 @@ func (s *ModelServices) BlockDevice() *blockdeviceservice.WatchableService {
 // Application returns the model's application service.
 func (s *ModelServices) Application() *applicationservice.WatchableService {
 	logger := s.logger.Child("application")
+	state := applicationstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.clock, logger)
 
 	return applicationservice.NewWatchableService(
-		applicationstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.clock, logger),
+		state,
 		domain.NewLeaseService(s.leaseManager),
 		s.storageRegistry,
 		s.modelUUID,
@@ -227,7 +249,18 @@ func (s *ModelServices) Application() *applicationservice.WatchableService {
 		providertracker.ProviderRunner[applicationservice.Provider](s.providerFactory, s.modelUUID.String()),
 		providertracker.ProviderRunner[applicationservice.SupportedFeatureProvider](s.providerFactory, s.modelUUID.String()),
 		providertracker.ProviderRunner[applicationservice.CAASApplicationProvider](s.providerFactory, s.modelUUID.String()),
-		charmstore.NewCharmStore(s.modelObjectStoreGetter, logger.Child("charmstore")),
+		charmstore.NewCharmStore(charmstore.Config{
+			ObjectStoreGetter: s.modelObjectStoreGetter,
+			Quota:             s.charmStoreConfig.Quota,
+			Usage: objectstoreservice.NewService(
+				objectstorestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+			),
+			Charms:    state,
+			TempFiles: s.charmStoreConfig.TempFiles,
+			Digests:   s.charmStoreConfig.Digests,
+			Logger:    logger.Child("charmstore"),
+			Clock:     s.clock,
+		}),
 		domain.NewStatusHistory(logger, s.clock),
 		s.clock,
 		logger,
@@ -289,6 +289,7 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *gc.C, o
 			modelApplicationLeaseManagerGetter(func() lease.Checker {
 				return leaseManager
 			}),
+			domainservices.CharmStoreConfig{},
 			c.MkDir(),
 			clock,
 			logger,
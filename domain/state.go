@@ -214,6 +214,14 @@ func (r *txnRunner) Txn(ctx context.Context, fn func(context.Context, *sqlair.TX
 	return CoerceError(r.runner.Txn(ctx, fn))
 }
 
+// ReadTxn manages the application of a read-only SQLair transaction within
+// which the input function is executed. If the underlying runner can not run
+// read-only transactions, a regular transaction is used.
+// The input context can be used by the caller to cancel this process.
+func (r *txnRunner) ReadTxn(ctx context.Context, fn func(context.Context, *sqlair.TX) error) error {
+	return CoerceError(database.ReadTxn(ctx, r.runner, fn))
+}
+
 // AtomicContext is a typed context that provides access to the database transaction
 // for the duration of a transaction.
 type AtomicContext interface {
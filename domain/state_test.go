@@ -13,6 +13,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/database"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/testing"
@@ -38,6 +39,23 @@ func (s *stateSuite) TestStateBaseGetDBNilFactory(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `nil getDB`)
 }
 
+func (s *stateSuite) TestStateBaseGetDBReadTxn(c *gc.C) {
+	base := NewStateBase(s.TxnRunnerFactory())
+	db, err := base.DB()
+	c.Assert(err, jc.ErrorIsNil)
+
+	runner, ok := db.(database.ReadOnlyTxnRunner)
+	c.Assert(ok, jc.IsTrue)
+
+	var called bool
+	err = runner.ReadTxn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		called = true
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(called, jc.IsTrue)
+}
+
 func (s *stateSuite) TestStateBasePrepare(c *gc.C) {
 	f := s.TxnRunnerFactory()
 	base := NewStateBase(f)
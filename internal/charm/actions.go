@@ -38,8 +38,13 @@ func NewActions() *Actions {
 // The Params map is expected to conform to JSON-Schema Draft 4 as defined at
 // http://json-schema.org/draft-04/schema# (see http://json-schema.org/latest/json-schema-core.html)
 type ActionSpec struct {
-	Description    string
-	Parallel       bool
+	Description string
+	// Parallel reports whether the action may run in parallel with other
+	// actions on the same unit.
+	Parallel bool
+	// ExecutionGroup is parsed from the optional "execution-group" key.
+	// Actions in the same group are run one at a time on a unit. It is
+	// empty if the action isn't in a group.
 	ExecutionGroup string
 	Params         map[string]interface{}
 }
@@ -96,6 +101,92 @@ func (spec *ActionSpec) InsertDefaults(target map[string]interface{}) (map[strin
 	return schema.InsertDefaults(target)
 }
 
+// ExampleParams returns a sample params object for the action, built from
+// the schema's default values and required fields. Required fields without
+// a default are given a zero value appropriate to their declared type, and
+// nested object schemas are expanded recursively.
+func (spec *ActionSpec) ExampleParams() map[string]interface{} {
+	return exampleObject(spec.Params)
+}
+
+// exampleObject returns a sample value for each of the properties of the
+// given object schema that is either required, has a default, or is an
+// object which itself yields a non-empty sample.
+func exampleObject(schema map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	properties, _ := schema["properties"].(map[string]interface{})
+	if len(properties) == 0 {
+		return result
+	}
+
+	required := make(map[string]bool)
+	if names, ok := schema["required"].([]interface{}); ok {
+		for _, name := range names {
+			if typed, ok := name.(string); ok {
+				required[typed] = true
+			}
+		}
+	}
+
+	for name, value := range properties {
+		property, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if def, ok := property["default"]; ok {
+			result[name] = def
+			continue
+		}
+		if schemaType(property) == "object" {
+			if nested := exampleObject(property); len(nested) > 0 || required[name] {
+				result[name] = nested
+			}
+			continue
+		}
+		if required[name] {
+			result[name] = zeroValue(property)
+		}
+	}
+	return result
+}
+
+// schemaType returns the declared type of the given schema. If a list of
+// types is declared, the first non-null type is returned.
+func schemaType(schema map[string]interface{}) string {
+	switch typed := schema["type"].(type) {
+	case string:
+		return typed
+	case []interface{}:
+		for _, t := range typed {
+			if name, ok := t.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// zeroValue returns the zero value for the declared type of the given
+// schema.
+func zeroValue(schema map[string]interface{}) interface{} {
+	switch schemaType(schema) {
+	case "string":
+		return ""
+	case "integer":
+		return 0
+	case "number":
+		return 0.0
+	case "boolean":
+		return false
+	case "array":
+		return []interface{}{}
+	case "object":
+		return exampleObject(schema)
+	default:
+		return nil
+	}
+}
+
 // ReadActionsYaml builds an Actions spec from a charm's actions.yaml.
 func ReadActionsYaml(charmName string, r io.Reader) (*Actions, error) {
 	data, err := io.ReadAll(r)
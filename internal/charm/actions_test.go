@@ -1087,6 +1087,104 @@ act:
 	}
 }
 
+func (s *ActionsSuite) TestExampleParams(c *gc.C) {
+	for i, t := range []struct {
+		should         string
+		schema         string
+		expectedResult map[string]interface{}
+	}{{
+		should: "return an empty map for no params",
+		schema: `
+act:
+  description: nothing
+`[1:],
+		expectedResult: map[string]interface{}{},
+	}, {
+		should: "use default values",
+		schema: `
+act:
+  params:
+    val:
+      type: string
+      default: somestr
+    other:
+      type: integer
+`[1:],
+		expectedResult: map[string]interface{}{"val": "somestr"},
+	}, {
+		should: "use zero values for required fields",
+		schema: `
+act:
+  params:
+    str:
+      type: string
+    int:
+      type: integer
+    num:
+      type: number
+    bool:
+      type: boolean
+    list:
+      type: array
+    nullable:
+      type: ["null", string]
+    untyped:
+      description: anything
+  required: [str, int, num, bool, list, nullable, untyped]
+`[1:],
+		expectedResult: map[string]interface{}{
+			"str":      "",
+			"int":      0,
+			"num":      0.0,
+			"bool":     false,
+			"list":     []interface{}{},
+			"nullable": "",
+			"untyped":  nil,
+		},
+	}, {
+		should: "expand nested objects",
+		schema: `
+act:
+  params:
+    val:
+      type: object
+      properties:
+        foo:
+          type: string
+        bar:
+          type: object
+          properties:
+            baz:
+              type: string
+              default: boz
+        qux:
+          type: boolean
+      required: [qux]
+    empty:
+      type: object
+      properties:
+        x:
+          type: string
+    reqempty:
+      type: object
+  required: [reqempty]
+`[1:],
+		expectedResult: map[string]interface{}{
+			"val": map[string]interface{}{
+				"bar": map[string]interface{}{
+					"baz": "boz",
+				},
+				"qux": false,
+			},
+			"reqempty": map[string]interface{}{},
+		},
+	}} {
+		c.Logf("test %d: should %s", i, t.should)
+		spec := getSchemaForAction(c, t.schema)
+		c.Check(spec.ExampleParams(), jc.DeepEquals, t.expectedResult)
+	}
+}
+
 func getSchemaForAction(c *gc.C, wholeSchema string) ActionSpec {
 	// Load up the YAML schema definition.
 	reader := bytes.NewReader([]byte(wholeSchema))
@@ -6,11 +6,18 @@ package charm
 import (
 	"archive/zip"
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 
 	"github.com/juju/collections/set"
@@ -25,14 +32,37 @@ type CharmArchive struct {
 
 	Path string // May be empty if CharmArchive wasn't read from a file
 	*charmBase
+
+	size int64
 }
 
 // Trick to ensure *CharmArchive implements the Charm interface.
 var _ Charm = (*CharmArchive)(nil)
 
+// ReadOptions holds the options that control how a charm archive is read.
+type ReadOptions struct {
+	// MaxMembers is the maximum number of entries the archive may hold.
+	// Archives with more entries are rejected with [TooManyArchiveMembers]
+	// before their central directory is parsed. Zero means no limit.
+	MaxMembers int
+}
+
 // ReadCharmArchive returns a CharmArchive for the charm in path.
 func ReadCharmArchive(path string) (*CharmArchive, error) {
-	a, err := readCharmArchive(newZipOpenerFromPath(path))
+	return ReadCharmArchiveWithOptions(path, ReadOptions{})
+}
+
+// ReadCharmArchiveWithOptions returns a CharmArchive for the charm in path,
+// read according to the given options. The options also apply to any later
+// access of the archive contents.
+func ReadCharmArchiveWithOptions(path string, opts ReadOptions) (*CharmArchive, error) {
+	if opts.MaxMembers < 0 {
+		return nil, errors.NotValidf("negative max members %d", opts.MaxMembers)
+	}
+	a, err := readCharmArchive(&zipPathOpener{
+		path:       path,
+		maxMembers: opts.MaxMembers,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +87,37 @@ func ReadCharmArchiveFromReader(r io.ReaderAt, size int64) (archive *CharmArchiv
 	return readCharmArchive(newZipOpenerFromReader(r, size))
 }
 
+// maxArchivePreallocation bounds the buffer allocated up front when reading
+// an archive of a declared size, so that a bogus size can't force a huge
+// allocation before any data has been read.
+const maxArchivePreallocation = 1 << 20
+
+// ReadCharmArchiveFromReaderVerified returns a CharmArchive read from r,
+// which must yield exactly size bytes. The bytes are hashed as they are
+// read, and if their SHA256 hash doesn't match expectedSHA256 an error
+// satisfying [ArchiveHashMismatch] is returned. This allows an archive to
+// be verified and parsed without reading it twice.
+func ReadCharmArchiveFromReaderVerified(r io.Reader, size int64, expectedSHA256 string) (*CharmArchive, error) {
+	if size < 0 {
+		return nil, errors.NotValidf("negative archive size %d", size)
+	}
+	hasher := sha256.New()
+	buf := bytes.NewBuffer(make([]byte, 0, min(size, maxArchivePreallocation)))
+	// Read one byte beyond the expected size, so that a reader holding
+	// more data than declared is detected.
+	n, err := io.Copy(buf, io.TeeReader(io.LimitReader(r, size+1), hasher))
+	if err != nil {
+		return nil, errors.Annotate(err, "reading charm archive")
+	}
+	if n != size {
+		return nil, errors.NotValidf("charm archive size %d, expected %d", n, size)
+	}
+	if computed := hex.EncodeToString(hasher.Sum(nil)); computed != expectedSHA256 {
+		return nil, fmt.Errorf("expected %q, got %q: %w", expectedSHA256, computed, ArchiveHashMismatch)
+	}
+	return ReadCharmArchiveBytes(buf.Bytes())
+}
+
 func readCharmArchive(zopen zipOpener) (archive *CharmArchive, err error) {
 	b := &CharmArchive{
 		zopen:     zopen,
@@ -67,6 +128,7 @@ func readCharmArchive(zopen zipOpener) (archive *CharmArchive, err error) {
 		return nil, err
 	}
 	defer func() { _ = zipr.Close() }()
+	b.size = zipr.size
 	reader, err := zipOpenFile(zipr, "metadata.yaml")
 	if err != nil {
 		return nil, err
@@ -78,7 +140,8 @@ func readCharmArchive(zopen zipOpener) (archive *CharmArchive, err error) {
 	}
 
 	// Try to read the optional manifest.yaml, it's required to determine if
-	// this charm is v1 or not.
+	// this charm is v1 or not. Very old charms have no manifest, in which
+	// case it is left nil; use [Manifest.IsEmpty] to check for either.
 	reader, err = zipOpenFile(zipr, "manifest.yaml")
 	if _, ok := err.(*noCharmArchiveFile); ok {
 		b.manifest = nil
@@ -192,12 +255,19 @@ func (err noCharmArchiveFile) Error() string {
 type zipReadCloser struct {
 	io.Closer
 	*zip.Reader
+
+	// size holds the size of the archive, in bytes.
+	size int64
 }
 
 // zipOpener holds the information needed to open a zip
 // file.
 type zipOpener interface {
 	openZip() (*zipReadCloser, error)
+
+	// openRaw returns the bytes of the archive as they were read, without
+	// interpreting them as a zip file.
+	openRaw() (io.ReadCloser, error)
 }
 
 // newZipOpenerFromPath returns a zipOpener that can be
@@ -217,7 +287,8 @@ func newZipOpenerFromReader(r io.ReaderAt, size int64) zipOpener {
 }
 
 type zipPathOpener struct {
-	path string
+	path       string
+	maxMembers int
 }
 
 func (zo *zipPathOpener) openZip() (*zipReadCloser, error) {
@@ -230,12 +301,77 @@ func (zo *zipPathOpener) openZip() (*zipReadCloser, error) {
 		f.Close()
 		return nil, err
 	}
-	r, err := zip.NewReader(f, fi.Size())
+	r, err := newZipReader(f, fi.Size(), zo.maxMembers)
 	if err != nil {
 		f.Close()
 		return nil, err
 	}
-	return &zipReadCloser{Closer: f, Reader: r}, nil
+	return &zipReadCloser{Closer: f, Reader: r, size: fi.Size()}, nil
+}
+
+func (zo *zipPathOpener) openRaw() (io.ReadCloser, error) {
+	return os.Open(zo.path)
+}
+
+const (
+	// zipDirectoryEndLen is the length of the end of central directory
+	// record, excluding the trailing comment.
+	zipDirectoryEndLen = 22
+
+	// zipDirectoryEndSignature marks the start of the end of central
+	// directory record.
+	zipDirectoryEndSignature = "PK\x05\x06"
+)
+
+// newZipReader returns a zip reader for r, which holds size bytes. If
+// maxMembers is greater than zero, archives holding more entries than that
+// are rejected with TooManyArchiveMembers. The entry count recorded in the
+// end of central directory record is checked first, so that oversized
+// directories are never parsed.
+func newZipReader(r io.ReaderAt, size int64, maxMembers int) (*zip.Reader, error) {
+	if maxMembers > 0 {
+		if count, ok := zipDirectoryCount(r, size); ok && count > maxMembers {
+			return nil, tooManyArchiveMembers(count, maxMembers)
+		}
+	}
+	zipr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	// Zip64 archives record their entry count elsewhere, so double check
+	// the parsed directory.
+	if maxMembers > 0 && len(zipr.File) > maxMembers {
+		return nil, tooManyArchiveMembers(len(zipr.File), maxMembers)
+	}
+	return zipr, nil
+}
+
+// zipDirectoryCount returns the total number of entries recorded in the end
+// of central directory record of the archive in r. It returns false if the
+// record can't be found, or if the count is deferred to a zip64 record.
+func zipDirectoryCount(r io.ReaderAt, size int64) (int, bool) {
+	// The record is followed by a comment of at most 64KiB.
+	bufLen := int64(zipDirectoryEndLen + math.MaxUint16)
+	if bufLen > size {
+		bufLen = size
+	}
+	buf := make([]byte, bufLen)
+	if _, err := r.ReadAt(buf, size-bufLen); err != nil && err != io.EOF {
+		return 0, false
+	}
+	i := bytes.LastIndex(buf, []byte(zipDirectoryEndSignature))
+	if i < 0 || len(buf)-i < zipDirectoryEndLen {
+		return 0, false
+	}
+	count := binary.LittleEndian.Uint16(buf[i+10:])
+	if count == math.MaxUint16 {
+		return 0, false
+	}
+	return int(count), true
+}
+
+func tooManyArchiveMembers(count, limit int) error {
+	return fmt.Errorf("archive has %d members, limit is %d: %w", count, limit, TooManyArchiveMembers)
 }
 
 type zipReaderOpener struct {
@@ -248,38 +384,110 @@ func (zo *zipReaderOpener) openZip() (*zipReadCloser, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &zipReadCloser{Closer: ioutil.NopCloser(nil), Reader: r}, nil
+	return &zipReadCloser{Closer: ioutil.NopCloser(nil), Reader: r, size: zo.size}, nil
+}
+
+func (zo *zipReaderOpener) openRaw() (io.ReadCloser, error) {
+	return io.NopCloser(io.NewSectionReader(zo.r, 0, zo.size)), nil
+}
+
+// Size returns the size of the charm archive in bytes, as captured when the
+// archive was read.
+func (a *CharmArchive) Size() int64 {
+	return a.size
 }
 
 // ArchiveMembers returns a set of the charm's contents.
 func (a *CharmArchive) ArchiveMembers() (set.Strings, error) {
-	zipr, err := a.zopen.openZip()
+	manifest := set.NewStrings()
+	err := a.WalkArchiveMembers(func(name string) error {
+		manifest.Add(name)
+		return nil
+	})
 	if err != nil {
 		return set.NewStrings(), err
 	}
+	return manifest, nil
+}
+
+// WalkArchiveMembers calls fn with the name of each of the charm's contents,
+// in archive order, without building the whole set in memory. If fn returns
+// an error, walking stops and the error is returned, unless it is
+// [filepath.SkipAll], in which case walking stops and nil is returned.
+// Unlike ArchiveMembers, a name other than "revision" is passed to fn more
+// than once if the archive holds duplicate entries.
+func (a *CharmArchive) WalkArchiveMembers(fn func(name string) error) error {
+	zipr, err := a.zopen.openZip()
+	if err != nil {
+		return err
+	}
 	defer zipr.Close()
-	paths, err := ziputil.Find(zipr.Reader, "*")
+
+	var sawRevision bool
+	walk := func() error {
+		for _, f := range zipr.File {
+			// We always strip ".", because that's sometimes not present.
+			name := path.Clean(f.Name)
+			if name == "." {
+				continue
+			}
+			// The revision file is sometimes written to an archive more
+			// than once, but is only reported once.
+			if name == "revision" {
+				if sawRevision {
+					continue
+				}
+				sawRevision = true
+			}
+			if err := fn(name); err != nil {
+				return err
+			}
+		}
+		// We always write out a revision file, even if there isn't one
+		// in the archive.
+		if !sawRevision {
+			return fn("revision")
+		}
+		return nil
+	}
+	if err := walk(); err != nil && !errors.Is(err, filepath.SkipAll) {
+		return err
+	}
+	return nil
+}
+
+// WriteTo writes the bytes of the charm archive to w, exactly as they were
+// read, and returns the number of bytes written. The archive is streamed
+// rather than expanded or rebuilt; for archives read from a file, the file
+// is opened again and copied. It implements [io.WriterTo].
+func (a *CharmArchive) WriteTo(w io.Writer) (int64, error) {
+	r, err := a.zopen.openRaw()
 	if err != nil {
-		return set.NewStrings(), err
+		return 0, err
 	}
-	manifest := set.NewStrings(paths...)
-	// We always write out a revision file, even if there isn't one in the
-	// archive; and we always strip ".", because that's sometimes not present.
-	manifest.Add("revision")
-	manifest.Remove(".")
-	return manifest, nil
+	defer func() { _ = r.Close() }()
+	return io.Copy(w, r)
 }
 
 // ExpandTo expands the charm archive into dir, creating it if necessary.
 // If any errors occur during the expansion procedure, the process will
 // abort.
 func (a *CharmArchive) ExpandTo(dir string) error {
+	return a.ExpandToContext(context.Background(), dir)
+}
+
+// ExpandToContext expands the charm archive into dir, creating it if
+// necessary. The context is checked between archive members; if it is done,
+// the expansion is aborted, the files extracted so far are removed, and the
+// context error is returned. If any other errors occur during the expansion
+// procedure, the process will abort.
+func (a *CharmArchive) ExpandToContext(ctx context.Context, dir string) error {
 	zipr, err := a.zopen.openZip()
 	if err != nil {
 		return err
 	}
 	defer zipr.Close()
-	if err := ziputil.ExtractAll(zipr.Reader, dir); err != nil {
+	if err := extractAllContext(ctx, zipr.Reader, dir); err != nil {
 		return err
 	}
 	hooksDir := filepath.Join(dir, "hooks")
@@ -305,6 +513,63 @@ func (a *CharmArchive) ExpandTo(dir string) error {
 	return nil
 }
 
+// extractAllContext extracts every member of the zip reader into dir, one
+// member at a time, so that the context can be checked in between. Each
+// member is extracted with ziputil, keeping its path and symlink checks.
+// If the context is done, everything created by the extraction so far is
+// removed, including dir and any parent directories which were created
+// implicitly for a member.
+func extractAllContext(ctx context.Context, reader *zip.Reader, dir string) error {
+	var created []string
+	for _, zipFile := range reader.File {
+		if err := ctx.Err(); err != nil {
+			removeCreated(created)
+			return errors.Annotate(err, "expanding charm archive")
+		}
+		target := filepath.Join(dir, filepath.FromSlash(path.Clean(zipFile.Name)))
+		created = append(created, missingDirs(filepath.Dir(target))...)
+		_, err := os.Lstat(target)
+		targetExisted := err == nil
+
+		member := &zip.Reader{File: []*zip.File{zipFile}}
+		if err := ziputil.ExtractAll(member, dir); err != nil {
+			return err
+		}
+		if !targetExisted {
+			created = append(created, target)
+		}
+	}
+	return nil
+}
+
+// missingDirs returns the directories in the path, including the path
+// itself, which don't exist yet, outermost first.
+func missingDirs(dir string) []string {
+	var missing []string
+	for {
+		if _, err := os.Lstat(dir); err == nil {
+			break
+		}
+		missing = append(missing, dir)
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	slices.Reverse(missing)
+	return missing
+}
+
+// removeCreated removes the created paths, most recent first, so that
+// directories are emptied before they are removed. Directories which still
+// hold files that weren't created by the extraction are left in place.
+func removeCreated(created []string) {
+	for i := len(created) - 1; i >= 0; i-- {
+		_ = os.Remove(created[i])
+	}
+}
+
 // fixHookFunc returns a WalkFunc that makes sure hooks are owner-executable.
 func fixHookFunc(hooksDir string, hookNames map[string]bool) filepath.WalkFunc {
 	return func(path string, info os.FileInfo, err error) error {
@@ -4,13 +4,19 @@
 package charm_test
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
 
 	"github.com/juju/collections/set"
+	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -61,6 +67,39 @@ func (s *CharmArchiveSuite) TestReadCharmArchive(c *gc.C) {
 	checkDummy(c, archive)
 }
 
+func (s *CharmArchiveSuite) TestReadCharmArchiveWithOptions(c *gc.C) {
+	archive, err := charm.ReadCharmArchiveWithOptions(s.archivePath, charm.ReadOptions{
+		MaxMembers: 1000,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	checkDummy(c, archive)
+
+	// The limit also applies to later reads of the archive.
+	_, err = archive.ArchiveMembers()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *CharmArchiveSuite) TestReadCharmArchiveWithOptionsNoLimit(c *gc.C) {
+	archive, err := charm.ReadCharmArchiveWithOptions(s.archivePath, charm.ReadOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	checkDummy(c, archive)
+}
+
+func (s *CharmArchiveSuite) TestReadCharmArchiveWithOptionsTooManyMembers(c *gc.C) {
+	_, err := charm.ReadCharmArchiveWithOptions(s.archivePath, charm.ReadOptions{
+		MaxMembers: 1,
+	})
+	c.Assert(err, jc.ErrorIs, charm.TooManyArchiveMembers)
+	c.Check(err, gc.ErrorMatches, `archive has \d+ members, limit is 1: too many archive members`)
+}
+
+func (s *CharmArchiveSuite) TestReadCharmArchiveWithOptionsNegativeMaxMembers(c *gc.C) {
+	_, err := charm.ReadCharmArchiveWithOptions(s.archivePath, charm.ReadOptions{
+		MaxMembers: -1,
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
 func (s *CharmArchiveSuite) TestReadCharmArchiveWithoutConfig(c *gc.C) {
 	// Technically varnish has no config AND no actions.
 	// Perhaps we should make this more orthogonal?
@@ -94,6 +133,53 @@ func (s *CharmArchiveSuite) TestReadCharmArchiveManifest(c *gc.C) {
 	}})
 }
 
+func (s *CharmArchiveSuite) TestReadCharmArchiveWithoutManifest(c *gc.C) {
+	// The no-manifest charm can't be read as a charm dir, so archive its
+	// files directly.
+	dir := charmDirPath(c, "no-manifest")
+	var buf bytes.Buffer
+	zipw := zip.NewWriter(&buf)
+	for _, name := range []string{"metadata.yaml", "revision"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		c.Assert(err, jc.ErrorIsNil)
+		w, err := zipw.Create(name)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = w.Write(data)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	c.Assert(zipw.Close(), jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchiveBytes(buf.Bytes())
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A lacking manifest.yaml file leaves the manifest nil, which
+	// is reported as empty.
+	c.Check(archive.Manifest(), gc.IsNil)
+	c.Check(archive.Manifest().IsEmpty(), jc.IsTrue)
+	c.Check(charm.MetaFormat(archive), gc.Equals, charm.FormatV1)
+}
+
+func (s *CharmArchiveSuite) TestReadCharmArchiveManifestNormalizedBases(c *gc.C) {
+	path := archivePath(c, readCharmDir(c, "duplicate-bases"))
+	archive, err := charm.ReadCharmArchive(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(archive.Manifest().Bases, gc.HasLen, 4)
+	c.Assert(archive.Manifest().NormalizedBases(), gc.DeepEquals, []charm.Base{{
+		Name: "ubuntu",
+		Channel: charm.Channel{
+			Track: "20.04",
+			Risk:  "stable",
+		},
+	}, {
+		Name: "ubuntu",
+		Channel: charm.Channel{
+			Track: "22.04",
+			Risk:  "candidate",
+		},
+	}})
+}
+
 func (s *CharmArchiveSuite) TestReadCharmArchiveWithoutActions(c *gc.C) {
 	// Wordpress has config but no actions.
 	path := archivePath(c, readCharmDir(c, "wordpress"))
@@ -140,6 +226,127 @@ func (s *CharmArchiveSuite) TestReadCharmArchiveFromReader(c *gc.C) {
 	checkDummy(c, archive)
 }
 
+func (s *CharmArchiveSuite) TestReadCharmArchiveFromReaderVerified(c *gc.C) {
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	sum := sha256.Sum256(data)
+
+	archive, err := charm.ReadCharmArchiveFromReaderVerified(bytes.NewReader(data), int64(len(data)), hex.EncodeToString(sum[:]))
+	c.Assert(err, jc.ErrorIsNil)
+	checkDummy(c, archive)
+	c.Check(archive.Size(), gc.Equals, int64(len(data)))
+}
+
+func (s *CharmArchiveSuite) TestReadCharmArchiveFromReaderVerifiedHashMismatch(c *gc.C) {
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	sum := sha256.Sum256([]byte("something else"))
+
+	_, err = charm.ReadCharmArchiveFromReaderVerified(bytes.NewReader(data), int64(len(data)), hex.EncodeToString(sum[:]))
+	c.Assert(err, jc.ErrorIs, charm.ArchiveHashMismatch)
+}
+
+func (s *CharmArchiveSuite) TestReadCharmArchiveFromReaderVerifiedSizeMismatch(c *gc.C) {
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	_, err = charm.ReadCharmArchiveFromReaderVerified(bytes.NewReader(data), int64(len(data))-1, hash)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+
+	_, err = charm.ReadCharmArchiveFromReaderVerified(bytes.NewReader(data), int64(len(data))+1, hash)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *CharmArchiveSuite) TestReadCharmArchiveFromReaderVerifiedHugeSize(c *gc.C) {
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	sum := sha256.Sum256(data)
+
+	// A declared size far beyond the data must not be allocated up front;
+	// the short read is reported instead.
+	_, err = charm.ReadCharmArchiveFromReaderVerified(bytes.NewReader(data), math.MaxInt64-1, hex.EncodeToString(sum[:]))
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *CharmArchiveSuite) TestWriteToFromFile(c *gc.C) {
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var buf bytes.Buffer
+	n, err := archive.WriteTo(&buf)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(n, gc.Equals, int64(len(data)))
+	c.Check(bytes.Equal(buf.Bytes(), data), jc.IsTrue)
+}
+
+func (s *CharmArchiveSuite) TestWriteToFromBytes(c *gc.C) {
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchiveBytes(data)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The archive can be streamed more than once.
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		n, err := archive.WriteTo(&buf)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(n, gc.Equals, int64(len(data)))
+		c.Check(bytes.Equal(buf.Bytes(), data), jc.IsTrue)
+	}
+}
+
+func (s *CharmArchiveSuite) TestWriteToFileRemoved(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "dummy.charm")
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	err = os.WriteFile(path, data, 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchive(path)
+	c.Assert(err, jc.ErrorIsNil)
+	err = os.Remove(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = archive.WriteTo(&bytes.Buffer{})
+	c.Assert(err, jc.ErrorIs, os.ErrNotExist)
+}
+
+func (s *CharmArchiveSuite) TestSize(c *gc.C) {
+	info, err := os.Stat(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(archive.Size(), gc.Equals, info.Size())
+}
+
+func (s *CharmArchiveSuite) TestSizeBytes(c *gc.C) {
+	data, err := os.ReadFile(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchiveBytes(data)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(archive.Size(), gc.Equals, int64(len(data)))
+}
+
+func (s *CharmArchiveSuite) TestSizeFromReader(c *gc.C) {
+	f, err := os.Open(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = f.Close() }()
+	info, err := f.Stat()
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchiveFromReader(f, info.Size())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(archive.Size(), gc.Equals, info.Size())
+}
+
 func (s *CharmArchiveSuite) TestArchiveMembers(c *gc.C) {
 	archive, err := charm.ReadCharmArchive(s.archivePath)
 	c.Assert(err, jc.ErrorIsNil)
@@ -148,6 +355,42 @@ func (s *CharmArchiveSuite) TestArchiveMembers(c *gc.C) {
 	c.Assert(manifest, jc.DeepEquals, set.NewStrings(dummyArchiveMembers...))
 }
 
+func (s *CharmArchiveSuite) TestWalkArchiveMembers(c *gc.C) {
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	var names []string
+	err = archive.WalkArchiveMembers(func(name string) error {
+		names = append(names, name)
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(names, jc.SameContents, dummyArchiveMembers)
+}
+
+func (s *CharmArchiveSuite) TestWalkArchiveMembersStop(c *gc.C) {
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	var names []string
+	err = archive.WalkArchiveMembers(func(name string) error {
+		names = append(names, name)
+		return filepath.SkipAll
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(names, gc.HasLen, 1)
+}
+
+func (s *CharmArchiveSuite) TestWalkArchiveMembersError(c *gc.C) {
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	var calls int
+	err = archive.WalkArchiveMembers(func(name string) error {
+		calls++
+		return errors.New("boom")
+	})
+	c.Assert(err, gc.ErrorMatches, "boom")
+	c.Check(calls, gc.Equals, 1)
+}
+
 func (s *CharmArchiveSuite) TestArchiveMembersActions(c *gc.C) {
 	path := archivePath(c, readCharmDir(c, "dummy-actions"))
 	archive, err := charm.ReadCharmArchive(path)
@@ -198,6 +441,113 @@ func (s *CharmArchiveSuite) TestExpandTo(c *gc.C) {
 	checkDummy(c, dir)
 }
 
+func (s *CharmArchiveSuite) TestExpandToContext(c *gc.C) {
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	path := filepath.Join(c.MkDir(), "charm")
+	err = archive.ExpandToContext(context.Background(), path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	dir, err := charmtesting.ReadCharmDir(path)
+	c.Assert(err, jc.ErrorIsNil)
+	checkDummy(c, dir)
+}
+
+func (s *CharmArchiveSuite) TestExpandToContextCancelled(c *gc.C) {
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	path := filepath.Join(c.MkDir(), "charm")
+	err = archive.ExpandToContext(ctx, path)
+	c.Assert(err, jc.ErrorIs, context.Canceled)
+
+	// The directory didn't exist beforehand, so nothing is left behind.
+	_, err = os.Stat(path)
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
+
+func (s *CharmArchiveSuite) TestExpandToContextCancelledKeepsExistingFiles(c *gc.C) {
+	archive, err := charm.ReadCharmArchive(s.archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	path := c.MkDir()
+	existing := filepath.Join(path, "existing")
+	err = os.WriteFile(existing, []byte("data"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = archive.ExpandToContext(ctx, path)
+	c.Assert(err, jc.ErrorIs, context.Canceled)
+
+	_, err = os.Stat(existing)
+	c.Check(err, jc.ErrorIsNil)
+	_, err = os.Stat(filepath.Join(path, "metadata.yaml"))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
+
+func (s *CharmArchiveSuite) TestExpandToContextCancelledRemovesImplicitDirs(c *gc.C) {
+	// The archive has no entries for the parent directories of
+	// nested/deep/file, so they are created implicitly when it is
+	// extracted.
+	dir := charmDirPath(c, "no-manifest")
+	var buf bytes.Buffer
+	zipw := zip.NewWriter(&buf)
+	for _, name := range []string{"metadata.yaml", "revision"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		c.Assert(err, jc.ErrorIsNil)
+		w, err := zipw.Create(name)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = w.Write(data)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	w, err := zipw.Create("nested/deep/file")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = w.Write([]byte("data"))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = zipw.Create("last")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(zipw.Close(), jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchiveBytes(buf.Bytes())
+	c.Assert(err, jc.ErrorIsNil)
+
+	path := c.MkDir()
+	existing := filepath.Join(path, "existing")
+	err = os.WriteFile(existing, []byte("data"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Cancel once every member but the last has been extracted.
+	ctx := &cancelAfterContext{Context: context.Background(), checks: 3}
+	err = archive.ExpandToContext(ctx, path)
+	c.Assert(err, jc.ErrorIs, context.Canceled)
+
+	entries, err := os.ReadDir(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, gc.HasLen, 1)
+	c.Check(entries[0].Name(), gc.Equals, "existing")
+}
+
+// cancelAfterContext is a context which reports itself as cancelled once
+// its error has been checked the given number of times.
+type cancelAfterContext struct {
+	context.Context
+	checks int
+}
+
+func (ctx *cancelAfterContext) Err() error {
+	if ctx.checks <= 0 {
+		return context.Canceled
+	}
+	ctx.checks--
+	return nil
+}
+
 func (s *CharmArchiveSuite) TestExpandToSetsHooksExecutable(c *gc.C) {
 	archivePath := archivePath(c, readCharmDir(c, "all-hooks"))
 
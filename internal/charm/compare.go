@@ -0,0 +1,166 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charm
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// CompareRevisions compares the revisions of two charm archives, returning
+// -1 if a is older than b, 0 if they are the same revision and +1 if a is
+// newer than b. A [NotComparable] error is returned if the charms have
+// different names.
+func CompareRevisions(a, b *CharmArchive) (int, error) {
+	if a == nil || b == nil {
+		return 0, errors.Errorf("comparing nil charm archive: %w", NotComparable)
+	}
+	nameA, nameB := charmName(a), charmName(b)
+	if nameA != nameB {
+		return 0, errors.Errorf("charm %q and %q: %w", nameA, nameB, NotComparable)
+	}
+	return cmp.Compare(a.Revision(), b.Revision()), nil
+}
+
+// CompareURLRevisions compares two charm URLs by source, then by revision.
+// The result is -1 if a sorts before b, 0 if they are equal and +1 if a sorts
+// after b. Charms from different sources are never considered to be the same
+// revision, so they are ordered by their schema. A [NotComparable] error is
+// returned if the URLs have different names.
+func CompareURLRevisions(a, b *URL) (int, error) {
+	if a == nil || b == nil {
+		return 0, errors.Errorf("comparing nil charm URL: %w", NotComparable)
+	}
+	if a.Name != b.Name {
+		return 0, errors.Errorf("charm %q and %q: %w", a.Name, b.Name, NotComparable)
+	}
+	if result := cmp.Compare(a.Schema, b.Schema); result != 0 {
+		return result, nil
+	}
+	return cmp.Compare(a.Revision, b.Revision), nil
+}
+
+// CheckUpgradeCompatibility compares the metadata of the charm archives an
+// application is upgrading from and to, returning a description of each
+// change which could break the upgrade. An empty slice means the charms are
+// compatible. The following are reported:
+//   - established relations, named in established, which are removed.
+//     Relations which are removed but not established are not reported.
+//   - relations whose interface or role changes.
+//   - storage which is removed, or whose type changes.
+//   - bases supported by from which are no longer supported by to.
+func CheckUpgradeCompatibility(from, to *CharmArchive, established []string) []string {
+	var changes []string
+	fromMeta, toMeta := from.Meta(), to.Meta()
+	if fromMeta != nil && toMeta != nil {
+		changes = append(changes, relationChanges(fromMeta, toMeta, established)...)
+		changes = append(changes, storageChanges(fromMeta, toMeta)...)
+	}
+	fromManifest, toManifest := from.Manifest(), to.Manifest()
+	if fromManifest != nil && toManifest != nil {
+		changes = append(changes, baseChanges(fromManifest, toManifest)...)
+	}
+	return changes
+}
+
+func relationChanges(from, to *Meta, established []string) []string {
+	inUse := make(map[string]bool, len(established))
+	for _, name := range established {
+		inUse[name] = true
+	}
+
+	fromRelations, toRelations := from.CombinedRelations(), to.CombinedRelations()
+	var changes []string
+	for _, name := range sortedKeys(fromRelations) {
+		fromRelation := fromRelations[name]
+		toRelation, ok := toRelations[name]
+		switch {
+		case !ok:
+			if inUse[name] {
+				changes = append(changes, fmt.Sprintf("established relation %q removed", name))
+			}
+		case fromRelation.Interface != toRelation.Interface:
+			changes = append(changes, fmt.Sprintf("relation %q interface changed from %q to %q",
+				name, fromRelation.Interface, toRelation.Interface))
+		case fromRelation.Role != toRelation.Role:
+			changes = append(changes, fmt.Sprintf("relation %q role changed from %q to %q",
+				name, fromRelation.Role, toRelation.Role))
+		}
+	}
+	return changes
+}
+
+func storageChanges(from, to *Meta) []string {
+	var changes []string
+	for _, name := range sortedKeys(from.Storage) {
+		fromStorage := from.Storage[name]
+		toStorage, ok := to.Storage[name]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("storage %q removed", name))
+		case fromStorage.Type != toStorage.Type:
+			changes = append(changes, fmt.Sprintf("storage %q type changed from %q to %q",
+				name, fromStorage.Type, toStorage.Type))
+		}
+	}
+	return changes
+}
+
+// baseChanges reports the bases of from which to no longer supports. Bases
+// are matched on name and channel track; the architectures of a base which
+// is still supported are compared individually, unless to lists none.
+func baseChanges(from, to *Manifest) []string {
+	supported := make(map[string]map[string]bool)
+	for _, base := range to.Bases {
+		key := baseKey(base)
+		if supported[key] == nil {
+			supported[key] = make(map[string]bool)
+		}
+		for _, arch := range base.Architectures {
+			supported[key][arch] = true
+		}
+	}
+
+	var changes []string
+	for _, base := range from.Bases {
+		key := baseKey(base)
+		archs, ok := supported[key]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("base %q no longer supported", key))
+			continue
+		}
+		if len(archs) == 0 {
+			continue
+		}
+		for _, arch := range base.Architectures {
+			if !archs[arch] {
+				changes = append(changes, fmt.Sprintf("base %q no longer supported on %s", key, arch))
+			}
+		}
+	}
+	return changes
+}
+
+func baseKey(base Base) string {
+	return fmt.Sprintf("%s@%s", base.Name, base.Channel.Track)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func charmName(a *CharmArchive) string {
+	if meta := a.Meta(); meta != nil {
+		return meta.Name
+	}
+	return ""
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charm_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/charm"
+	charmtesting "github.com/juju/juju/internal/charm/testing"
+)
+
+type CompareSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&CompareSuite{})
+
+func (s *CompareSuite) TestCompareRevisions(c *gc.C) {
+	older := s.archiveWithRevision(c, "dummy", 1)
+	newer := s.archiveWithRevision(c, "dummy", 2)
+
+	result, err := charm.CompareRevisions(older, newer)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.Equals, -1)
+
+	result, err = charm.CompareRevisions(newer, older)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.Equals, 1)
+
+	result, err = charm.CompareRevisions(older, older)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.Equals, 0)
+}
+
+func (s *CompareSuite) TestCompareRevisionsNotComparable(c *gc.C) {
+	dummy := s.archiveWithRevision(c, "dummy", 1)
+	other := s.archiveWithRevision(c, "varnish", 1)
+
+	_, err := charm.CompareRevisions(dummy, other)
+	c.Check(err, jc.ErrorIs, charm.NotComparable)
+
+	_, err = charm.CompareRevisions(dummy, nil)
+	c.Check(err, jc.ErrorIs, charm.NotComparable)
+}
+
+func (s *CompareSuite) TestCompareURLRevisions(c *gc.C) {
+	for _, t := range []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "ch:foo-1", b: "ch:foo-2", expected: -1},
+		{a: "ch:foo-2", b: "ch:foo-1", expected: 1},
+		{a: "ch:foo-2", b: "ch:foo-2", expected: 0},
+		{a: "local:foo-1", b: "local:foo-1", expected: 0},
+		// Different sources are ordered by source, regardless of revision.
+		{a: "ch:foo-10", b: "local:foo-1", expected: -1},
+		{a: "local:foo-1", b: "ch:foo-10", expected: 1},
+	} {
+		c.Logf("%s <=> %s", t.a, t.b)
+		result, err := charm.CompareURLRevisions(charm.MustParseURL(t.a), charm.MustParseURL(t.b))
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(result, gc.Equals, t.expected)
+	}
+}
+
+func (s *CompareSuite) TestCompareURLRevisionsNotComparable(c *gc.C) {
+	_, err := charm.CompareURLRevisions(charm.MustParseURL("ch:foo-1"), charm.MustParseURL("ch:bar-1"))
+	c.Check(err, jc.ErrorIs, charm.NotComparable)
+
+	_, err = charm.CompareURLRevisions(nil, charm.MustParseURL("ch:bar-1"))
+	c.Check(err, jc.ErrorIs, charm.NotComparable)
+}
+
+func (s *CompareSuite) archiveWithRevision(c *gc.C, name string, revision int) *charm.CharmArchive {
+	path := cloneDir(c, charmDirPath(c, name))
+	err := os.WriteFile(filepath.Join(path, "revision"), []byte(strconv.Itoa(revision)), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	dir, err := charmtesting.ReadCharmDir(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchive(archivePath(c, dir))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(archive.Revision(), gc.Equals, revision)
+	return archive
+}
+
+func (s *CompareSuite) TestCheckUpgradeCompatibility(c *gc.C) {
+	from := s.archiveWithMetadata(c, `
+name: dummy
+summary: summary
+description: description
+provides:
+  website: {interface: http}
+  db: {interface: mysql}
+requires:
+  cache: {interface: memcache}
+  logging: {interface: syslog}
+  backup: {interface: s3}
+peers:
+  cluster: {interface: cluster}
+storage:
+  data: {type: filesystem}
+  blocks: {type: block}
+  logs: {type: filesystem}
+`, `
+bases:
+  - name: ubuntu
+    channel: "20.04"
+    architectures: [amd64, arm64]
+  - name: ubuntu
+    channel: "22.04"
+    architectures: [amd64]
+`)
+	to := s.archiveWithMetadata(c, `
+name: dummy
+summary: summary
+description: description
+provides:
+  website: {interface: https}
+  cache: {interface: memcache}
+requires:
+  logging: {interface: syslog}
+peers:
+  cluster: {interface: cluster}
+storage:
+  data: {type: block}
+  logs: {type: filesystem}
+`, `
+bases:
+  - name: ubuntu
+    channel: "20.04/stable"
+    architectures: [amd64]
+`)
+
+	// The backup relation is removed too, but it isn't established.
+	established := []string{"db", "website", "logging"}
+	c.Check(charm.CheckUpgradeCompatibility(from, to, established), jc.DeepEquals, []string{
+		`relation "cache" role changed from "requirer" to "provider"`,
+		`established relation "db" removed`,
+		`relation "website" interface changed from "http" to "https"`,
+		`storage "blocks" removed`,
+		`storage "data" type changed from "filesystem" to "block"`,
+		`base "ubuntu@20.04" no longer supported on arm64`,
+		`base "ubuntu@22.04" no longer supported`,
+	})
+}
+
+func (s *CompareSuite) TestCheckUpgradeCompatibilityCompatible(c *gc.C) {
+	from := s.archiveWithMetadata(c, `
+name: dummy
+summary: summary
+description: description
+provides:
+  website: {interface: http}
+  admin: {interface: http}
+storage:
+  data: {type: filesystem}
+`, `
+bases:
+  - name: ubuntu
+    channel: "22.04"
+    architectures: [amd64]
+`)
+	to := s.archiveWithMetadata(c, `
+name: dummy
+summary: summary
+description: description
+provides:
+  website: {interface: http}
+  metrics: {interface: prometheus}
+storage:
+  data: {type: filesystem}
+  logs: {type: filesystem}
+`, `
+bases:
+  - name: ubuntu
+    channel: "22.04"
+    architectures: [amd64, arm64]
+  - name: ubuntu
+    channel: "24.04"
+    architectures: [amd64]
+`)
+
+	// The admin relation is removed, but it isn't established.
+	c.Check(charm.CheckUpgradeCompatibility(from, to, []string{"website"}), gc.HasLen, 0)
+}
+
+func (s *CompareSuite) archiveWithMetadata(c *gc.C, metadata, manifest string) *charm.CharmArchive {
+	path := cloneDir(c, charmDirPath(c, "dummy"))
+	err := os.WriteFile(filepath.Join(path, "metadata.yaml"), []byte(metadata), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+	err = os.WriteFile(filepath.Join(path, "manifest.yaml"), []byte(manifest), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	dir, err := charmtesting.ReadCharmDir(path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	archive, err := charm.ReadCharmArchive(archivePath(c, dir))
+	c.Assert(err, jc.ErrorIsNil)
+	return archive
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/schema"
@@ -187,6 +188,36 @@ func (c *Config) DefaultSettings() Settings {
 	return out
 }
 
+// OptionHelp returns a one-line help string for every option in the config,
+// keyed on option name. The help string combines the option type, its default
+// value, if it has one, and its description, with any line breaks in the
+// description collapsed. For example:
+//
+//	string (default "My Title"): A descriptive title.
+func (c *Config) OptionHelp() map[string]string {
+	out := make(map[string]string, len(c.Options))
+	for name, option := range c.Options {
+		out[name] = option.help()
+	}
+	return out
+}
+
+// help returns a one-line help string describing the option.
+func (option Option) help() string {
+	help := option.Type
+	switch v := option.Default.(type) {
+	case nil:
+	case string:
+		help += fmt.Sprintf(" (default %q)", v)
+	default:
+		help += fmt.Sprintf(" (default %v)", v)
+	}
+	if desc := strings.Join(strings.Fields(option.Description), " "); desc != "" {
+		help += ": " + desc
+	}
+	return help
+}
+
 // ValidateSettings returns a copy of the supplied settings with a consistent type
 // for each value. It returns an error if the settings contain unknown keys
 // or invalid values.
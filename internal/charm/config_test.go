@@ -110,6 +110,39 @@ func (s *ConfigSuite) TestDefaultSettings(c *gc.C) {
 	})
 }
 
+func (s *ConfigSuite) TestOptionHelp(c *gc.C) {
+	c.Assert(s.config.OptionHelp(), jc.DeepEquals, map[string]string{
+		"title":              `string (default "My Title"): A descriptive title used for the application.`,
+		"subtitle":           `string (default ""): An optional subtitle used for the application.`,
+		"username":           `string (default "admin001"): The name of the initial account (given admin permissions).`,
+		"outlook":            `string: No default outlook.`,
+		"skill-level":        `int: A number indicating skill.`,
+		"agility-ratio":      `float: A number from 0 to 1 indicating agility.`,
+		"reticulate-splines": `boolean: Whether to reticulate splines on launch, or not.`,
+		"secret-foo":         `secret: A secret value.`,
+	})
+}
+
+func (s *ConfigSuite) TestOptionHelpMultilineAndMissingDescription(c *gc.C) {
+	config, err := charm.ReadConfig(bytes.NewBuffer([]byte(`
+options:
+  verbose:
+    type: boolean
+    default: true
+  ratio:
+    type: float
+    default: 0.5
+    description: |
+      A ratio spread
+      over two lines.
+`)))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(config.OptionHelp(), jc.DeepEquals, map[string]string{
+		"verbose": "boolean (default true)",
+		"ratio":   "float (default 0.5): A ratio spread over two lines.",
+	})
+}
+
 func (s *ConfigSuite) TestFilterSettings(c *gc.C) {
 	settings := s.config.FilterSettings(charm.Settings{
 		"title":              "something valid",
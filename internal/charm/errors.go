@@ -9,4 +9,16 @@ const (
 	// FileNotFound describes an error that occurs when a file is not found in
 	// a charm dir.
 	FileNotFound = errors.ConstError("file not found")
+
+	// NotComparable describes an error that occurs when two charms can't be
+	// compared, because they don't share the same reference name.
+	NotComparable = errors.ConstError("charms not comparable")
+
+	// TooManyArchiveMembers describes an error that occurs when a charm
+	// archive holds more entries than the reader is permitted to accept.
+	TooManyArchiveMembers = errors.ConstError("too many archive members")
+
+	// ArchiveHashMismatch describes an error that occurs when the SHA256 hash
+	// of a charm archive doesn't match the expected hash.
+	ArchiveHashMismatch = errors.ConstError("charm archive hash mismatch")
 )
@@ -4,7 +4,11 @@
 package charm
 
 import (
+	"cmp"
 	"io"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/schema"
@@ -20,6 +24,12 @@ type Manifest struct {
 	Bases []Base `yaml:"bases"`
 }
 
+// IsEmpty returns true if the manifest is nil or has no bases. The manifest
+// of a charm without a manifest.yaml file is nil.
+func (m *Manifest) IsEmpty() bool {
+	return m == nil || len(m.Bases) == 0
+}
+
 // Validate checks the manifest to ensure there are no empty names, nor channels,
 // and that architectures are supported.
 func (m *Manifest) Validate() error {
@@ -31,6 +41,86 @@ func (m *Manifest) Validate() error {
 	return nil
 }
 
+// NormalizedBases returns the manifest bases with duplicates removed, in a
+// deterministic order. Bases are duplicates if they have the same name,
+// track and architectures; of those, the base with the most stable risk
+// is kept. The returned bases are sorted by name, track, and then
+// architectures, where tracks are compared version-wise, so "9" sorts
+// before "10" and "20.04" before "22.04".
+func (m *Manifest) NormalizedBases() []Base {
+	type baseKey struct {
+		name, track, arches string
+	}
+	var keys []baseKey
+	bases := make(map[baseKey]Base)
+	for _, b := range m.Bases {
+		arches := slices.Clone(b.Architectures)
+		slices.Sort(arches)
+		b.Architectures = slices.Compact(arches)
+
+		key := baseKey{
+			name:   b.Name,
+			track:  b.Channel.Track,
+			arches: strings.Join(b.Architectures, ","),
+		}
+		existing, ok := bases[key]
+		if !ok {
+			keys = append(keys, key)
+			bases[key] = b
+			continue
+		}
+		if riskIndex(b.Channel.Risk) < riskIndex(existing.Channel.Risk) {
+			bases[key] = b
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b baseKey) int {
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		if c := compareTracks(a.track, b.track); c != 0 {
+			return c
+		}
+		return strings.Compare(a.arches, b.arches)
+	})
+	result := make([]Base, len(keys))
+	for i, key := range keys {
+		result[i] = bases[key]
+	}
+	return result
+}
+
+// compareTracks compares two tracks component by component, split on
+// dots. Components which are both numbers are compared numerically,
+// anything else is compared lexically. If one track is a prefix of the
+// other, the shorter track sorts first.
+func compareTracks(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		var c int
+		if aErr == nil && bErr == nil {
+			c = cmp.Compare(aNum, bNum)
+		} else {
+			c = strings.Compare(aParts[i], bParts[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(aParts), len(bParts))
+}
+
+// riskIndex returns the position of the risk in Risks, so that more
+// stable risks have lower indexes. Unknown risks sort last.
+func riskIndex(risk Risk) int {
+	if i := slices.Index(Risks, risk); i >= 0 {
+		return i
+	}
+	return len(Risks)
+}
+
 func (m *Manifest) UnmarshalYAML(f func(interface{}) error) error {
 	raw := make(map[interface{}]interface{})
 	err := f(&raw)
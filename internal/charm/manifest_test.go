@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 )
 
@@ -62,3 +63,90 @@ func (s *manifestSuite) TestValidateManifest(c *gc.C) {
 	}
 	c.Assert(manifest.Validate(), gc.ErrorMatches, "validating manifest: base without name not valid")
 }
+
+func (s *manifestSuite) TestIsEmpty(c *gc.C) {
+	var nilManifest *Manifest
+	c.Check(nilManifest.IsEmpty(), jc.IsTrue)
+	c.Check((&Manifest{}).IsEmpty(), jc.IsTrue)
+	c.Check((&Manifest{Bases: []Base{{Name: "ubuntu"}}}).IsEmpty(), jc.IsFalse)
+}
+
+func (s *manifestSuite) TestNormalizedBases(c *gc.C) {
+	manifest := &Manifest{Bases: []Base{{
+		Name:          "ubuntu",
+		Channel:       Channel{Track: "22.04", Risk: Edge},
+		Architectures: []string{"arm64", "amd64"},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "20.04", Risk: Stable},
+	}, {
+		Name:          "ubuntu",
+		Channel:       Channel{Track: "22.04", Risk: Candidate},
+		Architectures: []string{"amd64", "arm64"},
+	}, {
+		Name:    "centos",
+		Channel: Channel{Track: "7", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "20.04", Risk: Beta},
+	}, {
+		Name:          "ubuntu",
+		Channel:       Channel{Track: "22.04", Risk: Stable},
+		Architectures: []string{"s390x"},
+	}}}
+
+	c.Assert(manifest.NormalizedBases(), gc.DeepEquals, []Base{{
+		Name:    "centos",
+		Channel: Channel{Track: "7", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "20.04", Risk: Stable},
+	}, {
+		Name:          "ubuntu",
+		Channel:       Channel{Track: "22.04", Risk: Candidate},
+		Architectures: []string{"amd64", "arm64"},
+	}, {
+		Name:          "ubuntu",
+		Channel:       Channel{Track: "22.04", Risk: Stable},
+		Architectures: []string{"s390x"},
+	}})
+
+	// The manifest itself is left untouched.
+	c.Assert(manifest.Bases[0].Architectures, gc.DeepEquals, []string{"arm64", "amd64"})
+}
+
+func (s *manifestSuite) TestNormalizedBasesSortsTracksByVersion(c *gc.C) {
+	manifest := &Manifest{Bases: []Base{{
+		Name:    "centos",
+		Channel: Channel{Track: "10", Risk: Stable},
+	}, {
+		Name:    "centos",
+		Channel: Channel{Track: "9", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "22.10", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "22.04", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "22.04.1", Risk: Stable},
+	}}}
+
+	c.Assert(manifest.NormalizedBases(), gc.DeepEquals, []Base{{
+		Name:    "centos",
+		Channel: Channel{Track: "9", Risk: Stable},
+	}, {
+		Name:    "centos",
+		Channel: Channel{Track: "10", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "22.04", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "22.04.1", Risk: Stable},
+	}, {
+		Name:    "ubuntu",
+		Channel: Channel{Track: "22.10", Risk: Stable},
+	}})
+}
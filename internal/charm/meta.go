@@ -17,6 +17,7 @@ import (
 	"github.com/juju/utils/v4"
 	"gopkg.in/yaml.v2"
 
+	coreassumes "github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/internal/charm/assumes"
 	"github.com/juju/juju/internal/charm/hooks"
@@ -300,6 +301,34 @@ func (m Meta) Hooks() map[string]bool {
 	return allHooks
 }
 
+// RelationHooks returns the names of the relation hooks for the named
+// endpoint, in the order the hook kinds are defined. If the charm doesn't
+// declare the endpoint, nil is returned.
+func (m Meta) RelationHooks(endpoint string) []string {
+	if _, ok := m.CombinedRelations()[endpoint]; !ok {
+		return nil
+	}
+	var result []string
+	for _, hookName := range hooks.RelationHooks() {
+		result = append(result, fmt.Sprintf("%s-%s", endpoint, hookName))
+	}
+	return result
+}
+
+// StorageHooks returns the names of the storage hooks for the named storage,
+// in the order the hook kinds are defined. If the charm doesn't declare the
+// storage, nil is returned.
+func (m Meta) StorageHooks(storage string) []string {
+	if _, ok := m.Storage[storage]; !ok {
+		return nil
+	}
+	var result []string
+	for _, hookName := range hooks.StorageHooks() {
+		result = append(result, fmt.Sprintf("%s-%s", storage, hookName))
+	}
+	return result
+}
+
 // Used for parsing Categories and Tags.
 func parseStringList(list interface{}) []string {
 	if list == nil {
@@ -829,6 +858,82 @@ func (m Meta) CombinedRelations() map[string]Relation {
 	return combined
 }
 
+// IsSubordinate reports whether the charm declares itself to be a
+// subordinate charm.
+func (m Meta) IsSubordinate() bool {
+	return m.Subordinate
+}
+
+// ScopedRelations returns the defined relations, keyed by name, that have
+// container scope. Subordinate charms require at least one such relation to
+// relate to their principal.
+func (m Meta) ScopedRelations() map[string]Relation {
+	scoped := make(map[string]Relation)
+	for name, relation := range m.CombinedRelations() {
+		if relation.Scope == ScopeContainer {
+			scoped[name] = relation
+		}
+	}
+	return scoped
+}
+
+// ResourcesForContainer returns the resources, keyed by name, that are used
+// by the named container. An empty map is returned if the charm doesn't
+// define the container, or the container doesn't reference any resources.
+func (m Meta) ResourcesForContainer(name string) map[string]resource.Meta {
+	result := make(map[string]resource.Meta)
+	container, ok := m.Containers[name]
+	if !ok || container.Resource == "" {
+		return result
+	}
+	if res, ok := m.Resources[container.Resource]; ok {
+		result[container.Resource] = res
+	}
+	return result
+}
+
+// OCIImageResources returns the sorted names of the charm's oci-image
+// resources. An empty slice is returned if the charm doesn't define any.
+func (m Meta) OCIImageResources() []string {
+	names := []string{}
+	for name, res := range m.Resources {
+		if res.Type == resource.TypeContainerImage {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StorageByName returns the storage declared by the charm with the input
+// name, and whether it exists.
+func (m Meta) StorageByName(name string) (Storage, bool) {
+	store, ok := m.Storage[name]
+	return store, ok
+}
+
+// RequiredStorage returns the sorted names of the charm's storage that must
+// have at least one instance attached; that is, those with a minimum count
+// greater than zero.
+func (m Meta) RequiredStorage() []string {
+	names := []string{}
+	for name, store := range m.Storage {
+		if store.CountMin > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CheckAssumes checks that the provided feature set satisfies the charm's
+// assumes expression. If it doesn't, the returned error is a
+// [coreassumes.RequirementsNotSatisfiedError] describing which assumptions
+// failed. Charms without an assumes block are always satisfied.
+func (m Meta) CheckAssumes(features coreassumes.FeatureSet) error {
+	return features.Satisfies(m.Assumes)
+}
+
 // Schema coercer that expands the interface shorthand notation.
 // A consistent format is easier to work with than considering the
 // potential difference everywhere.
@@ -16,6 +16,7 @@ import (
 	gc "gopkg.in/check.v1"
 	"gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charm/resource"
@@ -322,6 +323,31 @@ func (s *MetaSuite) TestCheckSubordinateWithoutContainerRelation(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "subordinate charm \"dummy\" lacks \"requires\" relation with container scope")
 }
 
+func (s *MetaSuite) TestIsSubordinate(c *gc.C) {
+	meta, err := charm.ReadMeta(repoMeta(c, "logging"))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.IsSubordinate(), jc.IsTrue)
+
+	meta, err = charm.ReadMeta(repoMeta(c, "dummy"))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.IsSubordinate(), jc.IsFalse)
+}
+
+func (s *MetaSuite) TestScopedRelations(c *gc.C) {
+	meta, err := charm.ReadMeta(repoMeta(c, "logging"))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.ScopedRelations(), jc.DeepEquals, map[string]charm.Relation{
+		"info":              meta.Requires["info"],
+		"logging-directory": meta.Requires["logging-directory"],
+	})
+}
+
+func (s *MetaSuite) TestScopedRelationsNone(c *gc.C) {
+	meta, err := charm.ReadMeta(repoMeta(c, "riak"))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.ScopedRelations(), gc.HasLen, 0)
+}
+
 func (s *MetaSuite) TestScopeConstraint(c *gc.C) {
 	meta, err := charm.ReadMeta(repoMeta(c, "logging"))
 	c.Assert(err, gc.IsNil)
@@ -624,6 +650,37 @@ func (s *MetaSuite) TestMetaHooks(c *gc.C) {
 	c.Assert(hooks, jc.DeepEquals, expectedHooks)
 }
 
+func (s *MetaSuite) TestRelationHooks(c *gc.C) {
+	meta, err := charm.ReadMeta(repoMeta(c, "wordpress"))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.RelationHooks("db"), jc.DeepEquals, []string{
+		"db-relation-created",
+		"db-relation-joined",
+		"db-relation-changed",
+		"db-relation-departed",
+		"db-relation-broken",
+	})
+	c.Check(meta.RelationHooks("url"), gc.HasLen, 5)
+	c.Check(meta.RelationHooks("unknown"), gc.HasLen, 0)
+}
+
+func (s *MetaSuite) TestStorageHooks(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+storage:
+  data:
+    type: filesystem
+`))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.StorageHooks("data"), jc.DeepEquals, []string{
+		"data-storage-attached",
+		"data-storage-detaching",
+	})
+	c.Check(meta.StorageHooks("unknown"), gc.HasLen, 0)
+}
+
 func (s *MetaSuite) TestCodecRoundTripEmpty(c *gc.C) {
 	for _, codec := range codecs {
 		c.Logf("codec %s", codec.Name)
@@ -1126,6 +1183,65 @@ storage:
 	})
 }
 
+func (s *MetaSuite) TestStorageByName(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+storage:
+    data:
+        type: filesystem
+        multiple:
+            range: 0-
+        minimum-size: 10G
+`))
+	c.Assert(err, gc.IsNil)
+
+	store, ok := meta.StorageByName("data")
+	c.Assert(ok, jc.IsTrue)
+	c.Check(store, jc.DeepEquals, charm.Storage{
+		Name:        "data",
+		Type:        charm.StorageFilesystem,
+		CountMin:    0,
+		CountMax:    -1,
+		MinimumSize: 10 * 1024,
+	})
+
+	_, ok = meta.StorageByName("missing")
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *MetaSuite) TestRequiredStorage(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+storage:
+    logs:
+        type: filesystem
+        multiple:
+            range: 2-4
+    cache:
+        type: filesystem
+        multiple:
+            range: 0-2
+    data:
+        type: block
+`))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.RequiredStorage(), jc.DeepEquals, []string{"data", "logs"})
+}
+
+func (s *MetaSuite) TestRequiredStorageNoStorage(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+`))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.RequiredStorage(), gc.HasLen, 0)
+}
+
 func (s *MetaSuite) TestStorageErrors(c *gc.C) {
 	prefix := `
 name: a
@@ -1529,6 +1645,82 @@ storage:
 	})
 }
 
+func (s *MetaSuite) TestResourcesForContainer(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+containers:
+  foo:
+    resource: test-os
+  bar:
+    mounts:
+      - storage: a
+        location: /b/
+resources:
+  test-os:
+    type: oci-image
+    description: the os
+  other:
+    type: file
+    filename: other.json
+storage:
+  a:
+    type: filesystem
+`))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.ResourcesForContainer("foo"), jc.DeepEquals, map[string]resource.Meta{
+		"test-os": {
+			Name:        "test-os",
+			Type:        resource.TypeContainerImage,
+			Description: "the os",
+		},
+	})
+	c.Check(meta.ResourcesForContainer("bar"), jc.DeepEquals, map[string]resource.Meta{})
+	c.Check(meta.ResourcesForContainer("baz"), jc.DeepEquals, map[string]resource.Meta{})
+}
+
+func (s *MetaSuite) TestOCIImageResources(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+resources:
+  web-image:
+    type: oci-image
+    description: the web server
+  db-image:
+    type: oci-image
+  config:
+    type: file
+    filename: config.yaml
+`))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.Resources["config"].Path, gc.Equals, "config.yaml")
+	c.Check(meta.OCIImageResources(), jc.DeepEquals, []string{"db-image", "web-image"})
+}
+
+func (s *MetaSuite) TestOCIImageResourcesNoResources(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+`))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.OCIImageResources(), gc.HasLen, 0)
+}
+
+func (s *MetaSuite) TestResourcesForContainerNoContainers(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+`))
+	c.Assert(err, gc.IsNil)
+	c.Check(meta.Containers, gc.HasLen, 0)
+	c.Check(meta.ResourcesForContainer("foo"), jc.DeepEquals, map[string]resource.Meta{})
+}
+
 func intPtr(i int) *int {
 	return &i
 }
@@ -1777,3 +1969,56 @@ charm-user: barry
 `))
 	c.Assert(err, gc.ErrorMatches, `parsing charm-user: invalid charm-user "barry" expected one of root, sudoer or non-root`)
 }
+
+func (s *MetaSuite) TestCheckAssumes(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: assumes-charm
+description: d
+summary: s
+assumes:
+- juju >= 3.1
+- any-of:
+  - k8s-api
+  - lxd
+`))
+	c.Assert(err, jc.ErrorIsNil)
+
+	var features assumes.FeatureSet
+	features.Add(
+		assumes.JujuFeature(semversion.MustParse("3.2.0")),
+		assumes.K8sAPIFeature(semversion.MustParse("1.27.0")),
+	)
+	err = meta.CheckAssumes(features)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *MetaSuite) TestCheckAssumesNotSatisfied(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: assumes-charm
+description: d
+summary: s
+assumes:
+- juju >= 3.1
+- k8s-api
+`))
+	c.Assert(err, jc.ErrorIsNil)
+
+	var features assumes.FeatureSet
+	features.Add(assumes.JujuFeature(semversion.MustParse("3.0.0")))
+	err = meta.CheckAssumes(features)
+	c.Assert(err, jc.Satisfies, assumes.IsRequirementsNotSatisfiedError)
+	c.Check(err, gc.ErrorMatches, `(?s).*charm requires Juju version >= 3.1.0, model has version 3.0.0.*`)
+	c.Check(err, gc.ErrorMatches, `(?s).*charm must be deployed on a Kubernetes cloud.*`)
+}
+
+func (s *MetaSuite) TestCheckAssumesNoAssumesBlock(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: no-assumes
+description: d
+summary: s
+`))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = meta.CheckAssumes(assumes.FeatureSet{})
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -34,6 +34,15 @@ func (t *txnRunner) Txn(ctx context.Context, fn func(context.Context, *sqlair.TX
 	})
 }
 
+// ReadTxn executes the input function against the tracked database, using
+// the sqlair package, within a read-only transaction.
+// Retry semantics are applied automatically based on transient failures.
+func (t *txnRunner) ReadTxn(ctx context.Context, fn func(context.Context, *sqlair.TX) error) error {
+	return defaultTransactionRunner.Retry(ctx, func() error {
+		return errors.Trace(defaultTransactionRunner.ReadTxn(ctx, t.db, fn))
+	})
+}
+
 // StdTxn executes the input function against the tracked database,
 // within a transaction that depends on the input context.
 // Retry semantics are applied automatically based on transient failures.
@@ -48,6 +48,14 @@ func (t *TestTrackedDB) Txn(ctx context.Context, fn func(context.Context, *sqlai
 	return db.Txn(ctx, fn)
 }
 
+func (t *TestTrackedDB) ReadTxn(ctx context.Context, fn func(context.Context, *sqlair.TX) error) error {
+	db, err := t.factory()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return coredatabase.ReadTxn(ctx, db, fn)
+}
+
 func (t *TestTrackedDB) StdTxn(ctx context.Context, fn func(context.Context, *sql.Tx) error) error {
 	db, err := t.factory()
 	if err != nil {
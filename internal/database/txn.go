@@ -29,6 +29,16 @@ func Txn(ctx context.Context, db *sqlair.DB, fn func(context.Context, *sqlair.TX
 	return defaultTransactionRunner.Txn(ctx, db, fn)
 }
 
+// ReadTxn executes the input function against the tracked database, using
+// the sqlair package, in a read-only transaction.
+// Retry semantics are applied automatically based on transient failures.
+//
+// This should not be used directly, instead the TxnRunner should be used to
+// handle transactions.
+func ReadTxn(ctx context.Context, db *sqlair.DB, fn func(context.Context, *sqlair.TX) error) error {
+	return defaultTransactionRunner.ReadTxn(ctx, db, fn)
+}
+
 // StdTxn defines a generic txn function for applying transactions on a given
 // database. It expects that no individual transaction function should take
 // longer than the default timeout.
@@ -138,8 +138,22 @@ func NewRetryingTxnRunner(opts ...Option) *RetryingTxnRunner {
 // This should not be used directly, instead the TxnRunner should be used to
 // handle transactions.
 func (t *RetryingTxnRunner) Txn(ctx context.Context, db *sqlair.DB, fn func(context.Context, *sqlair.TX) error) error {
+	return t.txn(ctx, db, nil, fn)
+}
+
+// ReadTxn executes the input function against the tracked database, using
+// the sqlair package, in a transaction begun with the read-only option.
+// Retry semantics are applied automatically based on transient failures.
+//
+// This should not be used directly, instead the TxnRunner should be used to
+// handle transactions.
+func (t *RetryingTxnRunner) ReadTxn(ctx context.Context, db *sqlair.DB, fn func(context.Context, *sqlair.TX) error) error {
+	return t.txn(ctx, db, &sqlair.TXOptions{ReadOnly: true}, fn)
+}
+
+func (t *RetryingTxnRunner) txn(ctx context.Context, db *sqlair.DB, opts *sqlair.TXOptions, fn func(context.Context, *sqlair.TX) error) error {
 	return t.run(ctx, func(ctx context.Context) error {
-		tx, err := db.Begin(ctx, nil)
+		tx, err := db.Begin(ctx, opts)
 		if err != nil {
 			return errors.Trace(err)
 		}
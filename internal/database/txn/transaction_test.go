@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/canonical/sqlair"
 	"github.com/juju/errors"
 	jujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -190,6 +191,24 @@ func (s *transactionRunnerSuite) TestTxnInserts(c *gc.C) {
 	}
 }
 
+func (s *transactionRunnerSuite) TestReadTxn(c *gc.C) {
+	runner := txn.NewRetryingTxnRunner()
+
+	s.createTable(c)
+	_, err := s.DB().Exec("INSERT INTO foo (id, name) VALUES (1, 'test')")
+	c.Assert(err, jc.ErrorIsNil)
+
+	stmt, err := sqlair.Prepare("SELECT COUNT(*) AS &count.n FROM foo", count{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var result count
+	err = runner.ReadTxn(context.Background(), sqlair.NewDB(s.DB()), func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt).Get(&result)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.N, gc.Equals, 1)
+}
+
 func (s *transactionRunnerSuite) TestTxnRollback(c *gc.C) {
 	runner := txn.NewRetryingTxnRunner()
 
@@ -279,3 +298,7 @@ func (s *transactionRunnerSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+type count struct {
+	N int `db:"n"`
+}
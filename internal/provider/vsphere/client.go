@@ -29,11 +29,13 @@ type Client interface {
 	DeleteDatastoreFile(context.Context, string) error
 	DestroyVMFolder(context.Context, string) error
 	EnsureVMFolder(context.Context, string, string) (*object.Folder, error)
-	GetTargetDatastore(ctx context.Context, computeResource *mo.ComputeResource, rootDiskSource string) (*object.Datastore, error)
+	GetTargetDatastore(ctx context.Context, computeResource *mo.ComputeResource, rootDiskSource string, selection vsphereclient.DatastoreSelection) (*object.Datastore, error)
 	ListVMTemplates(ctx context.Context, path string) ([]*object.VirtualMachine, error)
 	MoveVMFolderInto(context.Context, string, string) error
 	MoveVMsInto(context.Context, string, ...types.ManagedObjectReference) error
 	RemoveVirtualMachines(context.Context, string) error
+	RemoveVirtualMachinesMatching(context.Context, string, map[string]string) error
+	ResourcePool(context.Context, string) (*object.ResourcePool, error)
 	ResourcePools(context.Context, string) ([]*object.ResourcePool, error)
 	UpdateVirtualMachineExtraConfig(context.Context, *mo.VirtualMachine, map[string]string) error
 	VirtualMachines(context.Context, string) ([]*mo.VirtualMachine, error)
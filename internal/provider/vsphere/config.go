@@ -22,6 +22,8 @@ const (
 	cfgForceVMHardwareVersion = "force-vm-hardware-version"
 	cfgEnableDiskUUID         = "enable-disk-uuid"
 	cfgDiskProvisioningType   = "disk-provisioning-type"
+	cfgDatastoreStrategy      = "vsphere-datastore-strategy"
+	cfgResourcePool           = "vsphere-resource-pool"
 )
 
 // configFields is the spec for each vmware config value's type.
@@ -51,6 +53,14 @@ var (
 			Description: "Specify how the disk should be provisioned when cloning the VM template. Allowed values are: thickEagerZero (default), thick and thin.",
 			Type:        configschema.Tstring,
 		},
+		cfgDatastoreStrategy: {
+			Description: "How to choose between multiple accessible datastores when no datastore is specified. Allowed values are: most-free and round-robin. If this is not specified, the process will abort unless there is only one datastore available.",
+			Type:        configschema.Tstring,
+		},
+		cfgResourcePool: {
+			Description: "The inventory path of the resource pool in which to create VMs, such as \"Cluster1/Resources/Pool1\". The pool must belong to the cluster or host of the chosen availability zone. If this is not specified, VMs are created in the resource pool of the chosen availability zone.",
+			Type:        configschema.Tstring,
+		},
 	}
 
 	configDefaults = schema.Defaults{
@@ -60,6 +70,8 @@ var (
 		cfgForceVMHardwareVersion: int(0),
 		cfgEnableDiskUUID:         true,
 		cfgDiskProvisioningType:   string(vsphereclient.DiskTypeThick),
+		cfgDatastoreStrategy:      schema.Omit,
+		cfgResourcePool:           schema.Omit,
 	}
 
 	configRequiredFields  = []string{}
@@ -168,6 +180,16 @@ func (c *environConfig) diskProvisioningType() vsphereclient.DiskProvisioningTyp
 	return vsphereclient.DiskProvisioningType(provTypeStr)
 }
 
+func (c *environConfig) datastoreStrategy() vsphereclient.DatastoreStrategy {
+	strategy, _ := c.attrs[cfgDatastoreStrategy].(string)
+	return vsphereclient.DatastoreStrategy(strategy)
+}
+
+func (c *environConfig) resourcePool() string {
+	pool, _ := c.attrs[cfgResourcePool].(string)
+	return pool
+}
+
 // Schema returns the configuration schema for an environment.
 func (environProvider) Schema() configschema.Fields {
 	fields, err := config.Schema(configSchema)
@@ -224,6 +246,27 @@ func (c environConfig) validate() error {
 			}
 		}
 	}
+
+	if strategy, ok := c.attrs[cfgDatastoreStrategy]; ok {
+		strategyStr, ok := strategy.(string)
+		if !ok {
+			return errors.Errorf("%s must be a string", cfgDatastoreStrategy)
+		}
+
+		if strategyStr != "" {
+			found := false
+			for _, val := range vsphereclient.ValidDatastoreStrategies {
+				if vsphereclient.DatastoreStrategy(strategyStr) == val {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return errors.Errorf(
+					"%q must be one of %q", cfgDatastoreStrategy, vsphereclient.ValidDatastoreStrategies)
+			}
+		}
+	}
 	return nil
 }
 
@@ -140,6 +140,21 @@ var newConfigTests = []configTestSpec{
 		insert: testing.Attrs{"disk-provisioning-type": "eroneous"},
 		err:    "\"disk-provisioning-type\" must be one of.*",
 	},
+	{
+		info:   "use round-robin datastore strategy",
+		insert: testing.Attrs{"vsphere-datastore-strategy": "round-robin"},
+		expect: testing.Attrs{"vsphere-datastore-strategy": "round-robin"},
+	},
+	{
+		info:   "set invalid datastore strategy",
+		insert: testing.Attrs{"vsphere-datastore-strategy": "least-used"},
+		err:    "\"vsphere-datastore-strategy\" must be one of.*",
+	},
+	{
+		info:   "use resource pool",
+		insert: testing.Attrs{"vsphere-resource-pool": "z1/Resources/child"},
+		expect: testing.Attrs{"vsphere-resource-pool": "z1/Resources/child"},
+	},
 }
 
 func (*ConfigSuite) TestNewModelConfig(c *gc.C) {
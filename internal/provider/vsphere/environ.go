@@ -16,7 +16,9 @@ import (
 	"github.com/juju/juju/environs"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/provider/common"
+	"github.com/juju/juju/internal/provider/vsphere/internal/vsphereclient"
 )
 
 // Note: This provider/environment does *not* implement storage.
@@ -33,6 +35,11 @@ type environ struct {
 
 	lock sync.Mutex // lock protects access the following fields.
 	ecfg *environConfig
+
+	// datastoreSequence is incremented each time a datastore is selected,
+	// so that the round-robin datastore strategy spreads VMs across
+	// datastores.
+	datastoreSequence int
 }
 
 func newEnviron(
@@ -104,6 +111,19 @@ func (env *environ) SetConfig(ctx context.Context, cfg *config.Config) error {
 	return nil
 }
 
+// nextDatastoreSelection returns the parameters for selecting a datastore
+// for the next VM.
+func (env *environ) nextDatastoreSelection() vsphereclient.DatastoreSelection {
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	selection := vsphereclient.DatastoreSelection{
+		Strategy: env.ecfg.datastoreStrategy(),
+		Sequence: env.datastoreSequence,
+	}
+	env.datastoreSequence++
+	return selection
+}
+
 // Config is part of the environs.Environ interface.
 func (env *environ) Config() *config.Config {
 	env.lock.Lock()
@@ -150,7 +170,10 @@ func (env *environ) Bootstrap(
 	// as the Environ will be used during instance finalization after
 	// the Bootstrap method returns, and the session will be invalid.
 	if err := env.withSession(ctx, func(senv *sessionEnviron) error {
-		return senv.ensureVMFolder(args.ControllerConfig.ControllerUUID(), ctx)
+		if err := senv.ensureVMFolder(args.ControllerConfig.ControllerUUID(), ctx); err != nil {
+			return errors.Trace(err)
+		}
+		return senv.checkResourcePool(ctx)
 	}); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -172,12 +195,41 @@ func (senv *sessionEnviron) ensureVMFolder(controllerUUID string, ctx context.Co
 	return errors.Trace(senv.handleCredentialError(ctx, err))
 }
 
+// checkResourcePool ensures that the resource pool named in the model
+// config, if any, exists.
+func (senv *sessionEnviron) checkResourcePool(ctx context.Context) error {
+	poolPath := senv.ecfg.resourcePool()
+	if poolPath == "" {
+		return nil
+	}
+	_, err := senv.client.ResourcePool(senv.ctx, poolPath)
+	return errors.Trace(senv.handleCredentialError(ctx, err))
+}
+
 // DestroyEnv is exported, because it has to be rewritten in external unit tests.
 var DestroyEnv = common.Destroy
 
-// AdoptResources is part of the Environ interface.
+// AdoptResources is part of the Environ interface. If moving the model
+// folder fails because the session has expired, the client is re-dialled
+// and the move is retried once. Any other error, including an authorisation
+// failure, is handled without retrying, since a new session with the same
+// credential won't fix it.
 func (env *environ) AdoptResources(ctx context.Context, controllerUUID string, fromVersion semversion.Number) error {
 	// Move model folder into the controller's folder.
+	var sessionExpired bool
+	err := env.withSession(ctx, func(senv *sessionEnviron) error {
+		err := senv.moveModelFolderInto(controllerUUID)
+		if isSessionExpired(err) {
+			sessionExpired = true
+			return err
+		}
+		return senv.handleCredentialError(ctx, err)
+	})
+	if !sessionExpired {
+		return err
+	}
+
+	logger.Debugf(ctx, "retrying adopting resources with a new session: %v", err)
 	return env.withSession(ctx, func(senv *sessionEnviron) error {
 		return senv.AdoptResources(ctx, controllerUUID, fromVersion)
 	})
@@ -185,11 +237,17 @@ func (env *environ) AdoptResources(ctx context.Context, controllerUUID string, f
 
 // AdoptResources is part of the Environ interface.
 func (senv *sessionEnviron) AdoptResources(ctx context.Context, controllerUUID string, fromVersion semversion.Number) error {
-	err := senv.client.MoveVMFolderInto(senv.ctx,
+	err := senv.moveModelFolderInto(controllerUUID)
+	return senv.handleCredentialError(ctx, err)
+}
+
+// moveModelFolderInto moves the model folder into the folder of the
+// controller with the given UUID.
+func (senv *sessionEnviron) moveModelFolderInto(controllerUUID string) error {
+	return senv.client.MoveVMFolderInto(senv.ctx,
 		path.Join(senv.getVMFolder(), controllerFolderName(controllerUUID)),
 		path.Join(senv.getVMFolder(), controllerFolderName("*"), senv.modelFolderName()),
 	)
-	return senv.handleCredentialError(ctx, err)
 }
 
 // Destroy is part of the environs.Environ interface.
@@ -227,8 +285,9 @@ func (senv *sessionEnviron) DestroyController(ctx context.Context, controllerUUI
 		return errors.Trace(err)
 	}
 	controllerFolderName := controllerFolderName(controllerUUID)
-	if err := senv.client.RemoveVirtualMachines(senv.ctx,
+	if err := senv.client.RemoveVirtualMachinesMatching(senv.ctx,
 		path.Join(senv.getVMFolder(), controllerFolderName, modelFolderName("*", "*"), "*"),
+		map[string]string{tags.JujuController: controllerUUID},
 	); err != nil {
 		return errors.Annotate(senv.handleCredentialError(ctx, err), "removing VMs")
 	}
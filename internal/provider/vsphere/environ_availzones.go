@@ -19,9 +19,10 @@ import (
 )
 
 type vmwareAvailZone struct {
-	r    mo.ComputeResource
-	pool *object.ResourcePool
-	name string
+	r      mo.ComputeResource
+	crPath string
+	pool   *object.ResourcePool
+	name   string
 }
 
 // Name returns the "name" of the Vsphere availability zone.
@@ -77,9 +78,10 @@ func (senv *sessionEnviron) AvailabilityZones(ctx context.Context) (network.Avai
 		}
 		for _, pool := range pools {
 			zone := &vmwareAvailZone{
-				r:    *cr.Resource,
-				pool: pool,
-				name: makeAvailZoneName(hostFolder, cr.Path, pool.InventoryPath),
+				r:      *cr.Resource,
+				crPath: cr.Path,
+				pool:   pool,
+				name:   makeAvailZoneName(hostFolder, cr.Path, pool.InventoryPath),
 			}
 			logger.Tracef(ctx, "zone: %s (cr.Name=%q pool.InventoryPath=%q)",
 				zone.Name(), zone.r.Name, zone.pool.InventoryPath)
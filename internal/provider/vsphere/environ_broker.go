@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/instances"
 	"github.com/juju/juju/environs/simplestreams"
+	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/cloudconfig/cloudinit"
 	"github.com/juju/juju/internal/cloudconfig/instancecfg"
 	"github.com/juju/juju/internal/cloudconfig/providerinit"
@@ -140,7 +142,23 @@ func (senv *sessionEnviron) newRawInstance(
 		return nil, nil, errors.Trace(err)
 	}
 
-	datastore, err := senv.client.GetTargetDatastore(senv.ctx, &availZone.r, *cons.RootDiskSource)
+	poolRef := availZone.pool.Reference()
+	if poolPath := senv.ecfg.resourcePool(); poolPath != "" {
+		pool, err := senv.client.ResourcePool(senv.ctx, poolPath)
+		if err != nil {
+			return nil, nil, environs.ZoneIndependentError(senv.handleCredentialError(ctx, err))
+		}
+		// The pool must belong to the zone's cluster or host, otherwise
+		// the VM would be placed outside the zone it was asked for. This
+		// is zone specific, so the other zones are still tried.
+		if !strings.HasPrefix(pool.InventoryPath, availZone.crPath+"/") {
+			return nil, nil, errors.NewNotValid(nil, fmt.Sprintf(
+				"resource pool %q is not in availability zone %q", poolPath, availZone.Name()))
+		}
+		poolRef = pool.Reference()
+	}
+
+	datastore, err := senv.client.GetTargetDatastore(senv.ctx, &availZone.r, *cons.RootDiskSource, senv.nextDatastoreSelection())
 	if err != nil {
 		return nil, nil, errors.Trace(err)
 	}
@@ -164,7 +182,7 @@ func (senv *sessionEnviron) newRawInstance(
 		env:              senv.environ,
 		client:           senv.client,
 		vmFolder:         senv.getVMFolder(),
-		azPoolRef:        availZone.pool.Reference(),
+		azPoolRef:        poolRef,
 		datastore:        datastore,
 		controllerUUID:   args.ControllerUUID,
 		statusUpdateArgs: statusUpdateArgs,
@@ -252,6 +270,7 @@ func (senv *sessionEnviron) newRawInstance(
 		Folder:                 path.Join(senv.getVMFolder(), controllerFolderName(args.ControllerUUID), senv.modelFolderName()),
 		UserData:               string(userData),
 		Metadata:               args.InstanceConfig.Tags,
+		Annotations:            vmAnnotations(args.InstanceConfig.Tags),
 		Constraints:            cons,
 		NetworkDevices:         networkDevices,
 		EnableDiskUUID:         senv.ecfg.enableDiskUUID(),
@@ -261,7 +280,7 @@ func (senv *sessionEnviron) newRawInstance(
 		Datastore:              datastore,
 		VMTemplate:             vmTemplate,
 		ComputeResource:        &availZone.r,
-		ResourcePool:           availZone.pool.Reference(),
+		ResourcePool:           poolRef,
 	}
 
 	vm, err := senv.client.CreateVirtualMachine(senv.ctx, createVMArgs)
@@ -324,6 +343,29 @@ func (senv *sessionEnviron) AllRunningInstances(ctx context.Context) ([]instance
 	return senv.AllInstances(ctx)
 }
 
+// vmAnnotationKeys are the instance tags written to the VM annotation, so
+// that operators can correlate VMs in vCenter with Juju entities.
+var vmAnnotationKeys = []string{
+	tags.JujuController,
+	tags.JujuModel,
+	tags.JujuMachine,
+}
+
+// vmAnnotations returns the key/value pairs to write to the annotation of a
+// VM with the given instance tags.
+func vmAnnotations(instanceTags map[string]string) map[string]string {
+	var annotations map[string]string
+	for _, key := range vmAnnotationKeys {
+		if value, ok := instanceTags[key]; ok {
+			if annotations == nil {
+				annotations = make(map[string]string)
+			}
+			annotations[key] = value
+		}
+	}
+	return annotations
+}
+
 // StopInstances implements environs.InstanceBroker.
 func (env *environ) StopInstances(ctx context.Context, ids ...instance.Id) error {
 	return env.withSession(ctx, func(senv *sessionEnviron) error {
@@ -31,6 +31,7 @@ import (
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/cloudconfig/instancecfg"
 	"github.com/juju/juju/internal/provider/common"
 	"github.com/juju/juju/internal/provider/vsphere"
@@ -174,6 +175,32 @@ func (s *legacyEnvironBrokerSuite) TestStartInstance(c *gc.C) {
 	})
 }
 
+func (s *legacyEnvironBrokerSuite) TestStartInstanceAnnotations(c *gc.C) {
+	startInstArgs := s.createStartInstanceArgs(c)
+	startInstArgs.InstanceConfig.Tags = map[string]string{
+		tags.JujuController: "deadbeef-1bad-500d-9000-4b1d0d06f00d",
+		tags.JujuModel:      "2d02eeac-9dbb-11e4-89d3-123b93f75cba",
+		tags.JujuMachine:    "0",
+		"k0":                "v0",
+	}
+
+	_, err := s.env.StartInstance(context.Background(), startInstArgs)
+	c.Assert(err, jc.ErrorIsNil)
+
+	call := s.client.Calls()[8]
+	c.Assert(call.FuncName, gc.Equals, "CreateVirtualMachine")
+	createVMArgs := call.Args[1].(vsphereclient.CreateVirtualMachineParams)
+
+	// Only the Juju identifiers are written to the annotation, while all
+	// of the tags are still written to the metadata.
+	c.Check(createVMArgs.Annotations, jc.DeepEquals, map[string]string{
+		tags.JujuController: "deadbeef-1bad-500d-9000-4b1d0d06f00d",
+		tags.JujuModel:      "2d02eeac-9dbb-11e4-89d3-123b93f75cba",
+		tags.JujuMachine:    "0",
+	})
+	c.Check(createVMArgs.Metadata, jc.DeepEquals, startInstArgs.InstanceConfig.Tags)
+}
+
 func (s *legacyEnvironBrokerSuite) TestStartInstanceNetwork(c *gc.C) {
 	env, err := s.provider.Open(context.Background(), environs.OpenParams{
 		Cloud: fakeCloudSpec(),
@@ -602,7 +629,7 @@ func (s *legacyEnvironBrokerSuite) TestStartInstanceNoDatastoreSetting(c *gc.C)
 
 	s.client.CheckCallNames(c, "Folders", "ComputeResources", "ResourcePools", "ResourcePools", "GetTargetDatastore", "ListVMTemplates", "EnsureVMFolder", "CreateTemplateVM", "CreateVirtualMachine", "Close")
 	call := s.client.Calls()[4]
-	c.Assert(call.Args, gc.HasLen, 3)
+	c.Assert(call.Args, gc.HasLen, 4)
 	requestedDatastore := call.Args[2].(string)
 
 	var expected string
@@ -621,6 +648,70 @@ func (s *legacyEnvironBrokerSuite) TestStartInstanceNoDatastoreSetting(c *gc.C)
 	})
 }
 
+func (s *legacyEnvironBrokerSuite) TestStartInstanceDatastoreStrategy(c *gc.C) {
+	cfg := s.env.Config()
+	cfg, err := cfg.Apply(map[string]interface{}{
+		"vsphere-datastore-strategy": "round-robin",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	for i := 0; i < 2; i++ {
+		s.client.ResetCalls()
+		startInstArgs := s.createStartInstanceArgs(c)
+		_, err = s.env.StartInstance(context.Background(), startInstArgs)
+		c.Assert(err, jc.ErrorIsNil)
+
+		call := s.client.Calls()[4]
+		c.Assert(call.FuncName, gc.Equals, "GetTargetDatastore")
+		c.Assert(call.Args, gc.HasLen, 4)
+		c.Check(call.Args[3], jc.DeepEquals, vsphereclient.DatastoreSelection{
+			Strategy: vsphereclient.DatastoreStrategyRoundRobin,
+			Sequence: i,
+		})
+	}
+}
+
+func (s *legacyEnvironBrokerSuite) TestStartInstanceResourcePool(c *gc.C) {
+	cfg := s.env.Config()
+	cfg, err := cfg.Apply(map[string]interface{}{
+		"vsphere-resource-pool": "z1/Resources/child",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	startInstArgs := s.createStartInstanceArgs(c)
+	_, err = s.env.StartInstance(context.Background(), startInstArgs)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.client.CheckCallNames(c, "Folders", "ComputeResources", "ResourcePools", "ResourcePools", "ResourcePool", "GetTargetDatastore", "ListVMTemplates", "EnsureVMFolder", "CreateTemplateVM", "CreateVirtualMachine", "Close")
+	c.Assert(s.client.Calls()[4].Args[1], gc.Equals, "z1/Resources/child")
+
+	call := s.client.Calls()[9]
+	createVMArgs := call.Args[1].(vsphereclient.CreateVirtualMachineParams)
+	c.Check(createVMArgs.ResourcePool.Value, gc.Equals, "z1/Resources/child")
+	c.Check(s.client.virtualMachineTemplates[0].args.ResourcePool.Value, gc.Equals, "z1/Resources/child")
+}
+
+func (s *legacyEnvironBrokerSuite) TestStartInstanceResourcePoolOutsideZone(c *gc.C) {
+	cfg := s.env.Config()
+	cfg, err := cfg.Apply(map[string]interface{}{
+		"vsphere-resource-pool": "z2/Resources/child",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	startInstArgs := s.createStartInstanceArgs(c)
+	_, err = s.env.StartInstance(context.Background(), startInstArgs)
+	c.Assert(err, gc.ErrorMatches, `resource pool "z2/Resources/child" is not in availability zone "z1"`)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Assert(err, gc.Not(jc.ErrorIs), environs.ErrAvailabilityZoneIndependent)
+
+	s.client.CheckCallNames(c, "Folders", "ComputeResources", "ResourcePools", "ResourcePools", "ResourcePool", "Close")
+}
 func (s *legacyEnvironBrokerSuite) TestNotBootstrapping(c *gc.C) {
 	startInstArgs := s.createStartInstanceArgs(c)
 	nonBootstrapInstance, err := instancecfg.NewInstanceConfig(
@@ -50,6 +50,45 @@ func (s *environSuite) TestBootstrap(c *gc.C) {
 	)
 }
 
+func (s *environSuite) TestBootstrapResourcePool(c *gc.C) {
+	s.PatchValue(&vsphere.Bootstrap, func(
+		ctx environs.BootstrapContext,
+		env environs.Environ,
+		args environs.BootstrapParams,
+	) (*environs.BootstrapResult, error) {
+		return nil, errors.New("Bootstrap called")
+	})
+	s.setResourcePool(c, "z1/Resources/child")
+
+	_, err := s.env.Bootstrap(envtesting.BootstrapTestContext(c), environs.BootstrapParams{
+		ControllerConfig: testing.FakeControllerConfig(),
+	})
+	c.Assert(err, gc.ErrorMatches, "Bootstrap called")
+
+	s.client.CheckCallNames(c, "EnsureVMFolder", "ResourcePool", "Close")
+	c.Check(s.client.Calls()[1].Args[1], gc.Equals, "z1/Resources/child")
+}
+
+func (s *environSuite) TestBootstrapResourcePoolNotFound(c *gc.C) {
+	s.setResourcePool(c, "z1/Resources/missing")
+	s.client.SetErrors(nil, errors.NotFoundf(`resource pool "z1/Resources/missing"`))
+
+	_, err := s.env.Bootstrap(envtesting.BootstrapTestContext(c), environs.BootstrapParams{
+		ControllerConfig: testing.FakeControllerConfig(),
+	})
+	c.Assert(err, gc.ErrorMatches, `resource pool "z1/Resources/missing" not found`)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *environSuite) setResourcePool(c *gc.C, pool string) {
+	cfg, err := s.env.Config().Apply(map[string]interface{}{
+		"vsphere-resource-pool": pool,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.env.SetConfig(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *environSuite) TestDestroy(c *gc.C) {
 	var destroyCalled bool
 	s.PatchValue(&vsphere.DestroyEnv, func(env environs.Environ, ctx context.Context) error {
@@ -96,7 +135,7 @@ func (s *environSuite) TestDestroyController(c *gc.C) {
 
 	s.dialStub.CheckCallNames(c, "Dial")
 	s.client.CheckCallNames(c,
-		"DestroyVMFolder", "RemoveVirtualMachines", "DestroyVMFolder",
+		"DestroyVMFolder", "RemoveVirtualMachinesMatching", "DestroyVMFolder",
 		"Close",
 	)
 
@@ -108,11 +147,14 @@ func (s *environSuite) TestDestroyController(c *gc.C) {
 	)
 
 	removeVirtualMachinesCall := s.client.Calls()[1]
-	c.Assert(removeVirtualMachinesCall.Args, gc.HasLen, 2)
+	c.Assert(removeVirtualMachinesCall.Args, gc.HasLen, 3)
 	c.Assert(removeVirtualMachinesCall.Args[0], gc.Implements, new(context.Context))
 	c.Assert(removeVirtualMachinesCall.Args[1], gc.Equals,
 		`Juju Controller (foo)/Model "*" (*)/*`,
 	)
+	c.Assert(removeVirtualMachinesCall.Args[2], jc.DeepEquals, map[string]string{
+		"juju-controller-uuid": "foo",
+	})
 
 	destroyControllerVMFolderCall := s.client.Calls()[2]
 	c.Assert(destroyControllerVMFolderCall.Args, gc.HasLen, 2)
@@ -149,6 +191,40 @@ func (s *environSuite) TestAdoptResourcesPermissionError(c *gc.C) {
 	AssertInvalidatesCredential(c, s.client, func(ctx context.Context) error {
 		return s.env.AdoptResources(ctx, "foo", semversion.Number{})
 	})
+	s.dialStub.CheckCallNames(c, "Dial")
+}
+
+func (s *environSuite) TestAdoptResourcesRetriesAfterSessionExpired(c *gc.C) {
+	s.client.SetErrors(notAuthenticatedFault())
+
+	err := s.env.AdoptResources(context.Background(), "foo", semversion.Number{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.client.invalid, jc.IsFalse)
+
+	s.dialStub.CheckCallNames(c, "Dial", "Dial")
+	s.client.CheckCallNames(c, "MoveVMFolderInto", "Close", "MoveVMFolderInto", "Close")
+}
+
+func (s *environSuite) TestAdoptResourcesRetriesSessionExpiredOnce(c *gc.C) {
+	s.client.SetErrors(notAuthenticatedFault(), nil, notAuthenticatedFault())
+
+	err := s.env.AdoptResources(context.Background(), "foo", semversion.Number{})
+	c.Assert(err, gc.ErrorMatches, ".*ServerFaultCode: session expired$")
+	c.Assert(s.client.invalid, jc.IsFalse)
+
+	s.dialStub.CheckCallNames(c, "Dial", "Dial")
+	s.client.CheckCallNames(c, "MoveVMFolderInto", "Close", "MoveVMFolderInto", "FindFolder", "Close")
+}
+
+func (s *environSuite) TestAdoptResourcesNoRetryOnOtherErrors(c *gc.C) {
+	s.client.SetErrors(errors.New("boom"))
+
+	err := s.env.AdoptResources(context.Background(), "foo", semversion.Number{})
+	c.Assert(err, gc.ErrorMatches, "boom")
+	c.Assert(s.client.invalid, jc.IsFalse)
+
+	s.dialStub.CheckCallNames(c, "Dial")
+	s.client.CheckCallNames(c, "MoveVMFolderInto", "FindFolder", "Close")
 }
 
 func (s *environSuite) TestBootstrapPermissionError(c *gc.C) {
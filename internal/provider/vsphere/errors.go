@@ -43,6 +43,22 @@ func IsAuthorisationFailure(err error) bool {
 	return strings.Contains(fault.String, loginErrorFragment)
 }
 
+// isSessionExpired determines whether the given error indicates that the
+// vsphere session is no longer authenticated, such as when it has timed
+// out. Unlike an authorisation failure, dialling a new session fixes this.
+func isSessionExpired(err error) bool {
+	baseErr := errors.Cause(err)
+	if !soap.IsSoapFault(baseErr) {
+		return false
+	}
+	fault := soap.ToSoapFault(baseErr)
+	if fault.Code != serverFaultCode {
+		return false
+	}
+	_, isNotAuthenticated := fault.Detail.Fault.(types.NotAuthenticated)
+	return isNotAuthenticated
+}
+
 // handleCredentialError marks the current credential as invalid if
 // the passed vsphere error indicates it should be.
 func (senv *sessionEnviron) handleCredentialError(ctx context.Context, err error) error {
@@ -139,14 +139,32 @@ func serveImageMetadata(requests *[]*http.Request) *httptest.Server {
 }
 
 func AssertInvalidatesCredential(c *gc.C, client *mockClient, f func(context.Context) error) {
-	client.SetErrors(soap.WrapSoapFault(&soap.Fault{
+	client.SetErrors(permissionFault(), errors.New("find folder failed"))
+	err := f(context.Background())
+	c.Assert(err, gc.ErrorMatches, ".*ServerFaultCode: No way José$")
+	c.Assert(client.invalid, jc.IsTrue)
+}
+
+// permissionFault returns a vsphere fault indicating that the credential
+// doesn't have permission to perform an operation.
+func permissionFault() error {
+	return soap.WrapSoapFault(&soap.Fault{
 		Code:   "ServerFaultCode",
 		String: "No way José",
 		Detail: struct {
 			Fault types.AnyType `xml:",any,typeattr"`
 		}{Fault: types.NoPermission{}},
-	}), errors.New("find folder failed"))
-	err := f(context.Background())
-	c.Assert(err, gc.ErrorMatches, ".*ServerFaultCode: No way José$")
-	c.Assert(client.invalid, jc.IsTrue)
+	})
+}
+
+// notAuthenticatedFault returns a vsphere fault indicating that the session
+// is no longer authenticated.
+func notAuthenticatedFault() error {
+	return soap.WrapSoapFault(&soap.Fault{
+		Code:   "ServerFaultCode",
+		String: "session expired",
+		Detail: struct {
+			Fault types.AnyType `xml:",any,typeattr"`
+		}{Fault: types.NotAuthenticated{}},
+	})
 }
@@ -0,0 +1,52 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package vsphereclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FormatAnnotation returns the VM annotation holding the given key/value
+// pairs, one "key=value" pair per line, sorted by key.
+func FormatAnnotation(values map[string]string) string {
+	lines := make([]string, 0, len(values))
+	for k, v := range values {
+		lines = append(lines, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// ParseAnnotation returns the key/value pairs held in a VM annotation
+// written by FormatAnnotation. Lines which aren't key/value pairs, such as
+// notes added by an operator, are ignored.
+func ParseAnnotation(annotation string) map[string]string {
+	values := make(map[string]string)
+	for _, line := range strings.Split(annotation, "\n") {
+		k, v, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok || k == "" {
+			continue
+		}
+		values[k] = v
+	}
+	return values
+}
+
+// AnnotationMatches reports whether the annotation is consistent with the
+// key/value pairs in match. A key missing from the annotation is not a
+// mismatch, but a key with a different value is.
+func AnnotationMatches(annotation string, match map[string]string) bool {
+	if len(match) == 0 {
+		return true
+	}
+	values := ParseAnnotation(annotation)
+	for k, v := range match {
+		if actual, ok := values[k]; ok && actual != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package vsphereclient
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type annotationSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&annotationSuite{})
+
+func (s *annotationSuite) TestFormatAnnotation(c *gc.C) {
+	annotation := FormatAnnotation(map[string]string{
+		"juju-model-uuid":      "model",
+		"juju-controller-uuid": "controller",
+		"juju-machine-id":      "0",
+	})
+	c.Check(annotation, gc.Equals, "juju-controller-uuid=controller\njuju-machine-id=0\njuju-model-uuid=model")
+	c.Check(FormatAnnotation(nil), gc.Equals, "")
+}
+
+func (s *annotationSuite) TestParseAnnotation(c *gc.C) {
+	values := ParseAnnotation("juju-controller-uuid=controller\nsome operator notes\n juju-machine-id=0 \n=bad")
+	c.Check(values, jc.DeepEquals, map[string]string{
+		"juju-controller-uuid": "controller",
+		"juju-machine-id":      "0",
+	})
+}
+
+func (s *annotationSuite) TestAnnotationMatches(c *gc.C) {
+	annotation := "juju-controller-uuid=controller\njuju-machine-id=0"
+	c.Check(AnnotationMatches(annotation, nil), jc.IsTrue)
+	c.Check(AnnotationMatches(annotation, map[string]string{"juju-controller-uuid": "controller"}), jc.IsTrue)
+	c.Check(AnnotationMatches(annotation, map[string]string{"juju-controller-uuid": "other"}), jc.IsFalse)
+	c.Check(AnnotationMatches(annotation, map[string]string{"juju-model-uuid": "model"}), jc.IsTrue)
+	c.Check(AnnotationMatches("", map[string]string{"juju-controller-uuid": "controller"}), jc.IsTrue)
+}
@@ -74,6 +74,39 @@ var (
 	DefaultDiskProvisioningType = DiskTypeThick
 )
 
+// DatastoreStrategy describes how a datastore is chosen for a VM when no
+// datastore has been explicitly requested and more than one is accessible.
+type DatastoreStrategy string
+
+const (
+	// DatastoreStrategyNone requires a datastore to be specified when more
+	// than one is accessible.
+	DatastoreStrategyNone DatastoreStrategy = ""
+	// DatastoreStrategyMostFree selects the accessible datastore with the
+	// most free space.
+	DatastoreStrategyMostFree DatastoreStrategy = "most-free"
+	// DatastoreStrategyRoundRobin cycles through the accessible datastores,
+	// ordered by name.
+	DatastoreStrategyRoundRobin DatastoreStrategy = "round-robin"
+)
+
+// ValidDatastoreStrategies is a list of valid datastore strategies.
+var ValidDatastoreStrategies = []DatastoreStrategy{
+	DatastoreStrategyMostFree,
+	DatastoreStrategyRoundRobin,
+}
+
+// DatastoreSelection holds the parameters used to choose between multiple
+// accessible datastores.
+type DatastoreSelection struct {
+	// Strategy is the strategy used to choose a datastore.
+	Strategy DatastoreStrategy
+
+	// Sequence is used by the round-robin strategy; the datastore at this
+	// position, modulo the number of accessible datastores, is selected.
+	Sequence int
+}
+
 // ErrExtendDisk is returned if we timed out trying to extend the root
 // disk of a VM.
 type extendDiskError struct {
@@ -204,7 +237,16 @@ func (c *Client) finder(ctx context.Context) (*find.Finder, *object.Datacenter,
 // RemoveVirtualMachines removes VMs matching the given path from the
 // system. The path may include wildcards, to match multiple VMs.
 func (c *Client) RemoveVirtualMachines(ctx context.Context, path string) error {
-	c.logger.Tracef(ctx, "RemoveVirtualMachines() path=%q", path)
+	return c.RemoveVirtualMachinesMatching(ctx, path, nil)
+}
+
+// RemoveVirtualMachinesMatching removes VMs matching the given path from
+// the system, as RemoveVirtualMachines does. As a safety check, VMs whose
+// annotation holds a different value for any of the keys in match are left
+// in place. VMs without an annotation for a key are still removed, as VMs
+// created before annotations were set don't have them.
+func (c *Client) RemoveVirtualMachinesMatching(ctx context.Context, path string, match map[string]string) error {
+	c.logger.Tracef(ctx, "RemoveVirtualMachinesMatching() path=%q match=%v", path, match)
 	finder, _, err := c.finder(ctx)
 	if err != nil {
 		return errors.Trace(err)
@@ -233,6 +275,10 @@ func (c *Client) RemoveVirtualMachines(ctx context.Context, path string) error {
 	var lastError error
 	tasks := make([]*object.Task, 0, len(vms)*2)
 	for i, vm := range vms {
+		if mos[i].Config != nil && !AnnotationMatches(mos[i].Config.Annotation, match) {
+			c.logger.Warningf(ctx, "not removing %q, annotation does not match %v", vm.Name(), match)
+			continue
+		}
 		if mos[i].Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
 			c.logger.Debugf(ctx, "powering off %q", vm.Name())
 			task, err := vm.PowerOff(ctx)
@@ -456,6 +502,25 @@ func (c *Client) ResourcePools(ctx context.Context, path string) ([]*object.Reso
 	return items, nil
 }
 
+// ResourcePool returns the resource pool with the given path. If no
+// such resource pool exists, an error satisfying errors.IsNotFound is
+// returned.
+func (c *Client) ResourcePool(ctx context.Context, path string) (*object.ResourcePool, error) {
+	c.logger.Tracef(ctx, "ResourcePool() path=%q", path)
+	finder, _, err := c.finder(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pool, err := finder.ResourcePool(ctx, path)
+	if err != nil {
+		if _, ok := err.(*find.NotFoundError); ok {
+			return nil, errors.NotFoundf("resource pool %q", path)
+		}
+		return nil, errors.Annotate(err, "finding resource pool")
+	}
+	return pool, nil
+}
+
 // EnsureVMFolder creates the a VM folder with the given path if it doesn't already exist.
 // Two string arguments needed: relativeFolderPath will be split on "/"
 // whereas parentFolderName is the subfolder in DC's root-folder.
@@ -848,6 +913,7 @@ func (c *Client) buildConfigSpec(
 	for k, v := range args.Metadata {
 		spec.ExtraConfig = append(spec.ExtraConfig, &types.OptionValue{Key: k, Value: v})
 	}
+	spec.Annotation = FormatAnnotation(args.Annotations)
 
 	networks, dvportgroupConfig, err := c.computeResourceNetworks(ctx, args.ComputeResource)
 	if err != nil {
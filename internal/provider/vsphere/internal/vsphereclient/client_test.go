@@ -809,6 +809,50 @@ func (s *clientSuite) TestRemoveVirtualMachines(c *gc.C) {
 	})
 }
 
+func (s *clientSuite) TestRemoveVirtualMachinesMatching(c *gc.C) {
+	// FakeVm0 belongs to another controller, so it must not be removed.
+	s.roundTripper.updateContents("FakeVm0", []types.ObjectContent{{
+		Obj: types.ManagedObjectReference{
+			Type:  "VirtualMachine",
+			Value: "FakeVm0",
+		},
+		PropSet: []types.DynamicProperty{
+			{Name: "name", Val: "juju-vm-0"},
+			{Name: "runtime.powerState", Val: "poweredOff"},
+			{Name: "config.annotation", Val: "juju-controller-uuid=other"},
+		},
+	}})
+
+	client := s.newFakeClient(c, &s.roundTripper, "dc0")
+	err := client.RemoveVirtualMachinesMatching(context.Background(), "foo/bar/*", map[string]string{
+		"juju-controller-uuid": "foo",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.roundTripper.CheckCalls(c, []testing.StubCall{
+		retrievePropertiesStubCall("FakeRootFolder"),
+		retrievePropertiesStubCall("FakeRootFolder"),
+		retrievePropertiesStubCall("FakeDatacenter"),
+		retrievePropertiesStubCall("FakeVmFolder"),
+		retrievePropertiesStubCall("FakeVmFolder"),
+		retrievePropertiesStubCall("FakeControllerVmFolder"),
+		retrievePropertiesStubCall("FakeModelVmFolder"),
+		retrievePropertiesStubCall("FakeVmTemplate", "FakeVm0", "FakeVm1"),
+		{FuncName: "Destroy_Task", Args: nil},
+		{FuncName: "PowerOffVM_Task", Args: nil},
+		{FuncName: "Destroy_Task", Args: nil},
+		{FuncName: "CreatePropertyCollector", Args: nil},
+		{FuncName: "CreateFilter", Args: nil},
+		{FuncName: "WaitForUpdatesEx", Args: nil},
+		{FuncName: "CreatePropertyCollector", Args: nil},
+		{FuncName: "CreateFilter", Args: nil},
+		{FuncName: "WaitForUpdatesEx", Args: nil},
+		{FuncName: "CreatePropertyCollector", Args: nil},
+		{FuncName: "CreateFilter", Args: nil},
+		{FuncName: "WaitForUpdatesEx", Args: nil},
+	})
+}
+
 func (s *clientSuite) TestRemoveVirtualMachinesDestroyRace(c *gc.C) {
 	s.roundTripper.taskError[destroyTask] = &types.LocalizedMethodFault{
 		Fault: &types.ManagedObjectNotFound{},
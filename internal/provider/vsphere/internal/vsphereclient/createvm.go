@@ -12,6 +12,7 @@ import (
 	"io"
 	"math/big"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -122,6 +123,10 @@ type CreateVirtualMachineParams struct {
 	// "extra config".
 	Metadata map[string]string
 
+	// Annotations are key/value pairs written to the VM's annotation, which
+	// is shown as the VM's notes in vCenter.
+	Annotations map[string]string
+
 	// Constraints contains the resource constraints for the virtual machine.
 	Constraints constraints.Value
 
@@ -153,11 +158,13 @@ func acquireMutex(spec mutex.Spec) (func(), error) {
 }
 
 // GetTargetDatastore returns the proper datastore for a compute resource.
-// given a root disk constraint.
+// given a root disk constraint. If no root disk source is given, the
+// selection is used to choose between multiple accessible datastores.
 func (c *Client) GetTargetDatastore(
 	ctx context.Context,
 	computeResource *mo.ComputeResource,
 	rootDiskSource string,
+	selection DatastoreSelection,
 ) (*object.Datastore, error) {
 	_, datacenter, err := c.finder(ctx)
 	if err != nil {
@@ -169,7 +176,7 @@ func (c *Client) GetTargetDatastore(
 		return nil, errors.Trace(err)
 	}
 
-	datastoreMo, err := c.selectDatastore(ctx, computeResource, rootDiskSource)
+	datastoreMo, err := c.selectDatastore(ctx, computeResource, rootDiskSource, selection)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -463,6 +470,7 @@ func (c *Client) selectDatastore(
 	ctx context.Context,
 	computeResource *mo.ComputeResource,
 	rootDiskSource string,
+	selection DatastoreSelection,
 ) (_ *mo.Datastore, err error) {
 	defer func() {
 		if err != nil {
@@ -471,8 +479,9 @@ func (c *Client) selectDatastore(
 	}()
 	c.logger.Debugf(ctx, "Selecting datastore")
 	// Select a datastore. If the user specified one, use that. When no datastore
-	// is provided and there is only datastore accessible, use that. Otherwise return
-	// an error and ask for guidance.
+	// is provided and there is only datastore accessible, use that. If there are
+	// multiple, use the selection strategy if there is one. Otherwise return an
+	// error and ask for guidance.
 	refs := make([]types.ManagedObjectReference, len(computeResource.Datastore))
 	for i, ds := range computeResource.Datastore {
 		refs[i] = ds.Reference()
@@ -519,12 +528,50 @@ func (c *Client) selectDatastore(
 		c.logger.Infof(ctx, "selecting datastore %s", ds.Name)
 		return &ds, nil
 	} else if len(accessibleDatastores) > 1 {
+		switch selection.Strategy {
+		case DatastoreStrategyMostFree:
+			ds := mostFreeDatastore(accessibleDatastores)
+			c.logger.Infof(ctx, "selecting datastore %s with the most free space", ds.Name)
+			return &ds, nil
+		case DatastoreStrategyRoundRobin:
+			ds := roundRobinDatastore(accessibleDatastores, selection.Sequence)
+			c.logger.Infof(ctx, "selecting datastore %s in round-robin order", ds.Name)
+			return &ds, nil
+		}
 		return nil, errors.Errorf("no datastore provided and multiple available: %q", strings.Join(datastoreNames, ", "))
 	}
 
 	return nil, errors.New("could not find an accessible datastore")
 }
 
+// mostFreeDatastore returns the datastore with the most free space. Ties
+// are broken by name, so that the selection is stable.
+func mostFreeDatastore(datastores []mo.Datastore) mo.Datastore {
+	best := datastores[0]
+	for _, ds := range datastores[1:] {
+		if ds.Summary.FreeSpace > best.Summary.FreeSpace ||
+			(ds.Summary.FreeSpace == best.Summary.FreeSpace && ds.Name < best.Name) {
+			best = ds
+		}
+	}
+	return best
+}
+
+// roundRobinDatastore returns the datastore at the given sequence position,
+// with the datastores ordered by name.
+func roundRobinDatastore(datastores []mo.Datastore, sequence int) mo.Datastore {
+	sorted := make([]mo.Datastore, len(datastores))
+	copy(sorted, datastores)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	index := sequence % len(sorted)
+	if index < 0 {
+		index += len(sorted)
+	}
+	return sorted[index]
+}
+
 // addNetworkDevice adds an entry to the VirtualMachineConfigSpec's
 // DeviceChange list, to create a NIC device connecting the machine
 // to the specified network.
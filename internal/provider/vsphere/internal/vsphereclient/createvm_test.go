@@ -270,6 +270,24 @@ func (s *clientSuite) TestCreateVirtualMachineNoDiskUUID(c *gc.C) {
 		})
 }
 
+func (s *clientSuite) TestCreateVirtualMachineAnnotations(c *gc.C) {
+	client := s.newFakeClient(c, &s.roundTripper, "dc0")
+	args := baseCreateVirtualMachineParams(c, client)
+	args.Annotations = map[string]string{
+		"juju-controller-uuid": "controller",
+		"juju-model-uuid":      "model",
+	}
+	_, err := client.CreateVirtualMachine(context.Background(), args)
+	c.Assert(err, jc.ErrorIsNil)
+
+	call := s.roundTripper.Calls()[14]
+	c.Assert(call.FuncName, gc.Equals, "CloneVM_Task")
+	c.Assert(call.Args, gc.HasLen, 4)
+	spec, ok := call.Args[2].(*types.VirtualMachineConfigSpec)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(ParseAnnotation(spec.Annotation), jc.DeepEquals, args.Annotations)
+}
+
 func (s *clientSuite) TestCreateVirtualMachineThickDiskProvisioning(c *gc.C) {
 	client := s.newFakeClient(c, &s.roundTripper, "dc0")
 	args := baseCreateVirtualMachineParams(c, client)
@@ -458,7 +476,7 @@ func (s *clientSuite) TestGetTargetDatastoreDatastoreNotFound(c *gc.C) {
 	args := baseCreateVirtualMachineParams(c, client)
 	datastore := "datastore3"
 
-	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, datastore)
+	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, datastore, DatastoreSelection{})
 	c.Assert(err, gc.ErrorMatches, `could not find datastore "datastore3", datastore\(s\) accessible: "datastore2"`)
 }
 
@@ -470,7 +488,7 @@ func (s *clientSuite) TestGetTargetDatastoreDatastoreNoneAccessible(c *gc.C) {
 		Value: "FakeDatastore1",
 	}}
 
-	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, args.Datastore.Name())
+	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, args.Datastore.Name(), DatastoreSelection{})
 	c.Assert(err, gc.ErrorMatches, "no accessible datastores available")
 }
 
@@ -492,7 +510,7 @@ func (s *clientSuite) TestGetTargetDatastoreDatastoreNotFoundWithMultipleAvailab
 		}},
 	)
 
-	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, datastore)
+	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, datastore, DatastoreSelection{})
 	c.Assert(err, gc.ErrorMatches, `could not find datastore "datastore3", datastore\(s\) accessible: "datastore1", "datastore2"`)
 }
 
@@ -514,10 +532,95 @@ func (s *clientSuite) TestGetTargetDatastoreDatastoreNotFoundWithNoAvailable(c *
 		}},
 	)
 
-	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, datastore)
+	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, datastore, DatastoreSelection{})
 	c.Assert(err, gc.ErrorMatches, `no accessible datastores available`)
 }
 
+func (s *clientSuite) setupMultipleDatastores() {
+	for name, freeSpace := range map[string]int64{
+		"FakeDatastore1": 2048,
+		"FakeDatastore2": 1024,
+	} {
+		dsName := "datastore" + name[len(name)-1:]
+		s.roundTripper.updateContents(name,
+			[]types.ObjectContent{{
+				Obj: types.ManagedObjectReference{
+					Type:  "Datastore",
+					Value: name,
+				},
+				PropSet: []types.DynamicProperty{
+					{Name: "name", Val: dsName},
+					{Name: "summary.accessible", Val: true},
+					{Name: "summary.freeSpace", Val: freeSpace},
+				},
+			}},
+		)
+	}
+}
+
+func (s *clientSuite) TestGetTargetDatastoreMultipleAvailableNoStrategy(c *gc.C) {
+	client := s.newFakeClient(c, &s.roundTripper, "dc0")
+	args := baseCreateVirtualMachineParams(c, client)
+	s.setupMultipleDatastores()
+
+	_, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, "", DatastoreSelection{})
+	c.Assert(err, gc.ErrorMatches, `no datastore provided and multiple available: .*`)
+}
+
+func (s *clientSuite) TestGetTargetDatastoreMostFree(c *gc.C) {
+	client := s.newFakeClient(c, &s.roundTripper, "dc0")
+	args := baseCreateVirtualMachineParams(c, client)
+	s.setupMultipleDatastores()
+
+	ds, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, "", DatastoreSelection{
+		Strategy: DatastoreStrategyMostFree,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ds.Name(), gc.Equals, "datastore1")
+}
+
+func (s *clientSuite) TestGetTargetDatastoreRoundRobin(c *gc.C) {
+	client := s.newFakeClient(c, &s.roundTripper, "dc0")
+	args := baseCreateVirtualMachineParams(c, client)
+	s.setupMultipleDatastores()
+
+	var names []string
+	for seq := 0; seq < 3; seq++ {
+		ds, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, "", DatastoreSelection{
+			Strategy: DatastoreStrategyRoundRobin,
+			Sequence: seq,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+		names = append(names, ds.Name())
+	}
+	c.Check(names, jc.DeepEquals, []string{"datastore1", "datastore2", "datastore1"})
+}
+
+func (s *clientSuite) TestGetTargetDatastoreStrategySkipsInaccessible(c *gc.C) {
+	client := s.newFakeClient(c, &s.roundTripper, "dc0")
+	args := baseCreateVirtualMachineParams(c, client)
+	s.setupMultipleDatastores()
+	s.roundTripper.updateContents("FakeDatastore1",
+		[]types.ObjectContent{{
+			Obj: types.ManagedObjectReference{
+				Type:  "Datastore",
+				Value: "FakeDatastore1",
+			},
+			PropSet: []types.DynamicProperty{
+				{Name: "name", Val: "datastore1"},
+				{Name: "summary.accessible", Val: false},
+				{Name: "summary.freeSpace", Val: int64(4096)},
+			},
+		}},
+	)
+
+	ds, err := client.GetTargetDatastore(context.Background(), args.ComputeResource, "", DatastoreSelection{
+		Strategy: DatastoreStrategyMostFree,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ds.Name(), gc.Equals, "datastore2")
+}
+
 func (s *clientSuite) TestCreateVirtualMachineMultipleNetworksSpecifiedFirstDefault(c *gc.C) {
 	client := s.newFakeClient(c, &s.roundTripper, "dc0")
 	args := baseCreateVirtualMachineParams(c, client)
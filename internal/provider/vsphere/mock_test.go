@@ -74,7 +74,7 @@ func (c *mockClient) CreateTemplateVM(ctx context.Context, ovaArgs vsphereclient
 	return tpl.vm, c.NextErr()
 }
 
-func (c *mockClient) GetTargetDatastore(ctx context.Context, computeResource *mo.ComputeResource, rootDiskSource string) (*object.Datastore, error) {
+func (c *mockClient) GetTargetDatastore(ctx context.Context, computeResource *mo.ComputeResource, rootDiskSource string, selection vsphereclient.DatastoreSelection) (*object.Datastore, error) {
 	if rootDiskSource == "" {
 		for _, ds := range c.datastores {
 			if ds.Summary.Accessible {
@@ -87,7 +87,7 @@ func (c *mockClient) GetTargetDatastore(ctx context.Context, computeResource *mo
 		Type:  "Datastore",
 		Value: rootDiskSource,
 	})
-	c.MethodCall(c, "GetTargetDatastore", ctx, computeResource, rootDiskSource)
+	c.MethodCall(c, "GetTargetDatastore", ctx, computeResource, rootDiskSource, selection)
 	return ds, c.NextErr()
 }
 
@@ -138,6 +138,18 @@ func (c *mockClient) ComputeResources(ctx context.Context) ([]vsphereclient.Comp
 	return c.computeResources, c.NextErr()
 }
 
+func (c *mockClient) ResourcePool(ctx context.Context, path string) (*object.ResourcePool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.MethodCall(c, "ResourcePool", ctx, path)
+	// Relative paths are resolved against the datacenter's host folder.
+	inventoryPath := path
+	if !strings.HasPrefix(inventoryPath, "/") {
+		inventoryPath = "/DC/host/" + inventoryPath
+	}
+	return makeResourcePool(path, inventoryPath), c.NextErr()
+}
+
 func (c *mockClient) ResourcePools(ctx context.Context, path string) ([]*object.ResourcePool, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -218,6 +230,13 @@ func (c *mockClient) RemoveVirtualMachines(ctx context.Context, path string) err
 	return c.NextErr()
 }
 
+func (c *mockClient) RemoveVirtualMachinesMatching(ctx context.Context, path string, match map[string]string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.MethodCall(c, "RemoveVirtualMachinesMatching", ctx, path, match)
+	return c.NextErr()
+}
+
 func (c *mockClient) UpdateVirtualMachineExtraConfig(ctx context.Context, vm *mo.VirtualMachine, attrs map[string]string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/client_mock.go github.com/juju/juju/internal/provider/vsphere Client
+//	mockgen -typed -package mocks -destination internal/provider/vsphere/mocks/client_mock.go github.com/juju/juju/internal/provider/vsphere Client
 //
 
 // Package mocks is a generated GoMock package.
@@ -431,18 +431,18 @@ func (c *MockClientFoldersCall) DoAndReturn(f func(context.Context) (*object.Dat
 }
 
 // GetTargetDatastore mocks base method.
-func (m *MockClient) GetTargetDatastore(arg0 context.Context, arg1 *mo.ComputeResource, arg2 string) (*object.Datastore, error) {
+func (m *MockClient) GetTargetDatastore(arg0 context.Context, arg1 *mo.ComputeResource, arg2 string, arg3 vsphereclient.DatastoreSelection) (*object.Datastore, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTargetDatastore", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetTargetDatastore", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*object.Datastore)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTargetDatastore indicates an expected call of GetTargetDatastore.
-func (mr *MockClientMockRecorder) GetTargetDatastore(arg0, arg1, arg2 any) *MockClientGetTargetDatastoreCall {
+func (mr *MockClientMockRecorder) GetTargetDatastore(arg0, arg1, arg2, arg3 any) *MockClientGetTargetDatastoreCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetDatastore", reflect.TypeOf((*MockClient)(nil).GetTargetDatastore), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetDatastore", reflect.TypeOf((*MockClient)(nil).GetTargetDatastore), arg0, arg1, arg2, arg3)
 	return &MockClientGetTargetDatastoreCall{Call: call}
 }
 
@@ -458,13 +458,13 @@ func (c *MockClientGetTargetDatastoreCall) Return(arg0 *object.Datastore, arg1 e
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockClientGetTargetDatastoreCall) Do(f func(context.Context, *mo.ComputeResource, string) (*object.Datastore, error)) *MockClientGetTargetDatastoreCall {
+func (c *MockClientGetTargetDatastoreCall) Do(f func(context.Context, *mo.ComputeResource, string, vsphereclient.DatastoreSelection) (*object.Datastore, error)) *MockClientGetTargetDatastoreCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockClientGetTargetDatastoreCall) DoAndReturn(f func(context.Context, *mo.ComputeResource, string) (*object.Datastore, error)) *MockClientGetTargetDatastoreCall {
+func (c *MockClientGetTargetDatastoreCall) DoAndReturn(f func(context.Context, *mo.ComputeResource, string, vsphereclient.DatastoreSelection) (*object.Datastore, error)) *MockClientGetTargetDatastoreCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -627,6 +627,83 @@ func (c *MockClientRemoveVirtualMachinesCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// RemoveVirtualMachinesMatching mocks base method.
+func (m *MockClient) RemoveVirtualMachinesMatching(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVirtualMachinesMatching", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVirtualMachinesMatching indicates an expected call of RemoveVirtualMachinesMatching.
+func (mr *MockClientMockRecorder) RemoveVirtualMachinesMatching(arg0, arg1, arg2 any) *MockClientRemoveVirtualMachinesMatchingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVirtualMachinesMatching", reflect.TypeOf((*MockClient)(nil).RemoveVirtualMachinesMatching), arg0, arg1, arg2)
+	return &MockClientRemoveVirtualMachinesMatchingCall{Call: call}
+}
+
+// MockClientRemoveVirtualMachinesMatchingCall wrap *gomock.Call
+type MockClientRemoveVirtualMachinesMatchingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientRemoveVirtualMachinesMatchingCall) Return(arg0 error) *MockClientRemoveVirtualMachinesMatchingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientRemoveVirtualMachinesMatchingCall) Do(f func(context.Context, string, map[string]string) error) *MockClientRemoveVirtualMachinesMatchingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientRemoveVirtualMachinesMatchingCall) DoAndReturn(f func(context.Context, string, map[string]string) error) *MockClientRemoveVirtualMachinesMatchingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResourcePool mocks base method.
+func (m *MockClient) ResourcePool(arg0 context.Context, arg1 string) (*object.ResourcePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourcePool", arg0, arg1)
+	ret0, _ := ret[0].(*object.ResourcePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourcePool indicates an expected call of ResourcePool.
+func (mr *MockClientMockRecorder) ResourcePool(arg0, arg1 any) *MockClientResourcePoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourcePool", reflect.TypeOf((*MockClient)(nil).ResourcePool), arg0, arg1)
+	return &MockClientResourcePoolCall{Call: call}
+}
+
+// MockClientResourcePoolCall wrap *gomock.Call
+type MockClientResourcePoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientResourcePoolCall) Return(arg0 *object.ResourcePool, arg1 error) *MockClientResourcePoolCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientResourcePoolCall) Do(f func(context.Context, string) (*object.ResourcePool, error)) *MockClientResourcePoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientResourcePoolCall) DoAndReturn(f func(context.Context, string) (*object.ResourcePool, error)) *MockClientResourcePoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ResourcePools mocks base method.
 func (m *MockClient) ResourcePools(arg0 context.Context, arg1 string) ([]*object.ResourcePool, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +201,9 @@ type ProviderServices interface {
 type ProviderServicesGetter interface {
 	// ServicesForModel returns a ProviderServices for the given model.
 	ServicesForModel(modelUUID string) ProviderServices
+	// Remove releases any ProviderServices held for the given model, once
+	// the model has been removed. Removing an unknown model is a no-op.
+	Remove(modelUUID string)
 }
 
 // ControllerObjectStoreServices provides access to the services required by the
@@ -89,6 +89,14 @@ func (w *WatchableDB) Txn(ctx context.Context, fn func(context.Context, *sqlair.
 	return w.db.Txn(ctx, fn)
 }
 
+// ReadTxn manages the application of a read-only SQLair transaction within
+// which the input function is executed. If the underlying database can not
+// run read-only transactions, a regular transaction is used.
+// The input context can be used by the caller to cancel this process.
+func (w *WatchableDB) ReadTxn(ctx context.Context, fn func(context.Context, *sqlair.TX) error) error {
+	return coredatabase.ReadTxn(ctx, w.db, fn)
+}
+
 // StdTxn manages the application of a standard library transaction within
 // which the input function is executed.
 // The input context can be used by the caller to cancel this process.
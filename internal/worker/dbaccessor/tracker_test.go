@@ -125,6 +125,41 @@ func (s *trackedDBWorkerSuite) TestWorkerTxnIsNotNil(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *trackedDBWorkerSuite) TestWorkerReadTxnIsNotNil(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+	defer s.expectTimer(0)()
+
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").Return(s.DB(), nil)
+
+	w, err := s.newTrackedDBWorker(defaultPingDBFunc)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	runner, ok := w.(coredatabase.ReadOnlyTxnRunner)
+	c.Assert(ok, jc.IsTrue)
+
+	done := make(chan struct{})
+	err = runner.ReadTxn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		defer close(done)
+
+		if tx == nil {
+			return errors.New("nil transaction")
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case <-done:
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for DB callback")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
 func (s *trackedDBWorkerSuite) TestWorkerStdTxnIsNotNil(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -118,6 +118,20 @@ func (w *trackedDBWorker) Txn(ctx context.Context, fn func(context.Context, *sql
 	})
 }
 
+// ReadTxn executes the input function against the tracked database,
+// within a read-only transaction that depends on the input context.
+// Retry semantics are applied automatically based on transient failures.
+func (w *trackedDBWorker) ReadTxn(ctx context.Context, fn func(context.Context, *sqlair.TX) error) error {
+	return w.run(ctx, func(db *sqlair.DB) error {
+		// Tie the worker tomb to the context, so that if the worker dies, we
+		// can correctly kill the transaction via the context. The context will
+		// now have the correct reason for the death of the transaction. Either
+		// the tomb died or the context was cancelled.
+		ctx = corecontext.WithSourceableError(w.tomb.Context(ctx), w)
+		return errors.Trace(database.ReadTxn(ctx, db, fn))
+	})
+}
+
 // StdTxn executes the input function against the tracked database,
 // within a transaction that depends on the input context.
 // Retry semantics are applied automatically based on transient failures.
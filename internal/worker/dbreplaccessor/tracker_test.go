@@ -15,6 +15,7 @@ import (
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/internal/testing"
 )
 
@@ -123,6 +124,40 @@ func (s *trackedDBReplWorkerSuite) TestWorkerTxnIsNotNil(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *trackedDBReplWorkerSuite) TestWorkerReadTxnIsNotNil(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").Return(s.DB(), nil)
+
+	w, err := s.newTrackedDBWorker()
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	runner, ok := w.(coredatabase.ReadOnlyTxnRunner)
+	c.Assert(ok, jc.IsTrue)
+
+	done := make(chan struct{})
+	err = runner.ReadTxn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		defer close(done)
+
+		if tx == nil {
+			return errors.New("nil transaction")
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case <-done:
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for DB callback")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
 func (s *trackedDBReplWorkerSuite) newTrackedDBWorker() (TrackedDB, error) {
 	return newTrackedDBWorker(context.Background(),
 		s.states,
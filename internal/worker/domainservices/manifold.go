@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	charmstore "github.com/juju/juju/domain/application/charm/store"
 	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
@@ -38,6 +39,9 @@ type ManifoldConfig struct {
 	LeaseManagerName            string
 	LogSinkName                 string
 	LogDir                      string
+	CharmStoreQuota             int64
+	CharmTempFilePoolSize       int
+	CharmDigests                []charmstore.HashAlgorithm
 	Logger                      logger.Logger
 	Clock                       clock.Clock
 	NewWorker                   func(Config) (worker.Worker, error)
@@ -56,6 +60,7 @@ type DomainServicesGetterFn func(
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.Manager,
+	domainservices.CharmStoreConfig,
 	string,
 	clock.Clock,
 	logger.LoggerContextGetter,
@@ -80,6 +85,7 @@ type ModelDomainServicesFn func(
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.ModelLeaseManagerGetter,
+	domainservices.CharmStoreConfig,
 	string,
 	clock.Clock,
 	logger.Logger,
@@ -126,6 +132,12 @@ func (config ManifoldConfig) Validate() error {
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
+	if config.CharmStoreQuota < 0 {
+		return errors.NotValidf("negative CharmStoreQuota")
+	}
+	if config.CharmTempFilePoolSize < 0 {
+		return errors.NotValidf("negative CharmTempFilePoolSize")
+	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
@@ -215,6 +227,9 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		LeaseManager:                leaseManager,
 		LoggerContextGetter:         loggerContextGetter,
 		LogDir:                      config.LogDir,
+		CharmStoreQuota:             config.CharmStoreQuota,
+		CharmTempFilePoolSize:       config.CharmTempFilePoolSize,
+		CharmDigests:                config.CharmDigests,
 		Logger:                      config.Logger,
 		Clock:                       config.Clock,
 		NewDomainServicesGetter:     config.NewDomainServicesGetter,
@@ -272,6 +287,7 @@ func NewProviderTrackerModelDomainServices(
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
+	charmStoreConfig domainservices.CharmStoreConfig,
 	logDir string,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -285,6 +301,7 @@ func NewProviderTrackerModelDomainServices(
 		storageRegistry,
 		publicKeyImporter,
 		leaseManager,
+		charmStoreConfig,
 		logDir,
 		clock,
 		logger,
@@ -301,6 +318,7 @@ func NewDomainServicesGetter(
 	storageRegistryGetter storage.StorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.Manager,
+	charmStoreConfig domainservices.CharmStoreConfig,
 	logDir string,
 	clock clock.Clock,
 	loggerContextGetter logger.LoggerContextGetter,
@@ -314,6 +332,7 @@ func NewDomainServicesGetter(
 		storageRegistryGetter:  storageRegistryGetter,
 		publicKeyImporter:      publicKeyImporter,
 		leaseManager:           leaseManager,
+		charmStoreConfig:       charmStoreConfig,
 		logDir:                 logDir,
 		clock:                  clock,
 		loggerContextGetter:    loggerContextGetter,
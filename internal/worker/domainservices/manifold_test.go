@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	charmstore "github.com/juju/juju/domain/application/charm/store"
 	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 )
@@ -99,6 +100,14 @@ func (s *manifoldSuite) TestValidateConfig(c *gc.C) {
 	cfg = s.getConfig(c)
 	cfg.Clock = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.CharmStoreQuota = -1
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.CharmTempFilePoolSize = -1
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *manifoldSuite) TestStart(c *gc.C) {
@@ -141,6 +150,41 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 	workertest.CheckAlive(c, w)
 }
 
+func (s *manifoldSuite) TestStartCharmStoreConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.httpClientGetter.EXPECT().GetHTTPClient(gomock.Any(), corehttp.SSHImporterPurpose).Return(s.httpClient, nil)
+
+	getter := map[string]any{
+		"dbaccessor":      s.dbDeleter,
+		"changestream":    s.dbGetter,
+		"providerfactory": s.providerFactory,
+		"objectstore":     s.objectStoreGetter,
+		"storageregistry": s.storageRegistryGetter,
+		"httpclient":      s.httpClientGetter,
+		"leasemanager":    s.leaseManager,
+		"logsink":         s.loggerContextGetter,
+	}
+
+	var config Config
+	cfg := s.getConfig(c)
+	cfg.CharmStoreQuota = 1024
+	cfg.CharmTempFilePoolSize = 2
+	cfg.CharmDigests = []charmstore.HashAlgorithm{charmstore.SHA512}
+	cfg.NewWorker = func(c Config) (worker.Worker, error) {
+		config = c
+		return workertest.NewErrorWorker(nil), nil
+	}
+
+	w, err := Manifold(cfg).Start(context.Background(), dt.StubGetter(getter))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	c.Check(config.CharmStoreQuota, gc.Equals, int64(1024))
+	c.Check(config.CharmTempFilePoolSize, gc.Equals, 2)
+	c.Check(config.CharmDigests, jc.DeepEquals, []charmstore.HashAlgorithm{charmstore.SHA512})
+}
+
 func (s *manifoldSuite) TestOutputControllerDomainServices(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -241,6 +285,7 @@ func (s *manifoldSuite) TestNewModelDomainServices(c *gc.C) {
 		s.modelStorageRegistryGetter,
 		s.publicKeyImporter,
 		s.modelLeaseManagerGetter,
+		domainservices.CharmStoreConfig{},
 		c.MkDir(),
 		s.clock,
 		s.logger,
@@ -264,6 +309,7 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *gc.C) {
 		s.storageRegistryGetter,
 		s.publicKeyImporter,
 		s.leaseManager,
+		domainservices.CharmStoreConfig{},
 		c.MkDir(),
 		s.clock,
 		s.loggerContextGetter,
@@ -306,6 +352,7 @@ func noopDomainServicesGetter(
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.Manager,
+	domainservices.CharmStoreConfig,
 	string,
 	clock.Clock,
 	logger.LoggerContextGetter,
@@ -331,6 +378,7 @@ func noopModelDomainServices(
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.ModelLeaseManagerGetter,
+	domainservices.CharmStoreConfig,
 	string,
 	clock.Clock,
 	logger.Logger,
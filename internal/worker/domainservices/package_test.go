@@ -123,6 +123,7 @@ func NewModelDomainServices(
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
+	charmStoreConfig domainservices.CharmStoreConfig,
 	logDir string,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -136,6 +137,7 @@ func NewModelDomainServices(
 		storageRegistry,
 		publicKeyImporter,
 		leaseManager,
+		charmStoreConfig,
 		logDir,
 		clock,
 		logger,
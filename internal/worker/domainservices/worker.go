@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	charmstore "github.com/juju/juju/domain/application/charm/store"
 	domainservices "github.com/juju/juju/domain/services"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/services"
@@ -54,6 +55,18 @@ type Config struct {
 	// LogDir is the directory where logs are stored.
 	LogDir string
 
+	// CharmStoreQuota is the maximum number of bytes a model object store
+	// may hold for charms to still be stored. Zero means unlimited.
+	CharmStoreQuota int64
+
+	// CharmTempFilePoolSize is the number of unused temporary files kept
+	// to back charm readers. Zero disables the pool.
+	CharmTempFilePoolSize int
+
+	// CharmDigests are the hash algorithms used to compute the digest of
+	// charms. If empty, the charm store defaults are used.
+	CharmDigests []charmstore.HashAlgorithm
+
 	// Logger is used to log messages.
 	Logger logger.Logger
 
@@ -108,6 +121,12 @@ func (config Config) Validate() error {
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
+	if config.CharmStoreQuota < 0 {
+		return errors.NotValidf("negative CharmStoreQuota")
+	}
+	if config.CharmTempFilePoolSize < 0 {
+		return errors.NotValidf("negative CharmTempFilePoolSize")
+	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
@@ -133,6 +152,14 @@ func NewWorker(config Config) (worker.Worker, error) {
 		config.Clock,
 		config.Logger,
 	)
+
+	// The temporary file pool is shared by the charm stores of every model,
+	// and lives as long as the worker.
+	var tempFiles *charmstore.TempFilePool
+	if config.CharmTempFilePoolSize > 0 {
+		tempFiles = charmstore.NewTempFilePool("", config.CharmTempFilePoolSize, config.Logger)
+	}
+
 	w := &domainServicesWorker{
 		ctrlFactory: ctrlFactory,
 		servicesGetter: config.NewDomainServicesGetter(
@@ -144,6 +171,11 @@ func NewWorker(config Config) (worker.Worker, error) {
 			config.StorageRegistryGetter,
 			config.PublicKeyImporter,
 			config.LeaseManager,
+			domainservices.CharmStoreConfig{
+				Quota:     config.CharmStoreQuota,
+				TempFiles: tempFiles,
+				Digests:   config.CharmDigests,
+			},
 			config.LogDir,
 			config.Clock,
 			config.LoggerContextGetter,
@@ -151,6 +183,11 @@ func NewWorker(config Config) (worker.Worker, error) {
 	}
 	w.tomb.Go(func() error {
 		<-w.tomb.Dying()
+		if tempFiles != nil {
+			if err := tempFiles.Close(); err != nil {
+				config.Logger.Warningf(context.Background(), "removing charm temporary files: %v", err)
+			}
+		}
 		return w.tomb.Err()
 	})
 	return w, nil
@@ -209,6 +246,7 @@ type domainServicesGetter struct {
 	storageRegistryGetter  storage.StorageRegistryGetter
 	publicKeyImporter      domainservices.PublicKeyImporter
 	leaseManager           lease.Manager
+	charmStoreConfig       domainservices.CharmStoreConfig
 	logDir                 string
 	clock                  clock.Clock
 	loggerContextGetter    logger.LoggerContextGetter
@@ -240,6 +278,7 @@ func (s *domainServicesGetter) ServicesForModel(ctx context.Context, modelUUID c
 				modelUUID: modelUUID,
 				manager:   s.leaseManager,
 			},
+			s.charmStoreConfig,
 			s.logDir,
 			s.clock,
 			loggerContext.GetLogger("juju.services"),
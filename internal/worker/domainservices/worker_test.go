@@ -4,6 +4,8 @@
 package domainservices
 
 import (
+	"os"
+
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
@@ -19,6 +21,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	charmstore "github.com/juju/juju/domain/application/charm/store"
 	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 )
@@ -90,6 +93,14 @@ func (s *workerSuite) TestValidateConfig(c *gc.C) {
 	cfg = s.getConfig(c)
 	cfg.LoggerContextGetter = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.CharmStoreQuota = -1
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.CharmTempFilePoolSize = -1
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *workerSuite) getConfig(c *gc.C) Config {
@@ -114,6 +125,7 @@ func (s *workerSuite) getConfig(c *gc.C) Config {
 			storage.StorageRegistryGetter,
 			domainservices.PublicKeyImporter,
 			lease.Manager,
+			domainservices.CharmStoreConfig,
 			string,
 			clock.Clock,
 			logger.LoggerContextGetter,
@@ -137,6 +149,7 @@ func (s *workerSuite) getConfig(c *gc.C) Config {
 			storage.ModelStorageRegistryGetter,
 			domainservices.PublicKeyImporter,
 			lease.ModelLeaseManagerGetter,
+			domainservices.CharmStoreConfig,
 			string,
 			clock.Clock,
 			logger.Logger,
@@ -176,6 +189,83 @@ func (s *workerSuite) TestWorkerServicesGetter(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *workerSuite) TestWorkerCharmStoreConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var charmStoreConfig domainservices.CharmStoreConfig
+
+	cfg := s.getConfig(c)
+	cfg.CharmStoreQuota = 1024
+	cfg.CharmTempFilePoolSize = 2
+	cfg.CharmDigests = []charmstore.HashAlgorithm{charmstore.SHA512}
+	cfg.NewDomainServicesGetter = func(
+		_ services.ControllerDomainServices,
+		_ changestream.WatchableDBGetter,
+		_ ModelDomainServicesFn,
+		_ providertracker.ProviderFactory,
+		_ objectstore.ObjectStoreGetter,
+		_ storage.StorageRegistryGetter,
+		_ domainservices.PublicKeyImporter,
+		_ lease.Manager,
+		config domainservices.CharmStoreConfig,
+		_ string,
+		_ clock.Clock,
+		_ logger.LoggerContextGetter,
+	) services.DomainServicesGetter {
+		charmStoreConfig = config
+		return s.domainServicesGetter
+	}
+
+	w, err := NewWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(charmStoreConfig.Quota, gc.Equals, int64(1024))
+	c.Check(charmStoreConfig.TempFiles, gc.NotNil)
+	c.Check(charmStoreConfig.Digests, jc.DeepEquals, []charmstore.HashAlgorithm{charmstore.SHA512})
+
+	workertest.CleanKill(c, w)
+
+	// The temporary file pool is closed when the worker stops, so files
+	// returned to it are removed rather than kept.
+	file, err := charmStoreConfig.TempFiles.Get()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(charmStoreConfig.TempFiles.Put(file), jc.ErrorIsNil)
+	_, err = os.Stat(file.Name())
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+}
+
+func (s *workerSuite) TestWorkerNoCharmTempFilePool(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var charmStoreConfig domainservices.CharmStoreConfig
+
+	cfg := s.getConfig(c)
+	cfg.NewDomainServicesGetter = func(
+		_ services.ControllerDomainServices,
+		_ changestream.WatchableDBGetter,
+		_ ModelDomainServicesFn,
+		_ providertracker.ProviderFactory,
+		_ objectstore.ObjectStoreGetter,
+		_ storage.StorageRegistryGetter,
+		_ domainservices.PublicKeyImporter,
+		_ lease.Manager,
+		config domainservices.CharmStoreConfig,
+		_ string,
+		_ clock.Clock,
+		_ logger.LoggerContextGetter,
+	) services.DomainServicesGetter {
+		charmStoreConfig = config
+		return s.domainServicesGetter
+	}
+
+	w, err := NewWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(charmStoreConfig.TempFiles, gc.IsNil)
+}
+
 func (s *workerSuite) newWorker(c *gc.C) worker.Worker {
 	w, err := NewWorker(s.getConfig(c))
 	c.Assert(err, jc.ErrorIsNil)
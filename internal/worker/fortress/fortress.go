@@ -6,6 +6,7 @@ package fortress
 import (
 	"context"
 	"sync"
+	"time"
 
 	"gopkg.in/tomb.v2"
 )
@@ -15,15 +16,25 @@ type fortress struct {
 	tomb         tomb.Tomb
 	guardTickets chan guardTicket
 	guestTickets chan guestTicket
+	visitsDone   chan struct{}
+
+	// metrics is optional; if set, it's updated as the fortress is locked
+	// and unlocked, and as visits complete.
+	metrics *Collector
+	// cleanup is optional; if set, it's called once the loop has finished.
+	cleanup func()
 }
 
 // newFortress returns a new, locked, fortress. The caller is responsible for
 // ensuring it somehow gets Kill()ed, and for handling any error returned by
-// Wait().
-func newFortress() *fortress {
+// Wait(). The metrics and cleanup func may be nil.
+func newFortress(metrics *Collector, cleanup func()) *fortress {
 	f := &fortress{
 		guardTickets: make(chan guardTicket),
 		guestTickets: make(chan guestTicket),
+		visitsDone:   make(chan struct{}),
+		metrics:      metrics,
+		cleanup:      cleanup,
 	}
 	f.tomb.Go(f.loop)
 	return f
@@ -41,12 +52,17 @@ func (f *fortress) Wait() error {
 
 // Unlock is part of the Guard interface.
 func (f *fortress) Unlock(ctx context.Context) error {
-	return f.allowGuests(ctx, true)
+	return f.allowGuests(ctx, true, 0)
+}
+
+// UnlockWithPolicy is part of the PolicyGuard interface.
+func (f *fortress) UnlockWithPolicy(ctx context.Context, policy LockdownPolicy) error {
+	return f.allowGuests(ctx, true, policy)
 }
 
 // Lockdown is part of the Guard interface.
 func (f *fortress) Lockdown(ctx context.Context) error {
-	return f.allowGuests(ctx, false)
+	return f.allowGuests(ctx, false, 0)
 }
 
 // Visit is part of the Guest interface.
@@ -67,7 +83,7 @@ func (f *fortress) Visit(ctx context.Context, visit Visit) error {
 }
 
 // allowGuests communicates Guard-interface requests to the main loop.
-func (f *fortress) allowGuests(ctx context.Context, allowGuests bool) error {
+func (f *fortress) allowGuests(ctx context.Context, allowGuests bool, policy LockdownPolicy) error {
 	result := make(chan error)
 	select {
 	case <-f.tomb.Dying():
@@ -75,70 +91,190 @@ func (f *fortress) allowGuests(ctx context.Context, allowGuests bool) error {
 	case f.guardTickets <- guardTicket{
 		ctx:         ctx,
 		allowGuests: allowGuests,
+		policy:      policy,
 		result:      result,
 	}:
 		return <-result
 	}
 }
 
+// awaitLockdown waits for the pending lockdown to complete, and then
+// resubmits the unlock ticket to the main loop. It should be called on
+// its own goroutine.
+func (f *fortress) awaitLockdown(ticket guardTicket, lockedDown <-chan struct{}) {
+	select {
+	case <-f.tomb.Dying():
+		ticket.result <- ErrShutdown
+		return
+	case <-ticket.ctx.Done():
+		ticket.result <- ErrAborted
+		return
+	case <-lockedDown:
+	}
+	select {
+	case <-f.tomb.Dying():
+		ticket.result <- ErrShutdown
+	case f.guardTickets <- ticket:
+	}
+}
+
 // loop waits for a Guard to unlock the fortress, and then runs visit funcs in
 // parallel until a Guard locks it down again; at which point, it waits for all
 // outstanding visits to complete, and reverts to its original state.
 func (f *fortress) loop() error {
+	if f.cleanup != nil {
+		defer f.cleanup()
+	}
 	var active sync.WaitGroup
 	defer active.Wait()
 
+	f.setLocked(true)
+
 	// guestTickets will be set on Unlock and cleared at the start of Lockdown.
 	var guestTickets <-chan guestTicket
+
+	// visits counts the visits in progress. lockedDown is created by a
+	// Lockdown when no other Lockdown is pending, and is closed once there
+	// are no visits in progress. aborts holds a channel for each Lockdown
+	// waiting on lockedDown, to be closed if an Unlock with AbortLockdown
+	// arrives before lockedDown is closed.
+	var (
+		visits     int
+		lockedDown chan struct{}
+		aborts     []chan struct{}
+	)
 	for {
 		select {
 		case <-f.tomb.Dying():
 			return tomb.ErrDying
 		case ticket := <-guestTickets:
+			visits++
 			active.Add(1)
-			go ticket.complete(active.Done)
+			go ticket.complete(func() {
+				defer active.Done()
+				select {
+				case <-f.tomb.Dying():
+				case f.visitsDone <- struct{}{}:
+				}
+			}, f.observeVisit)
+		case <-f.visitsDone:
+			visits--
+			if visits == 0 && lockdownPending(lockedDown) {
+				close(lockedDown)
+				aborts = nil
+			}
 		case ticket := <-f.guardTickets:
 			// guard ticket requests are idempotent; it's not worth building
 			// the extra mechanism needed to (1) complain about abuse but
 			// (2) remain comprehensible and functional in the face of aborted
 			// Lockdowns.
 			if ticket.allowGuests {
+				// A plain Unlock leaves any pending Lockdown to complete
+				// once the existing visits, and any started after this
+				// Unlock, have completed.
+				if lockdownPending(lockedDown) {
+					switch ticket.policy {
+					case AwaitLockdown:
+						go f.awaitLockdown(ticket, lockedDown)
+						continue
+					case AbortLockdown:
+						for _, abort := range aborts {
+							close(abort)
+						}
+						lockedDown, aborts = nil, nil
+					}
+				}
 				guestTickets = f.guestTickets
-			} else {
-				guestTickets = nil
+				f.setLocked(false)
+				go ticket.complete(nil, nil)
+				continue
+			}
+
+			if guestTickets != nil && f.metrics != nil {
+				f.metrics.Lockdowns.Inc()
+			}
+			guestTickets = nil
+			if !lockdownPending(lockedDown) {
+				lockedDown = make(chan struct{})
+				if visits == 0 {
+					close(lockedDown)
+				}
 			}
-			go ticket.complete(active.Wait)
+			abort := make(chan struct{})
+			if lockdownPending(lockedDown) {
+				aborts = append(aborts, abort)
+			}
+			f.setLocked(true)
+			go ticket.complete(lockedDown, abort)
 		}
 	}
 }
 
+// lockdownPending returns true if a lockdown is waiting for visits to
+// complete.
+func lockdownPending(lockedDown <-chan struct{}) bool {
+	if lockedDown == nil {
+		return false
+	}
+	select {
+	case <-lockedDown:
+		return false
+	default:
+		return true
+	}
+}
+
+// setLocked records whether the fortress is locked down, if metrics are
+// being collected.
+func (f *fortress) setLocked(locked bool) {
+	if f.metrics == nil {
+		return
+	}
+	if locked {
+		f.metrics.Locked.Set(1)
+	} else {
+		f.metrics.Locked.Set(0)
+	}
+}
+
+// observeVisit records the duration of a visit, if metrics are being
+// collected.
+func (f *fortress) observeVisit(duration time.Duration) {
+	if f.metrics == nil {
+		return
+	}
+	f.metrics.VisitDuration.Observe(duration.Seconds())
+}
+
 // guardTicket communicates between the Guard interface and the main loop.
 type guardTicket struct {
 	ctx         context.Context
 	allowGuests bool
 	result      chan<- error
+
+	// policy is only set by UnlockWithPolicy; it is zero for a plain
+	// Unlock or Lockdown.
+	policy LockdownPolicy
 }
 
 // complete unconditionally sends a single value on ticket.result; either nil
 // (when the desired state is reached) or ErrAborted (when the ticket's ctx is
-// done). It should be called on its own goroutine.
-func (ticket guardTicket) complete(waitLockedDown func()) {
+// done, or the abort channel is closed). When unlocking, lockedDown and
+// abort are nil, and Visits are already being accepted, so we're already
+// done. It should be called on its own goroutine.
+func (ticket guardTicket) complete(lockedDown, abort <-chan struct{}) {
 	var result error
 	defer func() {
 		ticket.result <- result
 	}()
 
-	done := make(chan struct{})
-	go func() {
-		// If we're locking down, we should wait for all Visits to complete.
-		// If not, Visits are already being accepted and we're already done.
-		if !ticket.allowGuests {
-			waitLockedDown()
-		}
-		close(done)
-	}()
+	if ticket.allowGuests {
+		return
+	}
 	select {
-	case <-done:
+	case <-lockedDown:
+	case <-abort:
+		result = ErrAborted
 	case <-ticket.ctx.Done():
 		result = ErrAborted
 	}
@@ -152,13 +288,18 @@ type guestTicket struct {
 }
 
 // complete unconditionally sends any error returned from the Visit func, then
-// calls the finished func. It should be called on its own goroutine.
-func (ticket guestTicket) complete(finished func()) {
+// calls the finished func. The time spent in the Visit func is passed to the
+// observe func. It should be called on its own goroutine.
+func (ticket guestTicket) complete(finished func(), observe func(time.Duration)) {
 	defer finished()
 
+	start := time.Now()
+	err := ticket.visit()
+	observe(time.Since(start))
+
 	select {
 	case <-ticket.ctx.Done():
 		ticket.result <- ErrAborted
-	case ticket.result <- ticket.visit():
+	case ticket.result <- err:
 	}
 }
@@ -41,7 +41,7 @@ func (s *FortressSuite) TestOutputBadTarget(c *gc.C) {
 
 	var out interface{}
 	err := fix.manifold.Output(fix.worker, &out)
-	c.Check(err.Error(), gc.Equals, "out should be *fortress.Guest or *fortress.Guard; is *interface {}")
+	c.Check(err.Error(), gc.Equals, "out should be *fortress.Guest, *fortress.ReadOnlyGuest or *fortress.Guard; is *interface {}")
 	c.Check(out, gc.IsNil)
 }
 
@@ -160,6 +160,26 @@ func (s *FortressSuite) TestVisitSuccess(c *gc.C) {
 	c.Check(err, jc.ErrorIsNil)
 }
 
+func (s *FortressSuite) TestReadOnlyGuestVisit(c *gc.C) {
+	fix := newFixture(c)
+	defer fix.TearDown(c)
+	err := fix.Guard(c).Unlock(context.Background())
+	c.Check(err, jc.ErrorIsNil)
+
+	guest := fix.ReadOnlyGuest(c)
+	err = guest.Visit(context.Background(), badVisit)
+	c.Check(err, gc.ErrorMatches, "bad!")
+}
+
+func (s *FortressSuite) TestReadOnlyGuestNotGuard(c *gc.C) {
+	fix := newFixture(c)
+	defer fix.TearDown(c)
+
+	guest := fix.ReadOnlyGuest(c)
+	_, ok := guest.(fortress.Guard)
+	c.Check(ok, jc.IsFalse)
+}
+
 func (s *FortressSuite) TestConcurrentVisit(c *gc.C) {
 	fix := newFixture(c)
 	defer fix.TearDown(c)
@@ -322,6 +342,185 @@ func (s *FortressSuite) TestAbortedLockdownUnlock(c *gc.C) {
 	AssertUnlocked(c, fix.Guest(c))
 }
 
+func (s *FortressSuite) TestUnlockLeavesPendingLockdown(c *gc.C) {
+	fix := newFixture(c)
+	defer fix.TearDown(c)
+
+	// Start a long Visit to an unlocked fortress.
+	unblockVisit := fix.startBlockingVisit(c)
+	defer close(unblockVisit)
+
+	// Start a Lockdown call, which waits for the Visit.
+	guard := fix.Guard(c)
+	locked := make(chan error, 1)
+	go func() {
+		locked <- guard.Lockdown(context.Background())
+	}()
+	select {
+	case err := <-locked:
+		c.Fatalf("unexpected Lockdown result: %v", err)
+	case <-time.After(coretesting.ShortWait):
+	}
+	AssertLocked(c, fix.Guest(c))
+
+	// Unlock the fortress, and check the Lockdown is still waiting.
+	err := guard.Unlock(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case err := <-locked:
+		c.Fatalf("unexpected Lockdown result: %v", err)
+	case <-time.After(coretesting.ShortWait):
+	}
+
+	// The Lockdown completes once the original Visit does, leaving the
+	// fortress unlocked.
+	unblockVisit <- struct{}{}
+	select {
+	case err := <-locked:
+		c.Check(err, jc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out")
+	}
+	AssertUnlocked(c, fix.Guest(c))
+}
+
+func (s *FortressSuite) TestUnlockWithPolicyAbortsPendingLockdown(c *gc.C) {
+	fix := newFixture(c)
+	defer fix.TearDown(c)
+
+	// Start a long Visit to an unlocked fortress.
+	unblockVisit := fix.startBlockingVisit(c)
+	defer close(unblockVisit)
+
+	// Start a Lockdown call, which waits for the Visit.
+	guard := fix.Guard(c)
+	locked := make(chan error, 1)
+	go func() {
+		locked <- guard.Lockdown(context.Background())
+	}()
+	select {
+	case err := <-locked:
+		c.Fatalf("unexpected Lockdown result: %v", err)
+	case <-time.After(coretesting.ShortWait):
+	}
+	AssertLocked(c, fix.Guest(c))
+
+	// Unlock the fortress, and check the Lockdown is aborted.
+	err := guard.(fortress.PolicyGuard).UnlockWithPolicy(context.Background(), fortress.AbortLockdown)
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case err := <-locked:
+		c.Check(err, gc.Equals, fortress.ErrAborted)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out")
+	}
+
+	// The Lockdown stays aborted once the original Visit completes.
+	unblockVisit <- struct{}{}
+	AssertUnlocked(c, fix.Guest(c))
+}
+
+func (s *FortressSuite) TestUnlockWithPolicyAwaitsPendingLockdown(c *gc.C) {
+	fix := newFixture(c)
+	defer fix.TearDown(c)
+
+	// Start a long Visit to an unlocked fortress.
+	unblockVisit := fix.startBlockingVisit(c)
+	defer close(unblockVisit)
+
+	// Start a Lockdown call, which waits for the Visit.
+	guard := fix.Guard(c)
+	locked := make(chan error, 1)
+	go func() {
+		locked <- guard.Lockdown(context.Background())
+	}()
+	select {
+	case err := <-locked:
+		c.Fatalf("unexpected Lockdown result: %v", err)
+	case <-time.After(coretesting.ShortWait):
+	}
+	AssertLocked(c, fix.Guest(c))
+
+	// Start an Unlock that waits for the Lockdown, and check that
+	// nothing progresses...
+	unlocked := make(chan error, 1)
+	go func() {
+		unlocked <- guard.(fortress.PolicyGuard).UnlockWithPolicy(context.Background(), fortress.AwaitLockdown)
+	}()
+	select {
+	case err := <-locked:
+		c.Fatalf("unexpected Lockdown result: %v", err)
+	case err := <-unlocked:
+		c.Fatalf("unexpected Unlock result: %v", err)
+	case <-time.After(coretesting.ShortWait):
+	}
+	AssertLocked(c, fix.Guest(c))
+
+	// ...until the Visit completes, at which point the Lockdown succeeds
+	// and the fortress is then unlocked.
+	unblockVisit <- struct{}{}
+	select {
+	case err := <-locked:
+		c.Check(err, jc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out")
+	}
+	select {
+	case err := <-unlocked:
+		c.Check(err, jc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out")
+	}
+	AssertUnlocked(c, fix.Guest(c))
+}
+
+func (s *FortressSuite) TestUnlockWithPolicyAwaitAborted(c *gc.C) {
+	fix := newFixture(c)
+	defer fix.TearDown(c)
+
+	// Start a long Visit to an unlocked fortress.
+	unblockVisit := fix.startBlockingVisit(c)
+	defer close(unblockVisit)
+
+	// Start a Lockdown call, which waits for the Visit.
+	guard := fix.Guard(c)
+	locked := make(chan error, 1)
+	go func() {
+		locked <- guard.Lockdown(context.Background())
+	}()
+	select {
+	case err := <-locked:
+		c.Fatalf("unexpected Lockdown result: %v", err)
+	case <-time.After(coretesting.ShortWait):
+	}
+	AssertLocked(c, fix.Guest(c))
+
+	// Abort an Unlock that waits for the Lockdown.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := guard.(fortress.PolicyGuard).UnlockWithPolicy(ctx, fortress.AwaitLockdown)
+	c.Assert(err, gc.Equals, fortress.ErrAborted)
+
+	// The fortress remains locked, and the Lockdown still completes.
+	AssertLocked(c, fix.Guest(c))
+	unblockVisit <- struct{}{}
+	select {
+	case err := <-locked:
+		c.Check(err, jc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out")
+	}
+}
+
+func (s *FortressSuite) TestUnlockWithPolicyAwaitNoPendingLockdown(c *gc.C) {
+	fix := newFixture(c)
+	defer fix.TearDown(c)
+
+	err := fix.Guard(c).(fortress.PolicyGuard).UnlockWithPolicy(context.Background(), fortress.AwaitLockdown)
+	c.Assert(err, jc.ErrorIsNil)
+	AssertUnlocked(c, fix.Guest(c))
+}
+
 func (s *FortressSuite) TestIsFortressError(c *gc.C) {
 	c.Check(fortress.IsFortressError(fortress.ErrAborted), jc.IsTrue)
 	c.Check(fortress.IsFortressError(fortress.ErrShutdown), jc.IsTrue)
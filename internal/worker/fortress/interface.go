@@ -24,6 +24,35 @@ type Guard interface {
 	Lockdown(context.Context) error
 }
 
+// PolicyGuard is a Guard that can also choose how unlocking treats a
+// Lockdown that is still waiting for existing Guest.Visit calls to complete.
+// A plain Unlock leaves such a Lockdown to complete once all Visit calls,
+// including those started after the Unlock, have completed. Clients that
+// need different behaviour can check for PolicyGuard with a type assertion.
+type PolicyGuard interface {
+	Guard
+
+	// UnlockWithPolicy unblocks all Guest.Visit calls, handling any
+	// Lockdown that is still waiting for existing calls to complete
+	// according to the supplied policy. It will return ErrAborted if the
+	// supplied Context is cancelled before the fortress is unlocked.
+	UnlockWithPolicy(context.Context, LockdownPolicy) error
+}
+
+// LockdownPolicy determines how UnlockWithPolicy treats a Lockdown that is
+// still waiting for existing Guest.Visit calls to complete.
+type LockdownPolicy int
+
+const (
+	// AbortLockdown unlocks the fortress immediately; any Lockdown still
+	// waiting for existing calls to complete returns ErrAborted.
+	AbortLockdown LockdownPolicy = iota + 1
+
+	// AwaitLockdown waits for any pending Lockdown to complete before
+	// unlocking the fortress, such that the Lockdown succeeds.
+	AwaitLockdown
+)
+
 // Guest allows clients to Visit a fortress when it's unlocked; that is, to
 // get non-exclusive access to whatever resource is being protected for the
 // duration of the supplied Visit func.
@@ -34,6 +63,17 @@ type Guest interface {
 	Visit(context.Context, Visit) error
 }
 
+// ReadOnlyGuest allows clients to Visit a fortress, in the same way as a
+// Guest. It's a distinct output type so that a client that only needs to
+// visit can't be wired up with anything that also implies control of the
+// fortress.
+type ReadOnlyGuest interface {
+	// Visit waits until the fortress is unlocked, then runs the supplied
+	// Visit func. It will return ErrAborted if the supplied Abort is closed
+	// before the Visit is started.
+	Visit(context.Context, Visit) error
+}
+
 // Visit is an operation that can be performed by a Guest.
 type Visit func() error
 
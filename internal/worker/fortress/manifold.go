@@ -9,8 +9,29 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
+// ManifoldConfig holds the optional configuration of a fortress manifold.
+type ManifoldConfig struct {
+	// Name identifies the fortress in its metrics. It's required if
+	// PrometheusRegisterer is set.
+	Name string
+
+	// PrometheusRegisterer, if set, is used to register metrics describing
+	// how long the fortress spends locked down. The metrics are unregistered
+	// when the fortress stops.
+	PrometheusRegisterer prometheus.Registerer
+}
+
+// Validate is called by start to check for bad configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.PrometheusRegisterer != nil && config.Name == "" {
+		return errors.NotValidf("empty Name with PrometheusRegisterer")
+	}
+	return nil
+}
+
 // Manifold returns a dependency.Manifold that runs a fortress.
 //
 // Clients should access the fortress resource via Guard and/or Guest pointers.
@@ -21,9 +42,30 @@ import (
 // determined by whichever guard last ran an operation; that is to say, it will
 // be impossible to reliably tell from outside. So please don't do that.
 func Manifold() dependency.Manifold {
+	return ManifoldWithConfig(ManifoldConfig{})
+}
+
+// ManifoldWithConfig returns a dependency.Manifold that runs a fortress, as
+// Manifold does, collecting metrics if the config has a PrometheusRegisterer.
+func ManifoldWithConfig(config ManifoldConfig) dependency.Manifold {
 	return dependency.Manifold{
 		Start: func(_ context.Context, _ dependency.Getter) (worker.Worker, error) {
-			return newFortress(), nil
+			if err := config.Validate(); err != nil {
+				return nil, errors.Trace(err)
+			}
+			if config.PrometheusRegisterer == nil {
+				return newFortress(nil, nil), nil
+			}
+
+			metrics := NewMetricsCollector(config.Name)
+			if err := config.PrometheusRegisterer.Register(metrics); err != nil {
+				return nil, errors.Trace(err)
+			}
+			return newFortress(metrics, func() {
+				// Clean up the metrics for the fortress, so the next time
+				// one is started we can safely register the metrics again.
+				config.PrometheusRegisterer.Unregister(metrics)
+			}), nil
 		},
 		Output: func(in worker.Worker, out interface{}) error {
 			inFortress, _ := in.(*fortress)
@@ -35,10 +77,24 @@ func Manifold() dependency.Manifold {
 				*outPointer = inFortress
 			case *Guest:
 				*outPointer = inFortress
+			case *ReadOnlyGuest:
+				// Wrap the fortress, so the Guard methods can't be
+				// reached by a type assertion.
+				*outPointer = readOnlyGuest{guest: inFortress}
 			default:
-				return errors.Errorf("out should be *fortress.Guest or *fortress.Guard; is %T", out)
+				return errors.Errorf("out should be *fortress.Guest, *fortress.ReadOnlyGuest or *fortress.Guard; is %T", out)
 			}
 			return nil
 		},
 	}
 }
+
+// readOnlyGuest exposes only the Visit method of a Guest.
+type readOnlyGuest struct {
+	guest Guest
+}
+
+// Visit is part of the ReadOnlyGuest interface.
+func (g readOnlyGuest) Visit(ctx context.Context, visit Visit) error {
+	return g.guest.Visit(ctx, visit)
+}
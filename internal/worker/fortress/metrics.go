@@ -0,0 +1,67 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package fortress
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	fortressMetricsNamespace   = "juju"
+	fortressSubsystemNamespace = "fortress"
+)
+
+// Collector defines a prometheus collector for a fortress.
+type Collector struct {
+	// Locked is 1 while the fortress is locked down, and 0 while it is
+	// unlocked.
+	Locked prometheus.Gauge
+	// Lockdowns counts the transitions from unlocked to locked down.
+	Lockdowns prometheus.Counter
+	// VisitDuration observes the duration, in seconds, of each Visit func
+	// when it returns, whether or not it succeeded.
+	VisitDuration prometheus.Histogram
+}
+
+// NewMetricsCollector returns a new Collector for the named fortress.
+func NewMetricsCollector(name string) *Collector {
+	labels := prometheus.Labels{"fortress": name}
+	return &Collector{
+		Locked: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   fortressMetricsNamespace,
+			Subsystem:   fortressSubsystemNamespace,
+			Name:        "locked",
+			Help:        "Whether the fortress is locked down (1) or unlocked (0).",
+			ConstLabels: labels,
+		}),
+		Lockdowns: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace:   fortressMetricsNamespace,
+			Subsystem:   fortressSubsystemNamespace,
+			Name:        "lockdowns_total",
+			Help:        "Total number of transitions from unlocked to locked down.",
+			ConstLabels: labels,
+		}),
+		VisitDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace:   fortressMetricsNamespace,
+			Subsystem:   fortressSubsystemNamespace,
+			Name:        "visit_duration_seconds",
+			Help:        "Duration in seconds of each visit to the fortress, observed when the visit func returns, whether or not it succeeded.",
+			ConstLabels: labels,
+		}),
+	}
+}
+
+// Describe is part of the prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.Locked.Describe(ch)
+	c.Lockdowns.Describe(ch)
+	c.VisitDuration.Describe(ch)
+}
+
+// Collect is part of the prometheus.Collector interface.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.Locked.Collect(ch)
+	c.Lockdowns.Collect(ch)
+	c.VisitDuration.Collect(ch)
+}
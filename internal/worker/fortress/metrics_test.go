@@ -0,0 +1,129 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package fortress_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/worker/fortress"
+)
+
+type MetricsSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&MetricsSuite{})
+
+func (s *MetricsSuite) TestValidateMissingName(c *gc.C) {
+	manifold := fortress.ManifoldWithConfig(fortress.ManifoldConfig{
+		PrometheusRegisterer: prometheus.NewRegistry(),
+	})
+	_, err := manifold.Start(context.Background(), nil)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *MetricsSuite) TestMetrics(c *gc.C) {
+	registry := prometheus.NewRegistry()
+	manifold := fortress.ManifoldWithConfig(fortress.ManifoldConfig{
+		Name:                 "test",
+		PrometheusRegisterer: registry,
+	})
+	w, err := manifold.Start(context.Background(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	defer CheckStop(c, w)
+
+	var guard fortress.Guard
+	c.Assert(manifold.Output(w, &guard), jc.ErrorIsNil)
+	var guest fortress.Guest
+	c.Assert(manifold.Output(w, &guest), jc.ErrorIsNil)
+
+	// The fortress starts locked, and an initial lockdown isn't a
+	// transition.
+	c.Assert(guard.Lockdown(context.Background()), jc.ErrorIsNil)
+	s.checkValue(c, registry, "juju_fortress_locked", 1)
+	s.checkValue(c, registry, "juju_fortress_lockdowns_total", 0)
+
+	c.Assert(guard.Unlock(context.Background()), jc.ErrorIsNil)
+	s.checkValue(c, registry, "juju_fortress_locked", 0)
+
+	err = guest.Visit(context.Background(), func() error { return nil })
+	c.Assert(err, jc.ErrorIsNil)
+	err = guest.Visit(context.Background(), badVisit)
+	c.Assert(err, gc.ErrorMatches, "bad!")
+
+	c.Assert(guard.Lockdown(context.Background()), jc.ErrorIsNil)
+	s.checkValue(c, registry, "juju_fortress_locked", 1)
+	s.checkValue(c, registry, "juju_fortress_lockdowns_total", 1)
+
+	families, err := registry.Gather()
+	c.Assert(err, jc.ErrorIsNil)
+	var visits uint64
+	for _, family := range families {
+		if family.GetName() == "juju_fortress_visit_duration_seconds" {
+			visits = family.GetMetric()[0].GetHistogram().GetSampleCount()
+		}
+	}
+	c.Check(visits, gc.Equals, uint64(2))
+}
+
+func (s *MetricsSuite) TestMetricsUnregisteredOnStop(c *gc.C) {
+	registry := prometheus.NewRegistry()
+	manifold := fortress.ManifoldWithConfig(fortress.ManifoldConfig{
+		Name:                 "test",
+		PrometheusRegisterer: registry,
+	})
+	w, err := manifold.Start(context.Background(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A second fortress with the same name can't register its metrics.
+	_, err = manifold.Start(context.Background(), nil)
+	c.Assert(err, gc.ErrorMatches, ".*duplicate metrics collector registration attempted")
+
+	CheckStop(c, w)
+
+	w, err = manifold.Start(context.Background(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	CheckStop(c, w)
+}
+
+func (s *MetricsSuite) TestMetricsNamedFortressesIndependent(c *gc.C) {
+	registry := prometheus.NewRegistry()
+	for _, name := range []string{"foo", "bar"} {
+		manifold := fortress.ManifoldWithConfig(fortress.ManifoldConfig{
+			Name:                 name,
+			PrometheusRegisterer: registry,
+		})
+		w, err := manifold.Start(context.Background(), nil)
+		c.Assert(err, jc.ErrorIsNil)
+		defer CheckStop(c, w)
+	}
+	count, err := testutil.GatherAndCount(registry, "juju_fortress_locked")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 2)
+}
+
+func (s *MetricsSuite) checkValue(c *gc.C, registry *prometheus.Registry, name string, expected float64) {
+	families, err := registry.Gather()
+	c.Assert(err, jc.ErrorIsNil)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		metric := family.GetMetric()[0]
+		if gauge := metric.GetGauge(); gauge != nil {
+			c.Check(gauge.GetValue(), gc.Equals, expected, gc.Commentf("%s", name))
+		} else {
+			c.Check(metric.GetCounter().GetValue(), gc.Equals, expected, gc.Commentf("%s", name))
+		}
+		return
+	}
+	c.Errorf("metric %q not found", name)
+}
@@ -55,6 +55,14 @@ func (fix *fixture) Guest(c *gc.C) (out fortress.Guest) {
 	return out
 }
 
+// ReadOnlyGuest returns a fortress.ReadOnlyGuest backed by the fixture's
+// worker.
+func (fix *fixture) ReadOnlyGuest(c *gc.C) (out fortress.ReadOnlyGuest) {
+	err := fix.manifold.Output(fix.worker, &out)
+	c.Assert(err, jc.ErrorIsNil)
+	return out
+}
+
 // startBlockingVisit Unlocks the fortress; starts a Visit and waits for it to
 // be invoked; then leaves that Visit blocking, and returns a channel on which
 // you (1) *can* send a value to unblock the visit but (2) *must* defer a close
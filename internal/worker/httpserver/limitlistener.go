@@ -0,0 +1,104 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package httpserver
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/juju/juju/core/logger"
+)
+
+const (
+	httpserverMetricsNamespace   = "juju"
+	httpserverSubsystemNamespace = "httpserver"
+)
+
+// limitListener is a listener which tracks the number of open connections
+// and, if max is greater than zero, closes any new connection accepted
+// while max connections are already open. Rejecting the connection rather
+// than leaving it in the accept backlog frees its file descriptor straight
+// away.
+type limitListener struct {
+	listener
+	max    int
+	logger logger.Logger
+
+	active   atomic.Int64
+	rejected prometheus.Counter
+}
+
+func newLimitListener(l listener, max int, logger logger.Logger) *limitListener {
+	return &limitListener{
+		listener: l,
+		max:      max,
+		logger:   logger,
+		rejected: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: httpserverMetricsNamespace,
+			Subsystem: httpserverSubsystemNamespace,
+			Name:      "api_connections_rejected_total",
+			Help:      "Total number of API connections rejected because the connection limit was reached.",
+		}),
+	}
+}
+
+// Accept implements net.Listener.
+func (l *limitListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		n := l.active.Add(1)
+		if l.max <= 0 || n <= int64(l.max) {
+			return &limitConn{Conn: conn, release: func() { l.active.Add(-1) }}, nil
+		}
+		l.active.Add(-1)
+		l.rejected.Inc()
+		l.logger.Debugf(context.Background(), "rejecting connection from %s, limit of %d reached", conn.RemoteAddr(), l.max)
+		_ = conn.Close()
+	}
+}
+
+// connections returns the number of currently open connections.
+func (l *limitListener) connections() int64 {
+	return l.active.Load()
+}
+
+// Describe is part of the prometheus.Collector interface.
+func (l *limitListener) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(l, ch)
+}
+
+// Collect is part of the prometheus.Collector interface.
+func (l *limitListener) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(
+		prometheus.NewDesc(
+			prometheus.BuildFQName(httpserverMetricsNamespace, httpserverSubsystemNamespace, "api_connections"),
+			"Number of open API connections.",
+			nil, nil,
+		),
+		prometheus.GaugeValue,
+		float64(l.connections()),
+	)
+	l.rejected.Collect(ch)
+}
+
+// limitConn releases its slot in the limitListener when it is closed.
+type limitConn struct {
+	net.Conn
+	once    sync.Once
+	release func()
+}
+
+// Close implements net.Conn.
+func (c *limitConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.release)
+	return err
+}
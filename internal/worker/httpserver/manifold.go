@@ -26,6 +26,10 @@ import (
 	workerstate "github.com/juju/juju/internal/worker/state"
 )
 
+// autocertPruneInterval is how often expired certificates are pruned from
+// the autocert cache.
+const autocertPruneInterval = 24 * time.Hour
+
 // ManifoldConfig holds the information necessary to run an HTTP server
 // in a dependency.Engine.
 type ManifoldConfig struct {
@@ -48,6 +52,16 @@ type ManifoldConfig struct {
 
 	Logger logger.Logger
 
+	// APIPortOpenDelayOverride, if not nil, is used as the api port open
+	// delay instead of the api-port-open-delay controller config value.
+	// Setting it to zero skips the delay entirely, e.g. for a fast cutover
+	// during a controller upgrade.
+	APIPortOpenDelayOverride *time.Duration
+
+	// MaxAPIConnections is the maximum number of connections that may be
+	// open at once on the API port. Zero means unlimited.
+	MaxAPIConnections int
+
 	GetControllerConfig func(context.Context, ControllerConfigGetter) (controller.Config, error)
 	NewTLSConfig        func(string, string, autocert.Cache, SNIGetterFunc, logger.Logger) *tls.Config
 	NewWorker           func(Config) (worker.Worker, error)
@@ -100,6 +114,12 @@ func (config ManifoldConfig) Validate() error {
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
+	if config.APIPortOpenDelayOverride != nil && *config.APIPortOpenDelayOverride < 0 {
+		return errors.NotValidf("APIPortOpenDelayOverride %v", *config.APIPortOpenDelayOverride)
+	}
+	if config.MaxAPIConnections < 0 {
+		return errors.NotValidf("MaxAPIConnections %d", config.MaxAPIConnections)
+	}
 	return nil
 }
 
@@ -165,27 +185,37 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		_ = stTracker.Done()
 		return nil, errors.Annotate(err, "unable to get controller config")
 	}
-	tlsConfig := config.NewTLSConfig(
-		controllerConfig.AutocertDNSName(),
-		controllerConfig.AutocertURL(),
-		controllerDomainServices.AutocertCache(),
-		pkitls.AuthoritySNITLSGetter(authority, config.Logger),
-		config.Logger,
-	)
+	apiPortOpenDelay := controllerConfig.APIPortOpenDelay()
+	if config.APIPortOpenDelayOverride != nil {
+		apiPortOpenDelay = *config.APIPortOpenDelayOverride
+	}
+
+	autocertCache := controllerDomainServices.AutocertCache()
+	sniGetter := pkitls.AuthoritySNITLSGetter(authority, config.Logger)
+	newTLSConfig := func(dnsName, url string) *tls.Config {
+		return config.NewTLSConfig(dnsName, url, autocertCache, sniGetter, config.Logger)
+	}
+	tlsConfig := newTLSConfig(controllerConfig.AutocertDNSName(), controllerConfig.AutocertURL())
 
 	w, err := config.NewWorker(Config{
-		AgentName:            config.AgentName,
-		Clock:                config.Clock,
-		PrometheusRegisterer: config.PrometheusRegisterer,
-		Hub:                  hub,
-		TLSConfig:            tlsConfig,
-		Mux:                  mux,
-		MuxShutdownWait:      config.MuxShutdownWait,
-		LogDir:               config.LogDir,
-		Logger:               config.Logger,
-		APIPort:              controllerConfig.APIPort(),
-		APIPortOpenDelay:     controllerConfig.APIPortOpenDelay(),
-		ControllerAPIPort:    controllerConfig.ControllerAPIPort(),
+		AgentName:             config.AgentName,
+		Clock:                 config.Clock,
+		PrometheusRegisterer:  config.PrometheusRegisterer,
+		Hub:                   hub,
+		TLSConfig:             tlsConfig,
+		Mux:                   mux,
+		MuxShutdownWait:       config.MuxShutdownWait,
+		LogDir:                config.LogDir,
+		Logger:                config.Logger,
+		APIPort:               controllerConfig.APIPort(),
+		APIPortOpenDelay:      apiPortOpenDelay,
+		ControllerAPIPort:     controllerConfig.ControllerAPIPort(),
+		MaxAPIConnections:     config.MaxAPIConnections,
+		AutocertCache:         autocertCache,
+		AutocertPruneInterval: autocertPruneInterval,
+
+		ControllerConfigService: controllerDomainServices.ControllerConfig(),
+		NewTLSConfig:            newTLSConfig,
 	})
 	if err != nil {
 		_ = stTracker.Done()
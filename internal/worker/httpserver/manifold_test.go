@@ -184,22 +184,59 @@ func (s *ManifoldSuite) TestStart(c *gc.C) {
 	c.Assert(newWorkerArgs[0], gc.FitsTypeOf, httpserver.Config{})
 	config := newWorkerArgs[0].(httpserver.Config)
 
+	// The TLS config reload func wraps the manifold's NewTLSConfig.
+	c.Assert(config.NewTLSConfig, gc.NotNil)
+	c.Check(config.NewTLSConfig("example.com", ""), gc.Equals, s.tlsConfig)
+	s.stub.CheckCall(c, 3, "NewTLSConfig", "example.com")
+	config.NewTLSConfig = nil
+
 	c.Assert(config, jc.DeepEquals, httpserver.Config{
-		AgentName:            "machine-42",
-		Clock:                s.clock,
-		PrometheusRegisterer: &s.prometheusRegisterer,
-		Hub:                  s.hub,
-		TLSConfig:            s.tlsConfig,
-		Mux:                  s.mux,
-		APIPort:              1024,
-		APIPortOpenDelay:     5 * time.Second,
-		ControllerAPIPort:    2048,
-		MuxShutdownWait:      1 * time.Minute,
-		LogDir:               "log-dir",
-		Logger:               s.config.Logger,
+		AgentName:             "machine-42",
+		Clock:                 s.clock,
+		PrometheusRegisterer:  &s.prometheusRegisterer,
+		Hub:                   s.hub,
+		TLSConfig:             s.tlsConfig,
+		Mux:                   s.mux,
+		APIPort:               1024,
+		APIPortOpenDelay:      5 * time.Second,
+		ControllerAPIPort:     2048,
+		MuxShutdownWait:       1 * time.Minute,
+		LogDir:                "log-dir",
+		Logger:                s.config.Logger,
+		AutocertCache:         s.autocertCacheGetter,
+		AutocertPruneInterval: 24 * time.Hour,
+
+		ControllerConfigService: s.controllerConfigGetter,
 	})
 }
 
+func (s *ManifoldSuite) TestStartWithAPIPortOpenDelayOverride(c *gc.C) {
+	delay := time.Duration(0)
+	s.config.APIPortOpenDelayOverride = &delay
+	s.manifold = httpserver.Manifold(s.config)
+
+	w := s.startWorkerClean(c)
+	workertest.CleanKill(c, w)
+
+	s.stub.CheckCallNames(c, "GetControllerConfig", "NewTLSConfig", "NewWorker")
+	config := s.stub.Calls()[2].Args[0].(httpserver.Config)
+
+	// The override takes precedence over the controller config value.
+	c.Check(config.APIPortOpenDelay, gc.Equals, time.Duration(0))
+}
+
+func (s *ManifoldSuite) TestStartWithMaxAPIConnections(c *gc.C) {
+	s.config.MaxAPIConnections = 100
+	s.manifold = httpserver.Manifold(s.config)
+
+	w := s.startWorkerClean(c)
+	workertest.CleanKill(c, w)
+
+	s.stub.CheckCallNames(c, "GetControllerConfig", "NewTLSConfig", "NewWorker")
+	config := s.stub.Calls()[2].Args[0].(httpserver.Config)
+	c.Check(config.MaxAPIConnections, gc.Equals, 100)
+}
+
 func (s *ManifoldSuite) TestValidate(c *gc.C) {
 	type test struct {
 		f      func(*httpserver.ManifoldConfig)
@@ -241,6 +278,15 @@ func (s *ManifoldSuite) TestValidate(c *gc.C) {
 	}, {
 		f:      func(cfg *httpserver.ManifoldConfig) { cfg.NewWorker = nil },
 		expect: "nil NewWorker not valid",
+	}, {
+		f: func(cfg *httpserver.ManifoldConfig) {
+			delay := -time.Second
+			cfg.APIPortOpenDelayOverride = &delay
+		},
+		expect: "APIPortOpenDelayOverride -1s not valid",
+	}, {
+		f:      func(cfg *httpserver.ManifoldConfig) { cfg.MaxAPIConnections = -1 },
+		expect: "MaxAPIConnections -1 not valid",
 	}}
 	for i, test := range tests {
 		c.Logf("test #%d (%s)", i, test.expect)
@@ -9,6 +9,7 @@ import (
 	"github.com/juju/worker/v4"
 
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/watcher"
 )
 
 // NewWorkerShim calls through to NewWorker, and exists only
@@ -22,6 +23,16 @@ type ControllerConfigGetter interface {
 	ControllerConfig(context.Context) (controller.Config, error)
 }
 
+// ControllerConfigService is an interface that returns the controller config
+// and watches it for changes.
+type ControllerConfigService interface {
+	ControllerConfigGetter
+
+	// WatchControllerConfig returns a watcher that returns the keys of
+	// any changed controller config.
+	WatchControllerConfig() (watcher.StringsWatcher, error)
+}
+
 // GetControllerConfig gets the controller config from a *State - it
 // exists so we can test the manifold without a StateSuite.
 func GetControllerConfig(ctx context.Context, getter ControllerConfigGetter) (controller.Config, error) {
@@ -16,6 +16,7 @@ import (
 	"runtime/pprof"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/juju/clock"
@@ -25,6 +26,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/internal/pubsub/apiserver"
 )
@@ -35,6 +37,13 @@ var (
 	ShutdownTimeout = 30 * time.Second
 )
 
+// AutocertCachePruner removes expired certificates from the autocert cache.
+type AutocertCachePruner interface {
+	// PruneExpired removes the expired certificates from the cache,
+	// returning the number of entries removed.
+	PruneExpired(ctx context.Context) (int, error)
+}
+
 // Config is the configuration required for running an API server worker.
 type Config struct {
 	AgentName            string
@@ -49,6 +58,22 @@ type Config struct {
 	APIPort              int
 	APIPortOpenDelay     time.Duration
 	ControllerAPIPort    int
+
+	// MaxAPIConnections is the maximum number of connections that may be
+	// open at once. New connections past the limit are closed as soon as
+	// they are accepted. Zero means unlimited.
+	MaxAPIConnections int
+
+	// AutocertCache is optional. If set, expired certificates are pruned
+	// from it every AutocertPruneInterval.
+	AutocertCache         AutocertCachePruner
+	AutocertPruneInterval time.Duration
+
+	// ControllerConfigService is optional. If set, the TLS config used for
+	// new connections is rebuilt with NewTLSConfig whenever the autocert
+	// DNS name or URL in the controller config changes.
+	ControllerConfigService ControllerConfigService
+	NewTLSConfig            func(dnsName, url string) *tls.Config
 }
 
 // Validate validates the API server configuration.
@@ -68,6 +93,33 @@ func (config Config) Validate() error {
 	if config.PrometheusRegisterer == nil {
 		return errors.NotValidf("nil PrometheusRegisterer")
 	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Hub == nil {
+		return errors.NotValidf("nil Hub")
+	}
+	if config.APIPort <= 0 {
+		return errors.NotValidf("APIPort %d", config.APIPort)
+	}
+	if config.ControllerAPIPort < 0 {
+		return errors.NotValidf("ControllerAPIPort %d", config.ControllerAPIPort)
+	}
+	if config.APIPortOpenDelay < 0 {
+		return errors.NotValidf("APIPortOpenDelay %v", config.APIPortOpenDelay)
+	}
+	if config.MaxAPIConnections < 0 {
+		return errors.NotValidf("MaxAPIConnections %d", config.MaxAPIConnections)
+	}
+	if config.MuxShutdownWait < 1*time.Minute {
+		return errors.NotValidf("MuxShutdownWait %v", config.MuxShutdownWait)
+	}
+	if config.AutocertCache != nil && config.AutocertPruneInterval <= 0 {
+		return errors.NotValidf("AutocertPruneInterval %v", config.AutocertPruneInterval)
+	}
+	if config.ControllerConfigService != nil && config.NewTLSConfig == nil {
+		return errors.NotValidf("nil NewTLSConfig")
+	}
 	return nil
 }
 
@@ -83,6 +135,7 @@ func NewWorker(config Config) (*Worker, error) {
 		url:    make(chan string),
 		status: "starting",
 	}
+	w.tlsConfig.Store(config.TLSConfig)
 	var err error
 	var listener listener
 	if w.config.ControllerAPIPort == 0 {
@@ -93,13 +146,19 @@ func NewWorker(config Config) (*Worker, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	w.holdable = newHeldListener(listener, config.Clock)
+	w.limited = newLimitListener(listener, config.MaxAPIConnections, config.Logger)
+	if err := config.PrometheusRegisterer.Register(w.limited); err != nil {
+		listener.Close()
+		return nil, errors.Annotate(err, "registering connection metrics")
+	}
+	w.holdable = newHeldListener(w.limited, config.Clock)
 
 	if err := catacomb.Invoke(catacomb.Plan{
 		Name: "httpserver",
 		Site: &w.catacomb,
 		Work: w.loop,
 	}); err != nil {
+		config.PrometheusRegisterer.Unregister(w.limited)
 		listener.Close()
 		return nil, errors.Trace(err)
 	}
@@ -115,8 +174,14 @@ type Worker struct {
 	config   Config
 	url      chan string
 	holdable *heldListener
+	limited  *limitListener
 	logger   logger.Logger
 
+	// tlsConfig holds the TLS config used for new connections. It is
+	// swapped when the controller config changes, leaving the config of
+	// existing connections untouched.
+	tlsConfig atomic.Pointer[tls.Config]
+
 	// mu controls access to both status and reporter.
 	mu     sync.Mutex
 	status string
@@ -146,6 +211,9 @@ func (w *Worker) Report() map[string]interface{} {
 		result["api-port-open-delay"] = w.config.APIPortOpenDelay
 		result["controller-api-port"] = w.config.ControllerAPIPort
 	}
+	if w.config.MaxAPIConnections > 0 {
+		result["max-api-connections"] = w.config.MaxAPIConnections
+	}
 	w.mu.Unlock()
 	return result
 }
@@ -165,6 +233,10 @@ func (w *Worker) loop() error {
 	ctx, cancel := w.scopedContext()
 	defer cancel()
 
+	// Unregister the connection metrics, so that they can be registered
+	// again the next time the worker is started.
+	defer w.config.PrometheusRegisterer.Unregister(w.limited)
+
 	serverLog := log.New(&loggerWrapper{
 		level:  logger.WARNING,
 		logger: w.logger,
@@ -175,7 +247,9 @@ func (w *Worker) loop() error {
 		ErrorLog:  serverLog,
 	}
 	go func() {
-		err := server.Serve(tls.NewListener(w.holdable, w.config.TLSConfig))
+		err := server.Serve(tls.NewListener(w.holdable, &tls.Config{
+			GetConfigForClient: w.getTLSConfig,
+		}))
 		if err != nil && err != http.ErrServerClosed {
 			w.logger.Errorf(ctx, "server finished with error %v", err)
 		}
@@ -196,6 +270,23 @@ func (w *Worker) loop() error {
 	w.status = "running"
 	w.mu.Unlock()
 
+	var pruneAutocerts <-chan time.Time
+	if w.config.AutocertCache != nil {
+		pruneAutocerts = w.config.Clock.After(w.config.AutocertPruneInterval)
+	}
+
+	var configChanges <-chan []string
+	if w.config.ControllerConfigService != nil {
+		configWatcher, err := w.config.ControllerConfigService.WatchControllerConfig()
+		if err != nil {
+			return errors.Annotate(err, "watching controller config")
+		}
+		if err := w.catacomb.Add(configWatcher); err != nil {
+			return errors.Trace(err)
+		}
+		configChanges = configWatcher.Changes()
+	}
+
 	for {
 		select {
 		case <-w.catacomb.Dying():
@@ -208,10 +299,66 @@ func (w *Worker) loop() error {
 			w.holdable.hold()
 			return w.shutdown(ctx)
 		case w.url <- w.holdable.URL():
+		case <-pruneAutocerts:
+			w.pruneAutocertCache(ctx)
+			pruneAutocerts = w.config.Clock.After(w.config.AutocertPruneInterval)
+		case keys, ok := <-configChanges:
+			if !ok {
+				return errors.New("controller config watcher closed")
+			}
+			if !containsTLSKey(keys) {
+				continue
+			}
+			if err := w.reloadTLSConfig(ctx); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 }
 
+// getTLSConfig returns the TLS config to use for a new connection.
+func (w *Worker) getTLSConfig(*tls.ClientHelloInfo) (*tls.Config, error) {
+	return w.tlsConfig.Load(), nil
+}
+
+// reloadTLSConfig rebuilds the TLS config from the current controller
+// config, and uses it for any new connections.
+func (w *Worker) reloadTLSConfig(ctx context.Context) error {
+	controllerConfig, err := w.config.ControllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting controller config")
+	}
+	dnsName := controllerConfig.AutocertDNSName()
+	w.tlsConfig.Store(w.config.NewTLSConfig(dnsName, controllerConfig.AutocertURL()))
+	w.logger.Infof(ctx, "reloaded TLS config with autocert DNS name %q", dnsName)
+	return nil
+}
+
+// containsTLSKey returns true if any of the controller config keys affect
+// the TLS config.
+func containsTLSKey(keys []string) bool {
+	for _, key := range keys {
+		switch key {
+		case controller.AutocertDNSNameKey, controller.AutocertURLKey:
+			return true
+		}
+	}
+	return false
+}
+
+// pruneAutocertCache removes expired certificates from the autocert cache.
+// Failing to prune isn't fatal; we'll try again at the next interval.
+func (w *Worker) pruneAutocertCache(ctx context.Context) {
+	pruned, err := w.config.AutocertCache.PruneExpired(ctx)
+	if err != nil {
+		w.logger.Warningf(ctx, "pruning autocert cache: %v", err)
+		return
+	}
+	if pruned > 0 {
+		w.logger.Infof(ctx, "pruned %d expired certificates from the autocert cache", pruned)
+	}
+}
+
 func (w *Worker) shutdown(ctx context.Context) error {
 	muxDone := make(chan struct{})
 	go func() {
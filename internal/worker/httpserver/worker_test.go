@@ -4,6 +4,7 @@
 package httpserver_test
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
 	"github.com/juju/pubsub/v2"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -24,6 +26,9 @@ import (
 
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
 	dqlitetesting "github.com/juju/juju/internal/database/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/pubsub/apiserver"
@@ -64,7 +69,7 @@ func (s *workerFixture) SetUpTest(c *gc.C) {
 		LogDir:               s.logDir,
 		MuxShutdownWait:      1 * time.Minute,
 		Hub:                  s.hub,
-		APIPort:              0,
+		APIPort:              dqlitetesting.FindTCPPort(c),
 		APIPortOpenDelay:     0,
 		ControllerAPIPort:    0,
 		Logger:               loggertesting.WrapCheckLog(c),
@@ -94,6 +99,39 @@ func (s *WorkerValidationSuite) TestValidateErrors(c *gc.C) {
 	}, {
 		f:      func(cfg *httpserver.Config) { cfg.PrometheusRegisterer = nil },
 		expect: "nil PrometheusRegisterer not valid",
+	}, {
+		f:      func(cfg *httpserver.Config) { cfg.Clock = nil },
+		expect: "nil Clock not valid",
+	}, {
+		f:      func(cfg *httpserver.Config) { cfg.Hub = nil },
+		expect: "nil Hub not valid",
+	}, {
+		f:      func(cfg *httpserver.Config) { cfg.APIPort = 0 },
+		expect: "APIPort 0 not valid",
+	}, {
+		f:      func(cfg *httpserver.Config) { cfg.ControllerAPIPort = -1 },
+		expect: "ControllerAPIPort -1 not valid",
+	}, {
+		f:      func(cfg *httpserver.Config) { cfg.MuxShutdownWait = time.Second },
+		expect: "MuxShutdownWait 1s not valid",
+	}, {
+		f:      func(cfg *httpserver.Config) { cfg.APIPortOpenDelay = -time.Second },
+		expect: "APIPortOpenDelay -1s not valid",
+	}, {
+		f:      func(cfg *httpserver.Config) { cfg.MaxAPIConnections = -1 },
+		expect: "MaxAPIConnections -1 not valid",
+	}, {
+		f: func(cfg *httpserver.Config) {
+			cfg.AutocertCache = &stubAutocertCache{}
+			cfg.AutocertPruneInterval = 0
+		},
+		expect: "AutocertPruneInterval 0s not valid",
+	}, {
+		f: func(cfg *httpserver.Config) {
+			cfg.ControllerConfigService = &stubControllerConfigService{}
+			cfg.NewTLSConfig = nil
+		},
+		expect: "nil NewTLSConfig not valid",
 	}}
 	for i, test := range tests {
 		c.Logf("test #%d (%s)", i, test.expect)
@@ -113,6 +151,13 @@ func (s *WorkerValidationSuite) testValidateError(c *gc.C, f func(*httpserver.Co
 	c.Check(err, gc.ErrorMatches, expect)
 }
 
+func (s *WorkerValidationSuite) TestRegisterMetricsError(c *gc.C) {
+	s.prometheusRegisterer.SetErrors(errors.New("boom"))
+	w, err := httpserver.NewWorker(s.config)
+	c.Check(w, gc.IsNil)
+	c.Check(err, gc.ErrorMatches, "registering connection metrics: boom")
+}
+
 type WorkerSuite struct {
 	workerFixture
 	worker *httpserver.Worker
@@ -250,6 +295,15 @@ func (s *WorkerSuite) TestMinTLSVersion(c *gc.C) {
 	c.Assert(conn, gc.IsNil)
 }
 
+func (s *WorkerSuite) TestRegistersConnectionMetrics(c *gc.C) {
+	s.prometheusRegisterer.CheckCallNames(c, "Register")
+
+	workertest.CleanKill(c, s.worker)
+	s.prometheusRegisterer.CheckCallNames(c, "Register", "Unregister")
+	registered := s.prometheusRegisterer.Calls()[0].Args[0]
+	s.prometheusRegisterer.CheckCall(c, 1, "Unregister", registered)
+}
+
 func (s *WorkerSuite) TestHeldListener(c *gc.C) {
 	// Worker url comes back as "" when the worker is dying.
 	url := s.worker.URL()
@@ -498,3 +552,183 @@ func (s *WorkerControllerPortSuite) TestDualPortListenerWithDelayShutdown(c *gc.
 	// We exit cleanly even if we never tick the clock forward
 	workertest.CleanKill(c, worker)
 }
+
+type WorkerAutocertSuite struct {
+	workerFixture
+}
+
+var _ = gc.Suite(&WorkerAutocertSuite{})
+
+func (s *WorkerAutocertSuite) TestPrunesAutocertCache(c *gc.C) {
+	cache := &stubAutocertCache{pruned: make(chan struct{}, 1)}
+	s.config.AutocertCache = cache
+	s.config.AutocertPruneInterval = time.Hour
+	worker, err := httpserver.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, worker)
+
+	for i := 0; i < 2; i++ {
+		err := s.clock.WaitAdvance(time.Hour, coretesting.LongWait, 1)
+		c.Assert(err, jc.ErrorIsNil)
+		select {
+		case <-cache.pruned:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for autocert cache to be pruned")
+		}
+	}
+}
+
+func (s *WorkerAutocertSuite) TestPruneErrorNotFatal(c *gc.C) {
+	cache := &stubAutocertCache{
+		pruned: make(chan struct{}, 1),
+		err:    errors.New("boom"),
+	}
+	s.config.AutocertCache = cache
+	s.config.AutocertPruneInterval = time.Hour
+	worker, err := httpserver.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, worker)
+
+	err = s.clock.WaitAdvance(time.Hour, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case <-cache.pruned:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for autocert cache to be pruned")
+	}
+	workertest.CheckAlive(c, worker)
+}
+
+type stubAutocertCache struct {
+	pruned chan struct{}
+	err    error
+}
+
+func (s *stubAutocertCache) PruneExpired(context.Context) (int, error) {
+	s.pruned <- struct{}{}
+	return 1, s.err
+}
+
+type WorkerTLSReloadSuite struct {
+	workerFixture
+}
+
+var _ = gc.Suite(&WorkerTLSReloadSuite{})
+
+func (s *WorkerTLSReloadSuite) TestReloadsTLSConfig(c *gc.C) {
+	changes := make(chan []string, 1)
+	service := &stubControllerConfigService{
+		watcher: watchertest.NewMockStringsWatcher(changes),
+		config: controller.Config{
+			controller.AutocertDNSNameKey: "api.example.com",
+		},
+	}
+	dnsNames := make(chan string, 1)
+	reloaded := s.config.TLSConfig.Clone()
+	reloaded.MinVersion = tls.VersionTLS13
+	s.config.ControllerConfigService = service
+	s.config.NewTLSConfig = func(dnsName, _ string) *tls.Config {
+		dnsNames <- dnsName
+		return reloaded
+	}
+	worker, err := httpserver.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, worker)
+
+	parsed, err := url.Parse(worker.URL())
+	c.Assert(err, jc.ErrorIsNil)
+	clientConfig := s.config.TLSConfig.Clone()
+	clientConfig.MaxVersion = tls.VersionTLS12
+
+	// A TLS 1.2 client is accepted with the initial config.
+	conn, err := tls.Dial("tcp", parsed.Host, clientConfig)
+	c.Assert(err, jc.ErrorIsNil)
+	defer conn.Close()
+
+	// Changes to unrelated keys don't reload the config.
+	changes <- []string{controller.APIPortOpenDelay}
+	select {
+	case <-dnsNames:
+		c.Fatalf("unexpected TLS config reload")
+	case <-time.After(coretesting.ShortWait):
+	}
+
+	changes <- []string{controller.AutocertDNSNameKey}
+	select {
+	case dnsName := <-dnsNames:
+		c.Check(dnsName, gc.Equals, "api.example.com")
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for TLS config reload")
+	}
+
+	// New connections get the reloaded config, which requires TLS 1.3.
+	// The config is swapped just after NewTLSConfig returns, so retry
+	// until it's in place.
+	var dialErr error
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		var newConn *tls.Conn
+		newConn, dialErr = tls.Dial("tcp", parsed.Host, clientConfig)
+		if dialErr != nil {
+			break
+		}
+		newConn.Close()
+	}
+	c.Check(dialErr, gc.ErrorMatches, ".*tls:.*version.*")
+
+	// Existing connections keep their config.
+	c.Check(conn.ConnectionState().Version, gc.Equals, uint16(tls.VersionTLS12))
+}
+
+type stubControllerConfigService struct {
+	watcher *watchertest.MockStringsWatcher
+	config  controller.Config
+}
+
+func (s *stubControllerConfigService) ControllerConfig(context.Context) (controller.Config, error) {
+	return s.config, nil
+}
+
+func (s *stubControllerConfigService) WatchControllerConfig() (watcher.StringsWatcher, error) {
+	return s.watcher, nil
+}
+
+type WorkerMaxConnectionsSuite struct {
+	workerFixture
+}
+
+var _ = gc.Suite(&WorkerMaxConnectionsSuite{})
+
+func (s *WorkerMaxConnectionsSuite) TestMaxAPIConnections(c *gc.C) {
+	s.config.MaxAPIConnections = 1
+	w, err := httpserver.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	parsed, err := url.Parse(w.URL())
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The first connection takes the only slot.
+	conn1, err := tls.Dial("tcp", parsed.Host, s.config.TLSConfig)
+	c.Assert(err, jc.ErrorIsNil)
+	defer conn1.Close()
+
+	// The second is closed by the server as soon as it is accepted.
+	conn2, err := net.Dial("tcp", parsed.Host)
+	c.Assert(err, jc.ErrorIsNil)
+	defer conn2.Close()
+	err = conn2.SetReadDeadline(time.Now().Add(coretesting.LongWait))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = conn2.Read(make([]byte, 1))
+	c.Assert(err, gc.Equals, io.EOF)
+
+	// Once the first connection is closed, a new one is accepted.
+	c.Assert(conn1.Close(), jc.ErrorIsNil)
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		conn3, err := tls.Dial("tcp", parsed.Host, s.config.TLSConfig)
+		if err == nil {
+			conn3.Close()
+			return
+		}
+	}
+	c.Fatalf("timed out waiting for a connection to be accepted")
+}
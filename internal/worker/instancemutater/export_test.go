@@ -16,6 +16,8 @@ import (
 	"github.com/juju/juju/environs"
 )
 
+var IsTransient = isTransient
+
 func NewMachineContext(
 	logger logger.Logger,
 	broker environs.LXDProfiler,
@@ -58,6 +60,10 @@ func NewContainerTestWorker(config Config, ctxFn RequiredMutaterContextFunc) (wo
 	return newWorker(context.Background(), config)
 }
 
+func SetVerifyAppliedProfiles(m *MutaterMachine, verify bool) {
+	m.verifyAppliedProfiles = verify
+}
+
 func ProcessMachineProfileChanges(m *MutaterMachine, info *instancemutater.UnitProfileInfo) error {
 	return m.processMachineProfileChanges(context.Background(), info)
 }
@@ -26,6 +26,13 @@ type ModelManifoldConfig struct {
 	Logger    logger.Logger
 	NewWorker func(context.Context, Config) (worker.Worker, error)
 	NewClient func(base.APICaller) InstanceMutaterAPI
+
+	// MaxConcurrentMachineStarts is passed through to the worker's
+	// Config. Zero means there is no limit.
+	MaxConcurrentMachineStarts int
+
+	// VerifyAppliedProfiles is passed through to the worker's Config.
+	VerifyAppliedProfiles bool
 }
 
 // Validate validates the manifold configuration.
@@ -48,6 +55,9 @@ func (config ModelManifoldConfig) Validate() error {
 	if config.APICallerName == "" {
 		return errors.NotValidf("empty APICallerName")
 	}
+	if config.MaxConcurrentMachineStarts < 0 {
+		return errors.NotValidf("negative MaxConcurrentMachineStarts")
+	}
 	return nil
 }
 
@@ -72,6 +82,9 @@ func (config ModelManifoldConfig) newWorker(ctx context.Context, environ environ
 		Broker:      broker,
 		AgentConfig: agentConfig,
 		Tag:         agentConfig.Tag(),
+
+		MaxConcurrentMachineStarts: config.MaxConcurrentMachineStarts,
+		VerifyAppliedProfiles:      config.VerifyAppliedProfiles,
 	}
 
 	w, err := config.NewWorker(ctx, cfg)
@@ -140,6 +153,13 @@ type MachineManifoldConfig struct {
 	Logger    logger.Logger
 	NewWorker func(context.Context, Config) (worker.Worker, error)
 	NewClient func(base.APICaller) InstanceMutaterAPI
+
+	// MaxConcurrentMachineStarts is passed through to the worker's
+	// Config. Zero means there is no limit.
+	MaxConcurrentMachineStarts int
+
+	// VerifyAppliedProfiles is passed through to the worker's Config.
+	VerifyAppliedProfiles bool
 }
 
 // Validate validates the manifold configuration.
@@ -162,6 +182,9 @@ func (config MachineManifoldConfig) Validate() error {
 	if config.APICallerName == "" {
 		return errors.NotValidf("empty APICallerName")
 	}
+	if config.MaxConcurrentMachineStarts < 0 {
+		return errors.NotValidf("negative MaxConcurrentMachineStarts")
+	}
 	return nil
 }
 
@@ -191,6 +214,9 @@ func (config MachineManifoldConfig) newWorker(ctx context.Context, instanceBroke
 		Broker:      broker,
 		AgentConfig: agentConfig,
 		Tag:         tag,
+
+		MaxConcurrentMachineStarts: config.MaxConcurrentMachineStarts,
+		VerifyAppliedProfiles:      config.VerifyAppliedProfiles,
 	}
 
 	w, err := config.NewWorker(ctx, cfg)
@@ -106,6 +106,23 @@ func (s *modelManifoldConfigSuite) TestInvalidConfigValidate(c *gc.C) {
 			},
 			err: "empty APICallerName not valid",
 		},
+		{
+			description: "Test negative max concurrent machine starts",
+			config: instancemutater.ModelManifoldConfig{
+				Logger: loggertesting.WrapCheckLog(c),
+				NewWorker: func(_ context.Context, cfg instancemutater.Config) (worker.Worker, error) {
+					return mocks.NewMockWorker(ctrl), nil
+				},
+				NewClient: func(base.APICaller) instancemutater.InstanceMutaterAPI {
+					return mocks.NewMockInstanceMutaterAPI(ctrl)
+				},
+				AgentName:                  "agent",
+				EnvironName:                "environ",
+				APICallerName:              "api-caller",
+				MaxConcurrentMachineStarts: -1,
+			},
+			err: "negative MaxConcurrentMachineStarts not valid",
+		},
 	}
 	for i, test := range testcases {
 		c.Logf("%d %s", i, test.description)
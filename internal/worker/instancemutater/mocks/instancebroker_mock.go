@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/instancebroker_mock.go github.com/juju/juju/internal/worker/instancemutater InstanceMutaterAPI
+//	mockgen -typed -package mocks -destination internal/worker/instancemutater/mocks/instancebroker_mock.go github.com/juju/juju/internal/worker/instancemutater InstanceMutaterAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 
 	instancemutater "github.com/juju/juju/api/agent/instancemutater"
 	watcher "github.com/juju/juju/core/watcher"
+	params "github.com/juju/juju/rpc/params"
 	names "github.com/juju/names/v6"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -81,6 +82,45 @@ func (c *MockInstanceMutaterAPIMachineCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// MachinesLife mocks base method.
+func (m *MockInstanceMutaterAPI) MachinesLife(arg0 context.Context, arg1 []names.MachineTag) ([]params.LifeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MachinesLife", arg0, arg1)
+	ret0, _ := ret[0].([]params.LifeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MachinesLife indicates an expected call of MachinesLife.
+func (mr *MockInstanceMutaterAPIMockRecorder) MachinesLife(arg0, arg1 any) *MockInstanceMutaterAPIMachinesLifeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachinesLife", reflect.TypeOf((*MockInstanceMutaterAPI)(nil).MachinesLife), arg0, arg1)
+	return &MockInstanceMutaterAPIMachinesLifeCall{Call: call}
+}
+
+// MockInstanceMutaterAPIMachinesLifeCall wrap *gomock.Call
+type MockInstanceMutaterAPIMachinesLifeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceMutaterAPIMachinesLifeCall) Return(arg0 []params.LifeResult, arg1 error) *MockInstanceMutaterAPIMachinesLifeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceMutaterAPIMachinesLifeCall) Do(f func(context.Context, []names.MachineTag) ([]params.LifeResult, error)) *MockInstanceMutaterAPIMachinesLifeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceMutaterAPIMachinesLifeCall) DoAndReturn(f func(context.Context, []names.MachineTag) ([]params.LifeResult, error)) *MockInstanceMutaterAPIMachinesLifeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchModelMachines mocks base method.
 func (m *MockInstanceMutaterAPI) WatchModelMachines(arg0 context.Context) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
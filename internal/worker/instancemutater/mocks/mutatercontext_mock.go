@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/mutatercontext_mock.go github.com/juju/juju/internal/worker/instancemutater MutaterContext
+//	mockgen -typed -package mocks -destination internal/worker/instancemutater/mocks/mutatercontext_mock.go github.com/juju/juju/internal/worker/instancemutater MutaterContext
 //
 
 // Package mocks is a generated GoMock package.
@@ -16,6 +16,7 @@ import (
 	instancemutater "github.com/juju/juju/api/agent/instancemutater"
 	environs "github.com/juju/juju/environs"
 	instancemutater0 "github.com/juju/juju/internal/worker/instancemutater"
+	params "github.com/juju/juju/rpc/params"
 	names "github.com/juju/names/v6"
 	worker "github.com/juju/worker/v4"
 	gomock "go.uber.org/mock/gomock"
@@ -346,3 +347,42 @@ func (c *MockMutaterContextnewMachineContextCall) DoAndReturn(f func() instancem
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// refreshMachines mocks base method.
+func (m *MockMutaterContext) refreshMachines(arg0 context.Context, arg1 []names.MachineTag) ([]params.LifeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "refreshMachines", arg0, arg1)
+	ret0, _ := ret[0].([]params.LifeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// refreshMachines indicates an expected call of refreshMachines.
+func (mr *MockMutaterContextMockRecorder) refreshMachines(arg0, arg1 any) *MockMutaterContextrefreshMachinesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshMachines", reflect.TypeOf((*MockMutaterContext)(nil).refreshMachines), arg0, arg1)
+	return &MockMutaterContextrefreshMachinesCall{Call: call}
+}
+
+// MockMutaterContextrefreshMachinesCall wrap *gomock.Call
+type MockMutaterContextrefreshMachinesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMutaterContextrefreshMachinesCall) Return(arg0 []params.LifeResult, arg1 error) *MockMutaterContextrefreshMachinesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMutaterContextrefreshMachinesCall) Do(f func(context.Context, []names.MachineTag) ([]params.LifeResult, error)) *MockMutaterContextrefreshMachinesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMutaterContextrefreshMachinesCall) DoAndReturn(f func(context.Context, []names.MachineTag) ([]params.LifeResult, error)) *MockMutaterContextrefreshMachinesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
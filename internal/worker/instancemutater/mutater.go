@@ -52,23 +52,38 @@ type MutaterMachine struct {
 	logger     logger.Logger
 	machineApi instancemutater.MutaterMachine
 	id         string
+
+	// verifyAppliedProfiles indicates whether the instance's profiles
+	// are checked again after they are assigned.
+	verifyAppliedProfiles bool
 }
 
 type MutaterContext interface {
 	MachineContext
 	newMachineContext() MachineContext
 	getMachine(ctx context.Context, tag names.MachineTag) (instancemutater.MutaterMachine, error)
+	// refreshMachines returns the life of each of the given machines,
+	// fetched with a single API call.
+	refreshMachines(ctx context.Context, tags []names.MachineTag) ([]params.LifeResult, error)
 }
 
 type mutater struct {
 	context     MutaterContext
 	logger      logger.Logger
 	wg          *sync.WaitGroup
-	machines    map[names.MachineTag]chan struct{}
+	machines    map[names.MachineTag]chan life.Value
 	machineDead chan instancemutater.MutaterMachine
+
+	// starting holds a slot for each machine that is still being set
+	// up. A nil channel places no limit on concurrent machine starts.
+	starting chan struct{}
+
+	// verifyAppliedProfiles is passed on to each machine.
+	verifyAppliedProfiles bool
 }
 
 func (m *mutater) startMachines(ctx context.Context, tags []names.MachineTag) error {
+	var removed []names.MachineTag
 	for _, tag := range tags {
 		select {
 		case <-m.context.dying():
@@ -78,8 +93,13 @@ func (m *mutater) startMachines(ctx context.Context, tags []names.MachineTag) er
 		m.logger.Tracef(ctx, "received tag %q", tag.String())
 		if ch := m.machines[tag]; ch == nil {
 			// First time we receive the tag, setup watchers.
+			started, err := m.waitToStart()
+			if err != nil {
+				return errors.Trace(err)
+			}
 			api, err := m.context.getMachine(ctx, tag)
 			if err != nil {
+				started()
 				return errors.Trace(err)
 			}
 			id := api.Tag().Id()
@@ -87,15 +107,18 @@ func (m *mutater) startMachines(ctx context.Context, tags []names.MachineTag) er
 			// Ensure we do not watch any containers that aren't LXD.
 			containerType, err := api.ContainerType(ctx)
 			if err != nil {
+				started()
 				return errors.Trace(err)
 			}
 			if containerType != instance.LXD {
 				m.logger.Tracef(ctx, "ignoring %q container machine-%s", containerType, id)
+				started()
 				continue
 			}
 
 			profileChangeWatcher, err := api.WatchLXDProfileVerificationNeeded(ctx)
 			if err != nil {
+				started()
 				if errors.Is(err, errors.NotSupported) {
 					m.logger.Tracef(ctx, "ignoring manual machine-%s", id)
 					continue
@@ -103,7 +126,7 @@ func (m *mutater) startMachines(ctx context.Context, tags []names.MachineTag) er
 				return errors.Annotatef(err, "failed to start watching application lxd profiles for machine-%s", id)
 			}
 
-			ch = make(chan struct{})
+			ch = make(chan life.Value)
 			m.machines[tag] = ch
 
 			machine := MutaterMachine{
@@ -111,15 +134,65 @@ func (m *mutater) startMachines(ctx context.Context, tags []names.MachineTag) er
 				logger:     m.logger,
 				machineApi: api,
 				id:         id,
+
+				verifyAppliedProfiles: m.verifyAppliedProfiles,
 			}
 
 			m.wg.Add(1)
-			go runMachine(machine, profileChangeWatcher, ch, m.machineDead, func() { m.wg.Done() })
+			go runMachine(machine, profileChangeWatcher, ch, m.machineDead, started, func() { m.wg.Done() })
 		} else {
 			// We've received this tag before, therefore
 			// the machine has been removed from the model
 			// cache and no longer needed
-			ch <- struct{}{}
+			removed = append(removed, tag)
+		}
+	}
+	return m.notifyRemoved(ctx, removed)
+}
+
+// waitToStart blocks until fewer than the configured number of machines
+// are being set up, and returns a func to call once the new machine's
+// setup is complete. The returned func is safe to call more than once.
+func (m *mutater) waitToStart() (func(), error) {
+	if m.starting == nil {
+		return func() {}, nil
+	}
+	select {
+	case <-m.context.dying():
+		return nil, m.context.errDying()
+	case m.starting <- struct{}{}:
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-m.starting })
+	}, nil
+}
+
+// notifyRemoved tells each of the removed machines to check whether it is
+// dead. A single removal leaves the machine to refresh itself, but when
+// several machines are removed at once, such as during a mass teardown,
+// their lives are fetched with one API call and handed to the machines.
+func (m *mutater) notifyRemoved(ctx context.Context, tags []names.MachineTag) error {
+	lives := make([]life.Value, len(tags))
+	if len(tags) > 1 {
+		results, err := m.context.refreshMachines(ctx, tags)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for i, result := range results {
+			if result.Error != nil {
+				// Leave the machine to refresh itself and deal with
+				// the error.
+				continue
+			}
+			lives[i] = result.Life
+		}
+	}
+	for i, tag := range tags {
+		select {
+		case <-m.context.dying():
+			return m.context.errDying()
+		case m.machines[tag] <- lives[i]:
 		}
 	}
 	return nil
@@ -128,7 +201,8 @@ func (m *mutater) startMachines(ctx context.Context, tags []names.MachineTag) er
 func runMachine(
 	machine MutaterMachine,
 	profileChangeWatcher watcher.NotifyWatcher,
-	removed <-chan struct{}, died chan<- instancemutater.MutaterMachine, cleanup func(),
+	removed <-chan life.Value, died chan<- instancemutater.MutaterMachine,
+	started func(), cleanup func(),
 ) {
 	defer cleanup()
 	defer func() {
@@ -145,52 +219,90 @@ func runMachine(
 			}
 		}
 	}()
+	// The central loop may be waiting for this machine to finish
+	// starting, so it must be told before we try to report the
+	// machine as dead.
+	defer started()
 
 	if err := machine.context.add(profileChangeWatcher); err != nil {
 		machine.context.KillWithError(err)
 		return
 	}
-	if err := machine.watchProfileChangesLoop(removed, profileChangeWatcher); err != nil {
+	if err := machine.watchProfileChangesLoop(removed, profileChangeWatcher, started); err != nil {
 		machine.context.KillWithError(err)
 	}
 }
 
+// isTransient returns true if the error returned from the API is expected to
+// clear without intervention, in which case the machine should wait for
+// another change rather than restart the worker.
+func isTransient(err error) bool {
+	err = errors.Cause(err)
+	return params.IsCodeNotProvisioned(err) ||
+		params.IsCodeNotYetAvailable(err) ||
+		params.IsCodeTryAgain(err) ||
+		params.IsCodeExcessiveContention(err) ||
+		params.IsCodeUpgradeInProgress(err)
+}
+
 // watchProfileChanges, any error returned will cause the worker to restart.
-func (m MutaterMachine) watchProfileChangesLoop(removed <-chan struct{}, profileChangeWatcher watcher.NotifyWatcher) error {
+// A life received on the removed channel was fetched on behalf of the
+// machine; an empty life means the machine must refresh itself. The
+// started func is called once the watcher's initial change has been
+// handled.
+func (m MutaterMachine) watchProfileChangesLoop(removed <-chan life.Value, profileChangeWatcher watcher.NotifyWatcher, started func()) error {
 	m.logger.Tracef(context.TODO(), "watching change on MutaterMachine %s", m.id)
 	for {
 		select {
 		case <-m.context.dying():
 			return m.context.errDying()
 		case <-profileChangeWatcher.Changes():
-			info, err := m.machineApi.CharmProfilingInfo(context.TODO())
+			done, err := m.handleProfileChange()
+			started()
 			if err != nil {
-				// If the machine is not provisioned then we need to wait for
-				// new changes from the watcher.
-				if params.IsCodeNotProvisioned(errors.Cause(err)) {
-					m.logger.Tracef(context.TODO(), "got not provisioned machine-%s on charm profiling info, wait for another change", m.id)
-					continue
-				}
 				return errors.Trace(err)
 			}
-			if err = m.processMachineProfileChanges(context.TODO(), info); err != nil && errors.Is(err, errors.NotValid) {
-				// Return to stop mutating the machine, but no need to restart
-				// the worker.
+			if done {
 				return nil
-			} else if err != nil {
-				return errors.Trace(err)
 			}
-		case <-removed:
-			if err := m.machineApi.Refresh(context.TODO()); err != nil {
-				return errors.Trace(err)
+		case l := <-removed:
+			if l == "" {
+				if err := m.machineApi.Refresh(context.TODO()); err != nil {
+					return errors.Trace(err)
+				}
+				l = m.machineApi.Life()
 			}
-			if m.machineApi.Life() == life.Dead {
+			if l == life.Dead {
 				return nil
 			}
 		}
 	}
 }
 
+// handleProfileChange fetches the machine's charm profiling info and
+// applies any profile changes. It returns true if the machine should no
+// longer be mutated.
+func (m MutaterMachine) handleProfileChange() (bool, error) {
+	info, err := m.machineApi.CharmProfilingInfo(context.TODO())
+	if err != nil {
+		// If the error is transient, such as the machine not yet
+		// being provisioned, then we need to wait for new changes
+		// from the watcher.
+		if isTransient(err) {
+			m.logger.Tracef(context.TODO(), "got transient error for machine-%s on charm profiling info, wait for another change: %v", m.id, err)
+			return false, nil
+		}
+		return false, errors.Trace(err)
+	}
+	if err = m.processMachineProfileChanges(context.TODO(), info); err != nil && errors.Is(err, errors.NotValid) {
+		// Stop mutating the machine, but no need to restart the worker.
+		return true, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return false, nil
+}
+
 func (m MutaterMachine) processMachineProfileChanges(ctx context.Context, info *instancemutater.UnitProfileInfo) error {
 	if info == nil || (len(info.CurrentProfiles) == 0 && len(info.ProfileChanges) == 0) {
 		// no changes to be made, return now.
@@ -267,6 +379,10 @@ func (m MutaterMachine) processMachineProfileChanges(ctx context.Context, info *
 		return report(err)
 	}
 
+	if m.verifyAppliedProfiles {
+		m.verifyAssignedProfiles(ctx, string(info.InstanceId), expectedProfiles)
+	}
+
 	return report(m.machineApi.SetCharmProfiles(ctx, lxdprofile.FilterLXDProfileNames(currentProfiles)))
 }
 
@@ -300,6 +416,21 @@ func (m MutaterMachine) gatherProfileData(info *instancemutater.UnitProfileInfo)
 	return result, nil
 }
 
+// verifyAssignedProfiles checks that the instance has the expected profiles
+// once they have been assigned, as some versions of LXD fail to apply them
+// without reporting an error. A mismatch is only logged; the assignment is
+// still recorded.
+func (m MutaterMachine) verifyAssignedProfiles(ctx context.Context, instID string, expectedProfiles []string) {
+	verified, obtainedProfiles, err := m.verifyCurrentProfiles(instID, expectedProfiles)
+	if err != nil {
+		m.logger.Warningf(ctx, "cannot verify lxd profiles assigned to machine-%s: %v", m.id, err)
+		return
+	}
+	if !verified {
+		m.logger.Warningf(ctx, "machine-%s has lxd profiles %q after assigning %q", m.id, obtainedProfiles, expectedProfiles)
+	}
+}
+
 func (m MutaterMachine) verifyCurrentProfiles(instID string, expectedProfiles []string) (bool, []string, error) {
 	broker := m.context.getBroker()
 	obtainedProfiles, err := broker.LXDProfileNames(instID)
@@ -19,6 +19,7 @@ import (
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/worker/instancemutater"
 	"github.com/juju/juju/internal/worker/instancemutater/mocks"
+	"github.com/juju/juju/rpc/params"
 )
 
 type mutaterSuite struct {
@@ -60,6 +61,50 @@ func (s *mutaterSuite) TestProcessMachineProfileChanges(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *mutaterSuite) TestProcessMachineProfileChangesVerifyApplied(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	startingProfiles := []string{"default", "juju-testme"}
+	finishingProfiles := append(startingProfiles, "juju-testme-lxd-profile-1")
+	charmProfiles := []string{"juju-testme-lxd-profile-1"}
+
+	s.expectRefreshLifeAliveStatusIdle()
+	s.expectLXDProfileNames(startingProfiles, nil)
+	s.expectAssignLXDProfiles(finishingProfiles, nil)
+	s.expectLXDProfileNames(finishingProfiles, nil)
+	s.expectSetCharmProfiles(charmProfiles)
+	s.expectModificationStatusApplied()
+
+	instancemutater.SetVerifyAppliedProfiles(s.mutaterMachine, true)
+
+	info := s.info(startingProfiles, 1, true)
+	err := instancemutater.ProcessMachineProfileChanges(s.mutaterMachine, info)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *mutaterSuite) TestProcessMachineProfileChangesVerifyAppliedMismatch(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	startingProfiles := []string{"default", "juju-testme"}
+	finishingProfiles := append(startingProfiles, "juju-testme-lxd-profile-1")
+	charmProfiles := []string{"juju-testme-lxd-profile-1"}
+
+	// The instance silently fails to apply the new profile, which is
+	// only warned about; success is still recorded.
+	s.expectRefreshLifeAliveStatusIdle()
+	s.expectLXDProfileNames(startingProfiles, nil)
+	s.expectAssignLXDProfiles(finishingProfiles, nil)
+	s.expectLXDProfileNames(startingProfiles, nil)
+	s.expectSetCharmProfiles(charmProfiles)
+	s.expectModificationStatusApplied()
+
+	instancemutater.SetVerifyAppliedProfiles(s.mutaterMachine, true)
+
+	info := s.info(startingProfiles, 1, true)
+	err := instancemutater.ProcessMachineProfileChanges(s.mutaterMachine, info)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *mutaterSuite) TestProcessMachineProfileChangesMachineDead(c *gc.C) {
 	defer s.setUpMocks(c).Finish()
 
@@ -244,6 +289,27 @@ func (s *mutaterSuite) TestVerifyCurrentProfilesError(c *gc.C) {
 	c.Assert(obtained, gc.IsNil)
 }
 
+func (s *mutaterSuite) TestIsTransient(c *gc.C) {
+	for _, code := range []string{
+		params.CodeNotProvisioned,
+		params.CodeNotYetAvailable,
+		params.CodeTryAgain,
+		params.CodeExcessiveContention,
+		params.CodeUpgradeInProgress,
+	} {
+		c.Logf("code %q", code)
+		err := &params.Error{Code: code, Message: "boom"}
+		c.Check(instancemutater.IsTransient(err), jc.IsTrue)
+		c.Check(instancemutater.IsTransient(errors.Annotate(err, "wrapped")), jc.IsTrue)
+	}
+}
+
+func (s *mutaterSuite) TestIsTransientFalse(c *gc.C) {
+	c.Check(instancemutater.IsTransient(errors.New("boom")), jc.IsFalse)
+	c.Check(instancemutater.IsTransient(&params.Error{Code: params.CodeNotFound}), jc.IsFalse)
+	c.Check(instancemutater.IsTransient(&params.Error{Code: params.CodeUnauthorized}), jc.IsFalse)
+}
+
 func (s *mutaterSuite) setUpMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
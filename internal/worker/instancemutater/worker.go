@@ -15,14 +15,17 @@ import (
 
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/api/agent/instancemutater"
+	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/rpc/params"
 )
 
 type InstanceMutaterAPI interface {
 	WatchModelMachines(ctx context.Context) (watcher.StringsWatcher, error)
 	Machine(ctx context.Context, tag names.MachineTag) (instancemutater.MutaterMachine, error)
+	MachinesLife(ctx context.Context, tags []names.MachineTag) ([]params.LifeResult, error)
 }
 
 // Config represents the configuration required to run a new instance machineApi
@@ -54,6 +57,16 @@ type Config struct {
 	// Note: the following is required for testing purposes when we have an
 	// error case and we want to know when it's valid to kill/clean the worker.
 	GetRequiredContext RequiredMutaterContextFunc
+
+	// MaxConcurrentMachineStarts limits the number of machines that can
+	// be set up at the same time; any others wait for a free slot. Zero
+	// means there is no limit.
+	MaxConcurrentMachineStarts int
+
+	// VerifyAppliedProfiles causes the worker to re-query an instance's
+	// lxd profiles after assigning them, and warn if they don't match
+	// those expected. This costs an extra broker call per change.
+	VerifyAppliedProfiles bool
 }
 
 type RequiredLXDProfilesFunc func(string) []string
@@ -96,6 +109,9 @@ func (config Config) Validate() error {
 	if config.GetRequiredContext == nil {
 		return errors.NotValidf("nil GetRequiredContext")
 	}
+	if config.MaxConcurrentMachineStarts < 0 {
+		return errors.NotValidf("negative MaxConcurrentMachineStarts")
+	}
 	return nil
 }
 
@@ -148,6 +164,8 @@ func newWorker(ctx context.Context, config Config) (*mutaterWorker, error) {
 		machineWatcher:             watcher,
 		getRequiredLXDProfilesFunc: config.GetRequiredLXDProfiles,
 		getRequiredContextFunc:     config.GetRequiredContext,
+		maxConcurrentStarts:        config.MaxConcurrentMachineStarts,
+		verifyAppliedProfiles:      config.VerifyAppliedProfiles,
 	}
 	// getRequiredContextFunc returns a MutaterContext, this is for overriding
 	// during testing.
@@ -172,6 +190,8 @@ type mutaterWorker struct {
 	machineWatcher             watcher.StringsWatcher
 	getRequiredLXDProfilesFunc RequiredLXDProfilesFunc
 	getRequiredContextFunc     RequiredMutaterContextFunc
+	maxConcurrentStarts        int
+	verifyAppliedProfiles      bool
 }
 
 func (w *mutaterWorker) loop() error {
@@ -184,8 +204,13 @@ func (w *mutaterWorker) loop() error {
 		context:     w.getRequiredContextFunc(w),
 		logger:      w.logger,
 		wg:          &wg,
-		machines:    make(map[names.MachineTag]chan struct{}),
+		machines:    make(map[names.MachineTag]chan life.Value),
 		machineDead: make(chan instancemutater.MutaterMachine),
+
+		verifyAppliedProfiles: w.verifyAppliedProfiles,
+	}
+	if w.maxConcurrentStarts > 0 {
+		m.starting = make(chan struct{}, w.maxConcurrentStarts)
 	}
 	for {
 		select {
@@ -236,6 +261,11 @@ func (w *mutaterWorker) getMachine(ctx context.Context, tag names.MachineTag) (i
 	return m, err
 }
 
+// refreshMachines is part of the MutaterContext interface.
+func (w *mutaterWorker) refreshMachines(ctx context.Context, tags []names.MachineTag) ([]params.LifeResult, error) {
+	return w.facade.MachinesLife(ctx, tags)
+}
+
 // getBroker is part of the MachineContext interface.
 func (w *mutaterWorker) getBroker() environs.LXDProfiler {
 	return w.broker
@@ -119,6 +119,23 @@ func (s *workerConfigSuite) TestInvalidConfigValidate(c *gc.C) {
 			},
 			err: "nil GetRequiredLXDProfiles not valid",
 		},
+		{
+			description: "Test negative MaxConcurrentMachineStarts",
+			config: instancemutater.Config{
+				Logger:                 loggertesting.WrapCheckLog(c),
+				Facade:                 mocks.NewMockInstanceMutaterAPI(ctrl),
+				Broker:                 mocks.NewMockLXDProfiler(ctrl),
+				AgentConfig:            mocks.NewMockConfig(ctrl),
+				Tag:                    names.NewMachineTag("3"),
+				GetMachineWatcher:      getMachineWatcher,
+				GetRequiredLXDProfiles: func(_ string) []string { return []string{} },
+				GetRequiredContext: func(w instancemutater.MutaterContext) instancemutater.MutaterContext {
+					return w
+				},
+				MaxConcurrentMachineStarts: -1,
+			},
+			err: "negative MaxConcurrentMachineStarts not valid",
+		},
 	}
 	for i, test := range testcases {
 		c.Logf("%d %s", i, test.description)
@@ -164,6 +181,7 @@ type workerSuite struct {
 	context                *mocks.MockMutaterContext
 	appLXDProfileWorker    map[int]*workermocks.MockWorker
 	getRequiredLXDProfiles instancemutater.RequiredLXDProfilesFunc
+	maxConcurrentStarts    int
 
 	// doneWG is a collection of things each test needs to wait to
 	// be completed within the test.
@@ -184,6 +202,7 @@ func (s *workerSuite) SetUpTest(c *gc.C) {
 	s.getRequiredLXDProfiles = func(modelName string) []string {
 		return []string{"default", "juju-testing"}
 	}
+	s.maxConcurrentStarts = 0
 	s.doneWG = new(sync.WaitGroup)
 }
 
@@ -270,6 +289,67 @@ func (s *workerEnvironSuite) TestMachineNotifyTwice(c *gc.C) {
 	s.cleanKill(c, s.workerForScenario(c))
 }
 
+func (s *workerEnvironSuite) TestMachinesRemovedTogetherRefreshedOnce(c *gc.C) {
+	defer s.setup(c, 2).Finish()
+
+	s.notifyMachines([][]string{{"0", "1"}, {"0", "1"}})
+	s.expectFacadeMachineTag(0)
+	s.expectFacadeMachineTag(1)
+	s.expectContainerType()
+	s.notifyMachineAppLXDProfile(0, 0)
+	s.notifyMachineAppLXDProfile(1, 0)
+	s.expectMachinesLifeDead(0, 1)
+
+	s.cleanKill(c, s.workerForScenario(c))
+}
+
+func (s *workerEnvironSuite) TestMaxConcurrentMachineStarts(c *gc.C) {
+	defer s.setup(c, 2).Finish()
+	s.maxConcurrentStarts = 1
+
+	s.notifyMachines([][]string{{"0", "1"}})
+	s.expectContainerType()
+	s.notifyMachineAppLXDProfile(0, 1)
+	s.notifyMachineAppLXDProfile(1, 1)
+
+	// Machine 1 must not be set up until machine 0 has handled its
+	// initial profile change, which holds the only start slot.
+	profiled := make(chan struct{})
+	started := make(chan struct{})
+	tag0 := names.NewMachineTag("0")
+	s.facade.EXPECT().Machine(gomock.Any(), tag0).Return(s.machine[0], nil)
+	s.machine[0].EXPECT().Tag().Return(tag0).AnyTimes()
+	s.machine[0].EXPECT().CharmProfilingInfo(gomock.Any()).DoAndReturn(
+		func(context.Context) (*apiinstancemutater.UnitProfileInfo, error) {
+			defer close(profiled)
+			return &apiinstancemutater.UnitProfileInfo{}, nil
+		},
+	)
+	tag1 := names.NewMachineTag("1")
+	s.facade.EXPECT().Machine(gomock.Any(), tag1).DoAndReturn(
+		func(context.Context, names.MachineTag) (apiinstancemutater.MutaterMachine, error) {
+			defer close(started)
+			select {
+			case <-profiled:
+			default:
+				c.Errorf("machine 1 started while machine 0 was starting")
+			}
+			return s.machine[1], nil
+		},
+	)
+	s.machine[1].EXPECT().Tag().Return(tag1).AnyTimes()
+	s.expectCharmProfilingInfoSimpleNoChange(1)
+
+	w := s.workerForScenario(c)
+	select {
+	case <-started:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for machine 1 to start")
+	}
+
+	s.cleanKill(c, w)
+}
+
 func (s *workerEnvironSuite) TestNoChangeFoundOne(c *gc.C) {
 	defer s.setup(c, 1).Finish()
 
@@ -378,6 +458,8 @@ func (s *workerSuite) workerForScenario(c *gc.C) worker.Worker {
 		AgentConfig:            s.agentConfig,
 		Tag:                    s.machineTag,
 		GetRequiredLXDProfiles: s.getRequiredLXDProfiles,
+
+		MaxConcurrentMachineStarts: s.maxConcurrentStarts,
 	}
 
 	w, err := s.newWorkerFunc(config, func(ctx instancemutater.MutaterContext) instancemutater.MutaterContext {
@@ -548,6 +630,22 @@ func (s *workerSuite) expectMachineAliveStatusIdleMachineDead(machine int, group
 	mExp.Life().Return(life.Dead).After(o1.Call).Do(do)
 }
 
+func (s *workerSuite) expectMachinesLifeDead(machines ...int) {
+	tags := make([]names.MachineTag, len(machines))
+	results := make([]params.LifeResult, len(machines))
+	for i, machine := range machines {
+		tags[i] = names.NewMachineTag(strconv.Itoa(machine))
+		results[i] = params.LifeResult{Life: life.Dead}
+	}
+	do := s.workGroupAddGetDoneFuncNoContext()
+	s.facade.EXPECT().MachinesLife(gomock.Any(), tags).Return(results, nil).Do(
+		func(context.Context, []names.MachineTag) ([]params.LifeResult, error) {
+			do()
+			return nil, nil
+		},
+	)
+}
+
 func (s *workerSuite) expectModificationStatusApplied(machine int) {
 	do := s.workGroupAddGetDoneWithStatusFunc()
 	s.machine[machine].EXPECT().SetModificationStatus(gomock.Any(), status.Applied, "", nil).Return(nil).Do(do)
@@ -203,6 +203,11 @@ func (g providerServicesGetter) ServicesForModel(modelUUID string) ProviderServi
 	return providerServices{factory: g.servicesGetter.ServicesForModel(modelUUID)}
 }
 
+// Remove releases any ProviderServices held for the given model.
+func (g providerServicesGetter) Remove(modelUUID string) {
+	g.servicesGetter.Remove(modelUUID)
+}
+
 type providerServices struct {
 	factory services.ProviderServices
 }
@@ -218,12 +218,19 @@ type stubProviderServicesGetter struct {
 
 type providerServicesGetter struct {
 	modelworkermanager.ProviderServicesGetter
+	removed chan string
 }
 
 func (s providerServicesGetter) ServicesForModel(_ string) modelworkermanager.ProviderServices {
 	return nil
 }
 
+func (s providerServicesGetter) Remove(modelUUID string) {
+	if s.removed != nil {
+		s.removed <- modelUUID
+	}
+}
+
 type stubHTTPClientGetter struct {
 	http.HTTPClientGetter
 }
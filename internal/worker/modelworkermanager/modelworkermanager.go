@@ -232,6 +232,9 @@ func (m *modelWorkerManager) modelChanged(ctx context.Context, modelUUID string)
 		// removed from the runner above. However since the runner itself
 		// has neverFatal as an error handler, the runner itself doesn't
 		// propagate the error.
+		// Any provider services held for a removed model are no longer
+		// required, so release them.
+		m.config.ProviderServicesGetter.Remove(modelUUID)
 		return nil
 	} else if err != nil {
 		return errors.Trace(err)
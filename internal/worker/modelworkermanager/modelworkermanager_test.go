@@ -25,6 +25,7 @@ import (
 	modeltesting "github.com/juju/juju/core/model/testing"
 	coretesting "github.com/juju/juju/core/testing"
 	"github.com/juju/juju/core/watcher/watchertest"
+	modelerrors "github.com/juju/juju/domain/model/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/pki"
 	pkitest "github.com/juju/juju/internal/pki/test"
@@ -106,6 +107,38 @@ func (s *suite) TestStartsInitialWorker(c *gc.C) {
 	})
 }
 
+func (s *suite) TestRemovedModelReleasesProviderServices(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	removed := make(chan string, 1)
+	s.providerServicesGetter = providerServicesGetter{removed: removed}
+
+	changes := make(chan []string, 1)
+	watcher := watchertest.NewMockStringsWatcher(changes)
+	s.modelService.EXPECT().WatchActivatedModels(gomock.Any()).Return(
+		watcher, nil,
+	)
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	s.modelService.EXPECT().Model(gomock.Any(), modelUUID).Return(coremodel.Model{}, modelerrors.NotFound)
+
+	s.runTest(c, func(_ worker.Worker) {
+		select {
+		case changes <- []string{modelUUID.String()}:
+		case <-time.After(coretesting.LongWait):
+			c.Fatal("timed out sending changes")
+		}
+
+		select {
+		case uuid := <-removed:
+			c.Check(uuid, gc.Equals, modelUUID.String())
+		case <-time.After(coretesting.LongWait):
+			c.Fatal("timed out waiting for provider services removal")
+		}
+		s.assertNoWorkers(c)
+	})
+}
+
 func (s *suite) TestStartsLaterWorker(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -64,4 +64,6 @@ type ProviderServices interface {
 type ProviderServicesGetter interface {
 	// ServicesForModel returns a ProviderServices for the given model.
 	ServicesForModel(modelUUID string) ProviderServices
+	// Remove releases any ProviderServices held for the given model.
+	Remove(modelUUID string)
 }
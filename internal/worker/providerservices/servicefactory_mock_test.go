@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package providerservices -destination servicefactory_mock_test.go github.com/juju/juju/internal/services ProviderServices,ProviderServicesGetter
+//	mockgen -typed -package providerservices -destination internal/worker/providerservices/servicefactory_mock_test.go github.com/juju/juju/internal/services ProviderServices,ProviderServicesGetter
 //
 
 // Package providerservices is a generated GoMock package.
@@ -218,6 +218,42 @@ func (m *MockProviderServicesGetter) EXPECT() *MockProviderServicesGetterMockRec
 	return m.recorder
 }
 
+// Remove mocks base method.
+func (m *MockProviderServicesGetter) Remove(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Remove", arg0)
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockProviderServicesGetterMockRecorder) Remove(arg0 any) *MockProviderServicesGetterRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockProviderServicesGetter)(nil).Remove), arg0)
+	return &MockProviderServicesGetterRemoveCall{Call: call}
+}
+
+// MockProviderServicesGetterRemoveCall wrap *gomock.Call
+type MockProviderServicesGetterRemoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProviderServicesGetterRemoveCall) Return() *MockProviderServicesGetterRemoveCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProviderServicesGetterRemoveCall) Do(f func(string)) *MockProviderServicesGetterRemoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProviderServicesGetterRemoveCall) DoAndReturn(f func(string)) *MockProviderServicesGetterRemoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ServicesForModel mocks base method.
 func (m *MockProviderServicesGetter) ServicesForModel(arg0 string) services.ProviderServices {
 	m.ctrl.T.Helper()
@@ -110,3 +110,9 @@ func (s *domainServicesGetter) ServicesForModel(modelUUID string) services.Provi
 		),
 	}
 }
+
+// Remove is called once the given model has been removed. The provider
+// domain services are created afresh on every call to ServicesForModel, and
+// neither they nor the getter hold any connections of their own, so there is
+// nothing to release. Removing an unknown model is a no-op.
+func (s *domainServicesGetter) Remove(modelUUID string) {}
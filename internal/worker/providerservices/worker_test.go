@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
+	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -73,6 +74,37 @@ func (s *workerSuite) TestWorkerServicesGetter(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *workerSuite) TestServicesGetterRemove(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	getter := NewProviderServicesGetter(NewProviderServices, s.dbGetter, s.logger)
+
+	first := getter.ServicesForModel("foo")
+	c.Assert(first, gc.NotNil)
+
+	// The provider domain services don't run as a worker or hold any
+	// resources, so there is nothing for Remove to release.
+	_, ok := first.(*domainServices).ProviderServices.(*domainservices.ProviderServices)
+	c.Assert(ok, jc.IsTrue)
+	_, ok = first.(*domainServices).ProviderServices.(worker.Worker)
+	c.Check(ok, jc.IsFalse)
+
+	getter.Remove("foo")
+
+	// Services are created on demand, so they are still available for
+	// models that come back after being removed.
+	c.Check(getter.ServicesForModel("foo"), gc.NotNil)
+}
+
+func (s *workerSuite) TestServicesGetterRemoveUnknownModel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	getter := NewProviderServicesGetter(NewProviderServices, s.dbGetter, s.logger)
+
+	// Removing a model that was never requested is a no-op.
+	getter.Remove("foo")
+}
+
 func (s *workerSuite) newWorker(c *gc.C) worker.Worker {
 	w, err := NewWorker(s.getConfig())
 	c.Assert(err, jc.ErrorIsNil)
@@ -13,8 +13,11 @@ import (
 
 	coredependency "github.com/juju/juju/core/dependency"
 	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain"
 	"github.com/juju/juju/domain/removal"
 	removalservice "github.com/juju/juju/domain/removal/service"
 	"github.com/juju/juju/internal/errors"
@@ -31,9 +34,10 @@ type DomainServices interface {
 // RemovalService describes the ability to watch
 // for and execute model entity removals.
 type RemovalService interface {
-	// WatchRemovals emits job UUIDs for additions
-	// and changes to removal job scheduling.
-	WatchRemovals() (watcher.StringsWatcher, error)
+	// WatchRemovalsContext emits job UUIDs for additions and changes to
+	// removal job scheduling. The watcher is killed when the input context
+	// is cancelled.
+	WatchRemovalsContext(ctx context.Context) (watcher.StringsWatcher, error)
 
 	// GetAllJobs returns all jobs for removals that have not been completed.
 	GetAllJobs(ctx context.Context) ([]removal.Job, error)
@@ -69,6 +73,16 @@ type ManifoldConfig struct {
 
 	// Logger logs stuff.
 	Logger logger.Logger
+
+	// LeaseManagerName is the name of the lease manager dependency.
+	// It is optional. When supplied, the application leadership of the
+	// model is checked by the worker so that removal of leader units
+	// can be deferred.
+	LeaseManagerName string
+
+	// ModelUUID is the UUID of the model whose entities are removed.
+	// It is required if LeaseManagerName is supplied.
+	ModelUUID model.UUID
 }
 
 // Validate ensures that the configuration is
@@ -89,17 +103,22 @@ func (config ManifoldConfig) Validate() error {
 	if config.Logger == nil {
 		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
 	}
+	if config.LeaseManagerName != "" && config.ModelUUID == "" {
+		return errors.New("empty ModelUUID not valid").Add(coreerrors.NotValid)
+	}
 
 	return nil
 }
 
 // Manifold returns a dependency.Manifold that will run the removal worker.
 func Manifold(config ManifoldConfig) dependency.Manifold {
+	inputs := []string{config.DomainServicesName}
+	if config.LeaseManagerName != "" {
+		inputs = append(inputs, config.LeaseManagerName)
+	}
 	return dependency.Manifold{
-		Inputs: []string{
-			config.DomainServicesName,
-		},
-		Start: config.start,
+		Inputs: inputs,
+		Start:  config.start,
 	}
 }
 
@@ -119,6 +138,17 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		Logger:         config.Logger,
 	}
 
+	if config.LeaseManagerName != "" {
+		var leaseManager lease.Manager
+		if err := getter.Get(config.LeaseManagerName, &leaseManager); err != nil {
+			return nil, errors.Capture(err)
+		}
+		wCfg.LeadershipEnsurer = domain.NewLeaseService(modelLeaseManagerGetter{
+			manager:   leaseManager,
+			modelUUID: config.ModelUUID,
+		})
+	}
+
 	w, err := config.NewWorker(wCfg)
 	if err != nil {
 		return nil, errors.Errorf("creating removal worker: %w", err)
@@ -133,3 +163,20 @@ func GetRemovalService(getter dependency.Getter, name string) (RemovalService, e
 		return factory.Removal()
 	})
 }
+
+// modelLeaseManagerGetter returns the application
+// leadership lease checker for a single model.
+type modelLeaseManagerGetter struct {
+	manager   lease.Manager
+	modelUUID model.UUID
+}
+
+// GetLeaseManager returns the application leadership
+// lease checker for the model.
+func (g modelLeaseManagerGetter) GetLeaseManager() (lease.Checker, error) {
+	checker, err := g.manager.Checker(lease.ApplicationLeadershipNamespace, g.modelUUID.String())
+	if err != nil {
+		return nil, errors.Errorf("getting application leadership checker: %w", err)
+	}
+	return checker, nil
+}
@@ -11,9 +11,12 @@ import (
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
+	dt "github.com/juju/worker/v4/dependency/testing"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/lease"
+	modeltesting "github.com/juju/juju/core/model/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
@@ -56,6 +59,11 @@ func (s *manifoldConfigSuite) TestMissingLogger(c *gc.C) {
 	s.checkNotValid(c, "nil Logger not valid")
 }
 
+func (s *manifoldConfigSuite) TestLeaseManagerMissingModelUUID(c *gc.C) {
+	s.config.LeaseManagerName = "lease-manager"
+	s.checkNotValid(c, "empty ModelUUID not valid")
+}
+
 func validConfig(c *gc.C) ManifoldConfig {
 	return ManifoldConfig{
 		DomainServicesName: "domain-services",
@@ -97,6 +105,86 @@ func (s *manifoldSuite) TestStartSuccess(c *gc.C) {
 	c.Check(w, gc.NotNil)
 }
 
+func (s *manifoldSuite) TestInputsWithLeaseManager(c *gc.C) {
+	cfg := validConfig(c)
+	c.Check(Manifold(cfg).Inputs, jc.DeepEquals, []string{"domain-services"})
+
+	cfg.LeaseManagerName = "lease-manager"
+	cfg.ModelUUID = modeltesting.GenModelUUID(c)
+	c.Check(Manifold(cfg).Inputs, jc.DeepEquals, []string{"domain-services", "lease-manager"})
+}
+
+func (s *manifoldSuite) TestStartWithLeaseManager(c *gc.C) {
+	modelUUID := modeltesting.GenModelUUID(c)
+	manager := &fakeLeaseManager{}
+
+	var wCfg Config
+	cfg := validConfig(c)
+	cfg.GetRemovalService = func(dependency.Getter, string) (RemovalService, error) { return noService{}, nil }
+	cfg.NewWorker = func(cfg Config) (worker.Worker, error) {
+		wCfg = cfg
+		return noWorker{}, nil
+	}
+	cfg.LeaseManagerName = "lease-manager"
+	cfg.ModelUUID = modelUUID
+
+	getter := dt.StubGetter(map[string]any{
+		"lease-manager": lease.Manager(manager),
+	})
+	_, err := Manifold(cfg).Start(context.Background(), getter)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(wCfg.LeadershipEnsurer, gc.NotNil)
+
+	// The ensurer checks application leadership leases for the model.
+	err = wCfg.LeadershipEnsurer.LeadershipCheck("foo", "foo/0").Check()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(manager.namespace, gc.Equals, lease.ApplicationLeadershipNamespace)
+	c.Check(manager.modelUUID, gc.Equals, modelUUID.String())
+	c.Check(manager.checker.tokens, jc.DeepEquals, [][]string{{"foo", "foo/0"}})
+}
+
+func (s *manifoldSuite) TestStartWithoutLeaseManager(c *gc.C) {
+	var wCfg Config
+	cfg := validConfig(c)
+	cfg.GetRemovalService = func(dependency.Getter, string) (RemovalService, error) { return noService{}, nil }
+	cfg.NewWorker = func(cfg Config) (worker.Worker, error) {
+		wCfg = cfg
+		return noWorker{}, nil
+	}
+
+	_, err := Manifold(cfg).Start(context.Background(), noGetter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(wCfg.LeadershipEnsurer, gc.IsNil)
+}
+
+// fakeLeaseManager records the lease checker requested from it.
+type fakeLeaseManager struct {
+	lease.Manager
+
+	namespace string
+	modelUUID string
+	checker   *fakeLeaseChecker
+}
+
+func (m *fakeLeaseManager) Checker(namespace string, modelUUID string) (lease.Checker, error) {
+	m.namespace = namespace
+	m.modelUUID = modelUUID
+	m.checker = &fakeLeaseChecker{}
+	return m.checker, nil
+}
+
+// fakeLeaseChecker issues tokens for held leases.
+type fakeLeaseChecker struct {
+	lease.Checker
+
+	tokens [][]string
+}
+
+func (c *fakeLeaseChecker) Token(leaseName, holderName string) lease.Token {
+	c.tokens = append(c.tokens, []string{leaseName, holderName})
+	return fakeToken{}
+}
+
 type noGetter struct {
 	dependency.Getter
 }
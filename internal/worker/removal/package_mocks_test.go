@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package removal -destination package_mocks_test.go github.com/juju/juju/internal/worker/removal RemovalService,Clock
+//	mockgen -typed -package removal -destination internal/worker/removal/package_mocks_test.go github.com/juju/juju/internal/worker/removal RemovalService,Clock
 //
 
 // Package removal is a generated GoMock package.
@@ -120,41 +120,41 @@ func (c *MockRemovalServiceGetAllJobsCall) DoAndReturn(f func(context.Context) (
 	return c
 }
 
-// WatchRemovals mocks base method.
-func (m *MockRemovalService) WatchRemovals() (watcher.Watcher[[]string], error) {
+// WatchRemovalsContext mocks base method.
+func (m *MockRemovalService) WatchRemovalsContext(arg0 context.Context) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WatchRemovals")
+	ret := m.ctrl.Call(m, "WatchRemovalsContext", arg0)
 	ret0, _ := ret[0].(watcher.Watcher[[]string])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WatchRemovals indicates an expected call of WatchRemovals.
-func (mr *MockRemovalServiceMockRecorder) WatchRemovals() *MockRemovalServiceWatchRemovalsCall {
+// WatchRemovalsContext indicates an expected call of WatchRemovalsContext.
+func (mr *MockRemovalServiceMockRecorder) WatchRemovalsContext(arg0 any) *MockRemovalServiceWatchRemovalsContextCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRemovals", reflect.TypeOf((*MockRemovalService)(nil).WatchRemovals))
-	return &MockRemovalServiceWatchRemovalsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRemovalsContext", reflect.TypeOf((*MockRemovalService)(nil).WatchRemovalsContext), arg0)
+	return &MockRemovalServiceWatchRemovalsContextCall{Call: call}
 }
 
-// MockRemovalServiceWatchRemovalsCall wrap *gomock.Call
-type MockRemovalServiceWatchRemovalsCall struct {
+// MockRemovalServiceWatchRemovalsContextCall wrap *gomock.Call
+type MockRemovalServiceWatchRemovalsContextCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockRemovalServiceWatchRemovalsCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockRemovalServiceWatchRemovalsCall {
+func (c *MockRemovalServiceWatchRemovalsContextCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockRemovalServiceWatchRemovalsContextCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockRemovalServiceWatchRemovalsCall) Do(f func() (watcher.Watcher[[]string], error)) *MockRemovalServiceWatchRemovalsCall {
+func (c *MockRemovalServiceWatchRemovalsContextCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockRemovalServiceWatchRemovalsContextCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockRemovalServiceWatchRemovalsCall) DoAndReturn(f func() (watcher.Watcher[[]string], error)) *MockRemovalServiceWatchRemovalsCall {
+func (c *MockRemovalServiceWatchRemovalsContextCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockRemovalServiceWatchRemovalsContextCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
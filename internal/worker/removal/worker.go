@@ -4,7 +4,10 @@
 package removal
 
 import (
+	"cmp"
 	"context"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/juju/collections/set"
@@ -13,7 +16,10 @@ import (
 	"gopkg.in/tomb.v2"
 
 	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/removal"
 	"github.com/juju/juju/internal/errors"
 	internalworker "github.com/juju/juju/internal/worker"
@@ -23,6 +29,16 @@ import (
 // to see if any jobs need processing.
 const jobCheckMaxInterval = 30 * time.Second
 
+// jobPriorities orders removal jobs by the kind of entity they remove. Jobs
+// with a lower value are scheduled first, so that entities are removed before
+// those they depend on; for example relations before the units in them, and
+// units before the machines hosting them. Job types not listed here are
+// scheduled after all others.
+var jobPriorities = map[removal.JobType]int{
+	removal.RelationJob: 0,
+	removal.UnitJob:     1,
+}
+
 // Config holds configuration required to run the removal worker.
 type Config struct {
 
@@ -34,6 +50,11 @@ type Config struct {
 
 	// Logger logs stuff.
 	Logger logger.Logger
+
+	// LeadershipEnsurer is optional. When supplied, the removal of a unit
+	// that currently holds leadership of its application is deferred until
+	// leadership is released or revoked.
+	LeadershipEnsurer leadership.Ensurer
 }
 
 // Validate ensures that the configuration is
@@ -95,7 +116,9 @@ func NewWorker(cfg Config) (worker.Worker, error) {
 }
 
 func (w *removalWorker) loop() (err error) {
-	watch, err := w.cfg.RemovalService.WatchRemovals()
+	ctx := w.catacomb.Context(context.Background())
+
+	watch, err := w.cfg.RemovalService.WatchRemovalsContext(ctx)
 	if err != nil {
 		return errors.Capture(err)
 	}
@@ -104,8 +127,6 @@ func (w *removalWorker) loop() (err error) {
 		return errors.Capture(err)
 	}
 
-	ctx := w.catacomb.Context(context.Background())
-
 	timer := w.cfg.Clock.NewTimer(jobCheckMaxInterval)
 	defer timer.Stop()
 
@@ -144,6 +165,8 @@ func (w *removalWorker) processRemovalJobs(ctx context.Context) error {
 	running := set.NewStrings(w.runner.WorkerNames()...)
 	log := w.cfg.Logger
 
+	sortJobsByPriority(jobs)
+
 	for _, j := range jobs {
 		id := j.UUID.String()
 
@@ -160,6 +183,11 @@ func (w *removalWorker) processRemovalJobs(ctx context.Context) error {
 			continue
 		}
 
+		if w.holdsLeadership(ctx, j) {
+			log.Debugf(ctx, "removal job %q deferred while unit holds leadership", id)
+			continue
+		}
+
 		w.cfg.Logger.Infof(ctx, "scheduling job %q", id)
 		if err := w.runner.StartWorker(ctx, id, newJobWorker(w.cfg.RemovalService, j)); err != nil {
 			return errors.Capture(err)
@@ -169,6 +197,52 @@ func (w *removalWorker) processRemovalJobs(ctx context.Context) error {
 	return nil
 }
 
+// holdsLeadership returns true if the input job removes a unit that currently
+// holds leadership of its application. Such jobs are left to be picked up
+// again once leadership has moved on. If there is no leadership ensurer, or
+// the job is not for a unit, false is returned.
+func (w *removalWorker) holdsLeadership(ctx context.Context, job removal.Job) bool {
+	if w.cfg.LeadershipEnsurer == nil || job.RemovalType != removal.UnitJob {
+		return false
+	}
+
+	name, _ := job.Arg[removal.UnitNameArg].(string)
+	unitName, err := unit.NewName(name)
+	if err != nil {
+		w.cfg.Logger.Warningf(ctx, "removal job %q has invalid unit name %q: %v", job.UUID, name, err)
+		return false
+	}
+
+	err = w.cfg.LeadershipEnsurer.LeadershipCheck(unitName.Application(), unitName.String()).Check()
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, lease.ErrNotHeld), leadership.IsNotLeaderError(err):
+		return false
+	default:
+		// We can not be sure that the unit is not the leader,
+		// so err on the side of caution and try again later.
+		w.cfg.Logger.Warningf(ctx, "checking leadership for unit %q: %v", unitName, err)
+		return true
+	}
+}
+
+// sortJobsByPriority sorts the jobs in place by the priority of their removal
+// type. Jobs with the same priority keep the order they were supplied in.
+func sortJobsByPriority(jobs []removal.Job) {
+	slices.SortStableFunc(jobs, func(a, b removal.Job) int {
+		return cmp.Compare(jobPriority(a.RemovalType), jobPriority(b.RemovalType))
+	})
+}
+
+// jobPriority returns the scheduling priority for the removal type.
+func jobPriority(t removal.JobType) int {
+	if p, ok := jobPriorities[t]; ok {
+		return p
+	}
+	return math.MaxInt
+}
+
 // Report returns data for display in the dependency engine report.
 // In this case, it simply reports on all jobs in the runner.
 func (w *removalWorker) Report() map[string]any {
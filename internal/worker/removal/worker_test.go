@@ -6,6 +6,7 @@ package removal
 import (
 	"context"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -17,8 +18,11 @@ import (
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
@@ -36,7 +40,7 @@ func (s *workerSuite) TestWorkerStartStop(c *gc.C) {
 
 	ch := make(chan []string)
 	watch := watchertest.NewMockStringsWatcher(ch)
-	s.svc.EXPECT().WatchRemovals().Return(watch, nil)
+	s.svc.EXPECT().WatchRemovalsContext(gomock.Any()).Return(watch, nil)
 
 	// Use the timer creation as a synchronisation point below.
 	// so that we know we are entrant into the worker's loop.
@@ -72,7 +76,7 @@ func (s *workerSuite) TestWorkerNotifiedSchedulesDueJob(c *gc.C) {
 
 	ch := make(chan []string)
 	watch := watchertest.NewMockStringsWatcher(ch)
-	s.svc.EXPECT().WatchRemovals().Return(watch, nil)
+	s.svc.EXPECT().WatchRemovalsContext(gomock.Any()).Return(watch, nil)
 
 	s.clk.EXPECT().NewTimer(jobCheckMaxInterval).DoAndReturn(func(d time.Duration) clock.Timer {
 		return clock.WallClock.NewTimer(d)
@@ -138,7 +142,7 @@ func (s *workerSuite) TestWorkerTimerSchedulesOnlyRequiredJob(c *gc.C) {
 
 	ch := make(chan []string)
 	watch := watchertest.NewMockStringsWatcher(ch)
-	s.svc.EXPECT().WatchRemovals().Return(watch, nil)
+	s.svc.EXPECT().WatchRemovalsContext(gomock.Any()).Return(watch, nil)
 
 	// Fire it straight away.
 	s.clk.EXPECT().NewTimer(jobCheckMaxInterval).DoAndReturn(func(d time.Duration) clock.Timer {
@@ -227,7 +231,7 @@ func (s *workerSuite) TestWorkerReport(c *gc.C) {
 
 	ch := make(chan []string)
 	watch := watchertest.NewMockStringsWatcher(ch)
-	s.svc.EXPECT().WatchRemovals().Return(watch, nil)
+	s.svc.EXPECT().WatchRemovalsContext(gomock.Any()).Return(watch, nil)
 
 	s.clk.EXPECT().NewTimer(jobCheckMaxInterval).DoAndReturn(func(d time.Duration) clock.Timer {
 		return clock.WallClock.NewTimer(d)
@@ -342,6 +346,227 @@ func (s *workerSuite) TestWorkerReport(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *workerSuite) TestSortJobsByPriority(c *gc.C) {
+	unknown := removal.JobType(99)
+	jobs := []removal.Job{
+		{UUID: "unknown-1", RemovalType: unknown},
+		{UUID: "relation-1", RemovalType: removal.RelationJob},
+		{UUID: "unknown-2", RemovalType: unknown},
+		{UUID: "relation-2", RemovalType: removal.RelationJob},
+	}
+
+	sortJobsByPriority(jobs)
+
+	var uuids []removal.UUID
+	for _, j := range jobs {
+		uuids = append(uuids, j.UUID)
+	}
+
+	// Relations are scheduled first, and jobs of the same type keep their
+	// relative order.
+	c.Check(uuids, jc.DeepEquals, []removal.UUID{"relation-1", "relation-2", "unknown-1", "unknown-2"})
+}
+
+func (s *workerSuite) TestHoldsLeadership(c *gc.C) {
+	unitJob := removal.Job{
+		UUID:        "unit-job-uuid",
+		RemovalType: removal.UnitJob,
+		EntityUUID:  "unit-uuid",
+		Arg:         map[string]any{removal.UnitNameArg: "app/0"},
+	}
+	relationJob := removal.Job{
+		UUID:        "relation-job-uuid",
+		RemovalType: removal.RelationJob,
+		EntityUUID:  "relation-uuid",
+	}
+
+	tests := []struct {
+		about    string
+		ensurer  leadership.Ensurer
+		job      removal.Job
+		expected bool
+	}{{
+		about:    "no ensurer",
+		job:      unitJob,
+		expected: false,
+	}, {
+		about:    "not a unit job",
+		ensurer:  &fakeEnsurer{},
+		job:      relationJob,
+		expected: false,
+	}, {
+		about:    "unit is the leader",
+		ensurer:  &fakeEnsurer{},
+		job:      unitJob,
+		expected: true,
+	}, {
+		about:    "unit is not the leader",
+		ensurer:  &fakeEnsurer{err: lease.ErrNotHeld},
+		job:      unitJob,
+		expected: false,
+	}, {
+		about:    "leadership check failed",
+		ensurer:  &fakeEnsurer{err: errors.New("boom")},
+		job:      unitJob,
+		expected: true,
+	}}
+
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.about)
+
+		w := &removalWorker{cfg: Config{
+			Logger:            loggertesting.WrapCheckLog(c),
+			LeadershipEnsurer: test.ensurer,
+		}}
+		c.Check(w.holdsLeadership(context.Background(), test.job), gc.Equals, test.expected)
+
+		if f, ok := test.ensurer.(*fakeEnsurer); ok && test.job.RemovalType == removal.UnitJob {
+			c.Check(f.calls, jc.DeepEquals, [][]string{{"app", "app/0"}})
+		}
+	}
+}
+
+func (s *workerSuite) TestWorkerDefersLeaderUnitJob(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	ch := make(chan []string)
+	watch := watchertest.NewMockStringsWatcher(ch)
+	s.svc.EXPECT().WatchRemovalsContext(gomock.Any()).Return(watch, nil)
+
+	s.clk.EXPECT().NewTimer(jobCheckMaxInterval).DoAndReturn(func(d time.Duration) clock.Timer {
+		return clock.WallClock.NewTimer(d)
+	})
+
+	now := time.Now().UTC()
+	s.clk.EXPECT().Now().Return(now).Times(2)
+
+	leaderJob := removal.Job{
+		UUID:         "leader-job-uuid",
+		RemovalType:  removal.UnitJob,
+		EntityUUID:   "leader-unit-uuid",
+		ScheduledFor: now.Add(-time.Hour),
+		Arg:          map[string]any{removal.UnitNameArg: "app/0"},
+	}
+	unitJob := removal.Job{
+		UUID:         "unit-job-uuid",
+		RemovalType:  removal.UnitJob,
+		EntityUUID:   "unit-uuid",
+		ScheduledFor: now.Add(-time.Hour),
+		Arg:          map[string]any{removal.UnitNameArg: "app/1"},
+	}
+	s.svc.EXPECT().GetAllJobs(gomock.Any()).Return([]removal.Job{leaderJob, unitJob}, nil)
+
+	// Only the other unit's job is executed; the leader unit's removal is
+	// deferred.
+	sync := make(chan struct{})
+	s.svc.EXPECT().ExecuteJob(gomock.Any(), unitJob).DoAndReturn(func(_ context.Context, job removal.Job) error {
+		sync <- struct{}{}
+		return nil
+	})
+
+	cfg := Config{
+		RemovalService:    s.svc,
+		Clock:             s.clk,
+		Logger:            loggertesting.WrapCheckLog(c),
+		LeadershipEnsurer: &fakeEnsurer{leaders: []string{"app/0"}},
+	}
+	w, err := NewWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	select {
+	case ch <- []string{"leader-job-uuid", "unit-job-uuid"}:
+	case <-time.After(testing.ShortWait):
+		c.Fatalf("timed out waiting for watcher event consumption")
+	}
+
+	select {
+	case <-sync:
+	case <-time.After(testing.ShortWait):
+		c.Fatalf("timed out waiting for job execution")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
+// TestWorkerRunsUnitJobWhileRelationJobPending tests the following sequence
+// of events:
+// - The watcher fires.
+// - We query for jobs, and receive a due unit job and a due relation job.
+// - The relation job is scheduled first, and does not complete.
+// - The unit job is scheduled regardless, and runs to completion.
+func (s *workerSuite) TestWorkerRunsUnitJobWhileRelationJobPending(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	ch := make(chan []string)
+	watch := watchertest.NewMockStringsWatcher(ch)
+	s.svc.EXPECT().WatchRemovalsContext(gomock.Any()).Return(watch, nil)
+
+	s.clk.EXPECT().NewTimer(jobCheckMaxInterval).DoAndReturn(func(d time.Duration) clock.Timer {
+		return clock.WallClock.NewTimer(d)
+	})
+
+	now := time.Now().UTC()
+	s.clk.EXPECT().Now().Return(now).AnyTimes()
+
+	unitJob := removal.Job{
+		UUID:         "unit-job-uuid",
+		RemovalType:  removal.UnitJob,
+		EntityUUID:   "unit-uuid",
+		ScheduledFor: now.Add(-time.Hour),
+		Arg:          map[string]any{removal.UnitNameArg: "other/0"},
+	}
+	relationJob := removal.Job{
+		UUID:         "relation-job-uuid",
+		RemovalType:  removal.RelationJob,
+		EntityUUID:   "relation-uuid",
+		ScheduledFor: now.Add(-time.Hour),
+	}
+	s.svc.EXPECT().GetAllJobs(gomock.Any()).Return([]removal.Job{unitJob, relationJob}, nil)
+
+	relationStarted := make(chan struct{})
+	release := make(chan struct{})
+	s.svc.EXPECT().ExecuteJob(gomock.Any(), relationJob).DoAndReturn(func(ctx context.Context, job removal.Job) error {
+		close(relationStarted)
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return nil
+	})
+	unitDone := make(chan struct{})
+	s.svc.EXPECT().ExecuteJob(gomock.Any(), unitJob).DoAndReturn(func(context.Context, removal.Job) error {
+		close(unitDone)
+		return nil
+	})
+
+	cfg := Config{
+		RemovalService: s.svc,
+		Clock:          s.clk,
+		Logger:         loggertesting.WrapCheckLog(c),
+	}
+	w, err := NewWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	select {
+	case ch <- []string{"unit-job-uuid", "relation-job-uuid"}:
+	case <-time.After(testing.ShortWait):
+		c.Fatalf("timed out waiting for watcher event consumption")
+	}
+
+	for _, done := range []chan struct{}{relationStarted, unitDone} {
+		select {
+		case <-done:
+		case <-time.After(testing.LongWait):
+			c.Fatalf("timed out waiting for job execution")
+		}
+	}
+	close(release)
+
+	workertest.CleanKill(c, w)
+}
+
 func (s *workerSuite) setUpMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
@@ -350,3 +575,30 @@ func (s *workerSuite) setUpMocks(c *gc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+// fakeEnsurer is a [leadership.Ensurer] that reports every unit as the leader
+// of its application, unless err is set. If leaders is set, only the units
+// named in it are reported as leaders.
+type fakeEnsurer struct {
+	leadership.Ensurer
+
+	err     error
+	leaders []string
+	calls   [][]string
+}
+
+func (f *fakeEnsurer) LeadershipCheck(applicationName, unitName string) leadership.Token {
+	f.calls = append(f.calls, []string{applicationName, unitName})
+	if f.leaders != nil && !slices.Contains(f.leaders, unitName) {
+		return fakeToken{err: lease.ErrNotHeld}
+	}
+	return fakeToken{err: f.err}
+}
+
+type fakeToken struct {
+	err error
+}
+
+func (t fakeToken) Check() error {
+	return t.err
+}
@@ -24,6 +24,14 @@ import (
 
 type fixture struct {
 	testing.Stub
+
+	// strategies, if set, are returned in turn by the stub facade instead
+	// of flipping the initial strategy on the second call.
+	strategies []params.RetryStrategy
+
+	// changes, if set, is used by the stub watcher instead of three
+	// buffered changes.
+	changes chan struct{}
 }
 
 func newFixture(c *gc.C, errs ...error) *fixture {
@@ -34,17 +42,26 @@ func newFixture(c *gc.C, errs ...error) *fixture {
 }
 
 func (fix *fixture) Run(c *gc.C, test func(worker.Worker)) {
+	fix.RunWithConfig(c, func(*retrystrategy.WorkerConfig) {}, test)
+}
+
+func (fix *fixture) RunWithConfig(c *gc.C, configure func(*retrystrategy.WorkerConfig), test func(worker.Worker)) {
 	stubRetryStrategy := params.RetryStrategy{
 		ShouldRetry: true,
 	}
 	stubTag := stubTag{}
 	stubFacade := newStubFacade(c, &fix.Stub, stubRetryStrategy, stubTag)
+	stubFacade.strategies = fix.strategies
+	if fix.changes != nil {
+		stubFacade.watcher.notifyChan = fix.changes
+	}
 	stubConfig := retrystrategy.WorkerConfig{
 		Facade:        stubFacade,
 		AgentTag:      stubTag,
 		RetryStrategy: stubRetryStrategy,
 		Logger:        loggertesting.WrapCheckLog(c),
 	}
+	configure(&stubConfig)
 
 	w, err := retrystrategy.NewRetryStrategyWorker(stubConfig)
 	c.Assert(err, jc.ErrorIsNil)
@@ -68,6 +85,7 @@ type stubFacade struct {
 	watcher         *stubWatcher
 	count           int
 	initialStrategy params.RetryStrategy
+	strategies      []params.RetryStrategy
 	stubTag         names.Tag
 }
 
@@ -98,6 +116,9 @@ func (f *stubFacade) RetryStrategy(ctx context.Context, agentTag names.Tag) (par
 	f.c.Assert(agentTag, gc.Equals, f.stubTag)
 	f.stub.AddCall("RetryStrategy", agentTag)
 	f.count = f.count + 1
+	if len(f.strategies) > 0 {
+		return f.strategies[f.count-1], f.stub.NextErr()
+	}
 	// Change the strategy after 2 handles
 	if f.count == 2 {
 		f.initialStrategy.ShouldRetry = !f.initialStrategy.ShouldRetry
@@ -6,7 +6,9 @@ package retrystrategy
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
@@ -25,6 +27,11 @@ type ManifoldConfig struct {
 	NewFacade     func(base.APICaller) Facade
 	NewWorker     func(WorkerConfig) (worker.Worker, error)
 	Logger        logger.Logger
+
+	// Clock and DebounceWindow are passed through to the worker; see
+	// WorkerConfig for details.
+	Clock          clock.Clock
+	DebounceWindow time.Duration
 }
 
 // Manifold returns a dependency manifold that runs a hook retry strategy worker,
@@ -47,10 +54,12 @@ func (mc ManifoldConfig) start(ctx context.Context, a agent.Agent, apiCaller bas
 		return nil, errors.Trace(err)
 	}
 	return mc.NewWorker(WorkerConfig{
-		Facade:        retryStrategyFacade,
-		AgentTag:      agentTag,
-		RetryStrategy: initialRetryStrategy,
-		Logger:        mc.Logger,
+		Facade:         retryStrategyFacade,
+		AgentTag:       agentTag,
+		RetryStrategy:  initialRetryStrategy,
+		Logger:         mc.Logger,
+		Clock:          mc.Clock,
+		DebounceWindow: mc.DebounceWindow,
 	})
 }
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/testing"
@@ -140,6 +141,27 @@ func (s *ManifoldSuite) TestStartSuccess(c *gc.C) {
 	c.Assert(w, gc.Equals, fakeWorker)
 }
 
+func (s *ManifoldSuite) TestStartPassesDebounceConfig(c *gc.C) {
+	clock := testclock.NewClock(time.Now())
+	var config retrystrategy.WorkerConfig
+	manifold := retrystrategy.Manifold(retrystrategy.ManifoldConfig{
+		AgentName:     "agent",
+		APICallerName: "api-caller",
+		NewFacade:     s.newFacade(&fakeFacade{}),
+		NewWorker: func(wc retrystrategy.WorkerConfig) (worker.Worker, error) {
+			config = wc
+			return &fakeWorker{}, nil
+		},
+		Clock:          clock,
+		DebounceWindow: time.Minute,
+	})
+
+	_, err := manifold.Start(context.Background(), s.getter)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(config.Clock, gc.Equals, clock)
+	c.Check(config.DebounceWindow, gc.Equals, time.Minute)
+}
+
 func (s *ManifoldSuite) TestOutputSuccess(c *gc.C) {
 	manifold := retrystrategy.Manifold(retrystrategy.ManifoldConfig{
 		AgentName:     "agent",
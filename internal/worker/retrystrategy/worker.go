@@ -6,10 +6,13 @@ package retrystrategy
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
 	"github.com/juju/worker/v4/dependency"
 
 	"github.com/juju/juju/core/logger"
@@ -29,6 +32,15 @@ type WorkerConfig struct {
 	AgentTag      names.Tag
 	RetryStrategy params.RetryStrategy
 	Logger        logger.Logger
+
+	// Clock is used to wait for the retry strategy to settle. It is only
+	// required when DebounceWindow is non-zero.
+	Clock clock.Clock
+
+	// DebounceWindow is the period for which the retry strategy watcher
+	// must be quiet before the strategy is checked. A zero window checks
+	// the strategy, and bounces if it has changed, on every change.
+	DebounceWindow time.Duration
 }
 
 // Validate returns an error if the configuration is not complete.
@@ -43,13 +55,21 @@ func (c WorkerConfig) Validate() error {
 	if c.RetryStrategy == empty {
 		return errors.NotValidf("empty RetryStrategy")
 	}
+	if c.DebounceWindow < 0 {
+		return errors.NotValidf("negative DebounceWindow")
+	}
+	if c.DebounceWindow > 0 && c.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
 	return nil
 }
 
-// RetryStrategyWorker is a NotifyWorker with one additional
-// method that returns the current retry strategy.
+// RetryStrategyWorker watches the retry strategy, and bounces when it
+// changes. It has one additional method that returns the current retry
+// strategy.
 type RetryStrategyWorker struct {
-	*watcher.NotifyWorker
+	catacomb      catacomb.Catacomb
+	config        WorkerConfig
 	retryStrategy params.RetryStrategy
 }
 
@@ -59,13 +79,18 @@ func NewRetryStrategyWorker(config WorkerConfig) (worker.Worker, error) {
 	if err := config.Validate(); err != nil {
 		return nil, errors.Trace(err)
 	}
-	w, err := watcher.NewNotifyWorker(watcher.NotifyConfig{
-		Handler: retryStrategyHandler{config: config},
-	})
-	if err != nil {
+	w := &RetryStrategyWorker{
+		config:        config,
+		retryStrategy: config.RetryStrategy,
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "retry-strategy",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &RetryStrategyWorker{NotifyWorker: w, retryStrategy: config.RetryStrategy}, nil
+	return w, nil
 }
 
 // GetRetryStrategy returns the current hook retry strategy
@@ -73,33 +98,84 @@ func (w *RetryStrategyWorker) GetRetryStrategy() params.RetryStrategy {
 	return w.retryStrategy
 }
 
-// retryStrategyHandler implements watcher.NotifyHandler
-type retryStrategyHandler struct {
-	config WorkerConfig
+// Kill is part of the worker.Worker interface.
+func (w *RetryStrategyWorker) Kill() {
+	w.catacomb.Kill(nil)
 }
 
-// SetUp is part of the watcher.NotifyHandler interface.
-func (h retryStrategyHandler) SetUp(ctx context.Context) (watcher.NotifyWatcher, error) {
-	return h.config.Facade.WatchRetryStrategy(ctx, h.config.AgentTag)
+// Wait is part of the worker.Worker interface.
+func (w *RetryStrategyWorker) Wait() error {
+	return w.catacomb.Wait()
 }
 
-// Handle is part of the watcher.NotifyHandler interface.
-// Whenever a valid change is encountered the worker bounces,
-// making the dependents bounce and get the new value
-func (h retryStrategyHandler) Handle(ctx context.Context) error {
-	newRetryStrategy, err := h.config.Facade.RetryStrategy(ctx, h.config.AgentTag)
+// loop checks the retry strategy whenever the watcher reports a change.
+// With a debounce window, changes restart a timer, and the strategy is only
+// checked once the window has passed without any further changes.
+func (w *RetryStrategyWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	strategyWatcher, err := w.config.Facade.WatchRetryStrategy(ctx, w.config.AgentTag)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if newRetryStrategy != h.config.RetryStrategy {
-		h.config.Logger.Debugf(ctx, "bouncing retrystrategy worker to get new values")
-		return dependency.ErrBounce
+	if err := w.catacomb.Add(strategyWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	var (
+		timer   clock.Timer
+		settled <-chan time.Time
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-strategyWatcher.Changes():
+			if !ok {
+				return errors.New("retry strategy watcher closed")
+			}
+			if w.config.DebounceWindow == 0 {
+				if err := w.checkRetryStrategy(ctx); err != nil {
+					return err
+				}
+				continue
+			}
+			if timer == nil {
+				timer = w.config.Clock.NewTimer(w.config.DebounceWindow)
+			} else {
+				// Drain a fired but unhandled timer, so the reset window
+				// isn't cut short.
+				if !timer.Stop() && settled != nil {
+					<-timer.Chan()
+				}
+				timer.Reset(w.config.DebounceWindow)
+			}
+			settled = timer.Chan()
+		case <-settled:
+			settled = nil
+			if err := w.checkRetryStrategy(ctx); err != nil {
+				return err
+			}
+		}
 	}
-	return nil
 }
 
-// TearDown is part of the watcher.NotifyHandler interface.
-func (h retryStrategyHandler) TearDown() error {
-	// Nothing to cleanup, only state is the watcher
-	return nil
+// checkRetryStrategy returns dependency.ErrBounce if the retry strategy
+// differs from the one the worker was started with, making the dependents
+// bounce and get the new value.
+func (w *RetryStrategyWorker) checkRetryStrategy(ctx context.Context) error {
+	newRetryStrategy, err := w.config.Facade.RetryStrategy(ctx, w.config.AgentTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if newRetryStrategy == w.retryStrategy {
+		return nil
+	}
+	w.config.Logger.Debugf(ctx, "bouncing retrystrategy worker to get new values")
+	return dependency.ErrBounce
 }
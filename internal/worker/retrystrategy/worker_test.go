@@ -5,13 +5,19 @@
 package retrystrategy_test
 
 import (
+	"time"
+
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/workertest"
 	gc "gopkg.in/check.v1"
 
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/retrystrategy"
+	"github.com/juju/juju/rpc/params"
 )
 
 type WorkerSuite struct {
@@ -51,6 +57,24 @@ func (s WorkerSuite) TestValidateInvalidRetryStrategy(c *gc.C) {
 	}, "empty RetryStrategy not valid")
 }
 
+func (s WorkerSuite) TestValidateNegativeDebounceWindow(c *gc.C) {
+	s.testValidate(c, retrystrategy.WorkerConfig{
+		Facade:         &stubFacade{},
+		AgentTag:       &stubTag{},
+		RetryStrategy:  params.RetryStrategy{ShouldRetry: true},
+		DebounceWindow: -time.Second,
+	}, "negative DebounceWindow not valid")
+}
+
+func (s WorkerSuite) TestValidateDebounceWindowNilClock(c *gc.C) {
+	s.testValidate(c, retrystrategy.WorkerConfig{
+		Facade:         &stubFacade{},
+		AgentTag:       &stubTag{},
+		RetryStrategy:  params.RetryStrategy{ShouldRetry: true},
+		DebounceWindow: time.Second,
+	}, "nil Clock not valid")
+}
+
 func (s WorkerSuite) TestWatchError(c *gc.C) {
 	fix := newFixture(c, errors.New("supersonybunduru"))
 	fix.Run(c, func(w worker.Worker) {
@@ -77,3 +101,82 @@ func (s WorkerSuite) TestBounce(c *gc.C) {
 	})
 	fix.CheckCallNames(c, "WatchRetryStrategy", "RetryStrategy", "RetryStrategy")
 }
+
+func (s WorkerSuite) TestBounceDebounced(c *gc.C) {
+	clock := testclock.NewClock(time.Now())
+	fix := newFixture(c)
+	fix.strategies = []params.RetryStrategy{{ShouldRetry: false}}
+	fix.changes = make(chan struct{})
+	fix.RunWithConfig(c, func(config *retrystrategy.WorkerConfig) {
+		config.Clock = clock
+		config.DebounceWindow = time.Minute
+	}, func(w worker.Worker) {
+		fix.changes <- struct{}{}
+		waitAlarm(c, clock)
+		clock.Advance(time.Minute)
+
+		err := w.Wait()
+		c.Assert(err, gc.ErrorMatches, "restart immediately")
+	})
+	fix.CheckCallNames(c, "WatchRetryStrategy", "RetryStrategy")
+}
+
+func (s WorkerSuite) TestDebounceRestartsOnChange(c *gc.C) {
+	clock := testclock.NewClock(time.Now())
+	fix := newFixture(c)
+	fix.strategies = []params.RetryStrategy{{ShouldRetry: false}}
+	fix.changes = make(chan struct{})
+	fix.RunWithConfig(c, func(config *retrystrategy.WorkerConfig) {
+		config.Clock = clock
+		config.DebounceWindow = time.Minute
+	}, func(w worker.Worker) {
+		fix.changes <- struct{}{}
+		waitAlarm(c, clock)
+		clock.Advance(30 * time.Second)
+
+		// A further change restarts the window, so the strategy isn't
+		// checked until a whole window has passed since it.
+		fix.changes <- struct{}{}
+		waitAlarm(c, clock)
+		clock.Advance(30 * time.Second)
+		clock.Advance(30 * time.Second)
+
+		err := w.Wait()
+		c.Assert(err, gc.ErrorMatches, "restart immediately")
+	})
+	// Only one check was made, once the window after the last change
+	// had passed.
+	fix.CheckCallNames(c, "WatchRetryStrategy", "RetryStrategy")
+}
+
+func (s WorkerSuite) TestDebounceSettlesToCurrentStrategy(c *gc.C) {
+	clock := testclock.NewClock(time.Now())
+	fix := newFixture(c)
+	fix.strategies = []params.RetryStrategy{{ShouldRetry: true}}
+	fix.changes = make(chan struct{})
+	fix.RunWithConfig(c, func(config *retrystrategy.WorkerConfig) {
+		config.Clock = clock
+		config.DebounceWindow = time.Minute
+	}, func(w worker.Worker) {
+		fix.changes <- struct{}{}
+		waitAlarm(c, clock)
+		clock.Advance(time.Minute)
+
+		// The next change can only be delivered once the settled
+		// strategy has been checked.
+		fix.changes <- struct{}{}
+		waitAlarm(c, clock)
+
+		workertest.CheckKill(c, w)
+	})
+	fix.CheckCallNames(c, "WatchRetryStrategy", "RetryStrategy")
+}
+
+// waitAlarm waits for the worker to start or reset its debounce timer.
+func waitAlarm(c *gc.C, clock *testclock.Clock) {
+	select {
+	case <-clock.Alarms():
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for debounce timer")
+	}
+}
@@ -492,6 +492,29 @@ type ApplicationMergeBindings struct {
 	Force          bool              `json:"force"`
 }
 
+// BindingWarning describes a unit whose machine has no address in the space
+// that one of its application's endpoints is bound to.
+type BindingWarning struct {
+	UnitTag    string `json:"unit-tag"`
+	MachineTag string `json:"machine-tag"`
+	Endpoint   string `json:"endpoint"`
+	Space      string `json:"space"`
+	Message    string `json:"message"`
+}
+
+// BindingConsistencyResult holds the binding warnings for the units of a
+// single application.
+type BindingConsistencyResult struct {
+	Warnings []BindingWarning `json:"warnings,omitempty"`
+	Error    *Error           `json:"error,omitempty"`
+}
+
+// BindingConsistencyResults holds the results of the
+// Application.CheckBindingConsistency call.
+type BindingConsistencyResults struct {
+	Results []BindingConsistencyResult `json:"results"`
+}
+
 // DestroyUnitsParamsV15 holds bulk parameters for the Application.DestroyUnit call.
 type DestroyUnitsParamsV15 struct {
 	Units []DestroyUnitParamsV15 `json:"units"`
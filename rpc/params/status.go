@@ -35,6 +35,30 @@ type FullStatus struct {
 	Volumes             []VolumeDetails                    `json:"volumes,omitempty"`
 }
 
+// StatusSummary holds the number of applications and units in a model in
+// each status.
+type StatusSummary struct {
+	Applications StatusCounts `json:"applications"`
+	Units        StatusCounts `json:"units"`
+}
+
+// StatusCounts holds the number of entities in each status. Units are
+// counted by their workload status. Entities in any other status, such as
+// unknown or terminated, are counted as Other.
+type StatusCounts struct {
+	Active      int `json:"active"`
+	Blocked     int `json:"blocked"`
+	Waiting     int `json:"waiting"`
+	Error       int `json:"error"`
+	Maintenance int `json:"maintenance"`
+	Other       int `json:"other"`
+}
+
+// Total returns the total number of entities counted.
+func (c StatusCounts) Total() int {
+	return c.Active + c.Blocked + c.Waiting + c.Error + c.Maintenance + c.Other
+}
+
 // IsEmpty checks all collections on FullStatus to determine if the status is empty.
 // Note that only the collections are checked here as Model information will always be populated.
 func (fs *FullStatus) IsEmpty() bool {
@@ -136,6 +160,11 @@ type LXDProfile struct {
 	Config      map[string]string            `json:"config"`
 	Description string                       `json:"description"`
 	Devices     map[string]map[string]string `json:"devices"`
+
+	// ConfigDrift holds the config keys whose applied value differs from
+	// the profile expected for the application's current charm, mapped to
+	// the applied value.
+	ConfigDrift map[string]string `json:"config-drift,omitempty"`
 }
 
 // ApplicationStatus holds status info about an application.
@@ -663,6 +663,30 @@ type StoragePoolsResults struct {
 	Results []StoragePoolsResult `json:"results,omitempty"`
 }
 
+// PoolUsage holds the aggregated usage of the filesystems allocated
+// from a storage pool.
+type PoolUsage struct {
+	// Total is the combined size of the pool's filesystems in bytes.
+	Total uint64 `json:"total"`
+
+	// Used is the combined size in bytes of the pool's filesystems
+	// that are attached to a machine or unit.
+	Used uint64 `json:"used"`
+
+	// Available is the combined size in bytes of the pool's filesystems
+	// that are not attached.
+	Available uint64 `json:"available"`
+
+	// Filesystems is the number of filesystems allocated from the pool.
+	Filesystems int `json:"filesystems"`
+}
+
+// FilesystemPoolSummaryResult holds the filesystem usage of each storage
+// pool in the model, keyed by pool name.
+type FilesystemPoolSummaryResult struct {
+	Pools map[string]PoolUsage `json:"pools"`
+}
+
 // VolumeFilter holds a filter for volume list API call.
 type VolumeFilter struct {
 	// Machines are machine tags to filter on.
@@ -1661,6 +1661,39 @@ func allUnits(st *State, application string) (units []*Unit, err error) {
 	return units, nil
 }
 
+// AllUnitsAgentTools returns the agent binaries of every unit of the
+// application, keyed on unit name. The units are read in a single query.
+// Units whose agent binaries have not been recorded yet map to nil.
+func (a *Application) AllUnitsAgentTools() (map[string]*tools.Tools, error) {
+	unitsCollection, closer := a.st.db().GetCollection(unitsC)
+	defer closer()
+
+	var docs []unitAgentToolsDoc
+	err := unitsCollection.Find(bson.D{{"application", a.doc.Name}}).
+		Select(bson.D{{"name", 1}, {"tools", 1}}).
+		All(&docs)
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get agent binaries for units of application %q", a.doc.Name)
+	}
+	return unitsAgentTools(docs), nil
+}
+
+// unitAgentToolsDoc holds the agent binaries recorded on a unit document.
+type unitAgentToolsDoc struct {
+	Name  string       `bson:"name"`
+	Tools *tools.Tools `bson:"tools"`
+}
+
+// unitsAgentTools returns the agent binaries of the unit documents, keyed
+// on unit name.
+func unitsAgentTools(docs []unitAgentToolsDoc) map[string]*tools.Tools {
+	result := make(map[string]*tools.Tools, len(docs))
+	for _, doc := range docs {
+		result[doc.Name] = doc.Tools
+	}
+	return result
+}
+
 // CharmConfig returns the raw user configuration for the application's charm.
 func (a *Application) CharmConfig() (charm.Settings, error) {
 	if a.doc.CharmURL == nil {
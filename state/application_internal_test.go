@@ -0,0 +1,51 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/mgo/v3/bson"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/internal/tools"
+)
+
+type applicationSuite struct{}
+
+var _ = gc.Suite(&applicationSuite{})
+
+func (s *applicationSuite) TestUnitsAgentTools(c *gc.C) {
+	agentTools := &tools.Tools{
+		Version: semversion.MustParseBinary("4.0.0-ubuntu-amd64"),
+		URL:     "http://example.com/tools",
+		SHA256:  "abc",
+		Size:    1,
+	}
+
+	result := unitsAgentTools([]unitAgentToolsDoc{
+		{Name: "foo/0", Tools: agentTools},
+		{Name: "foo/1"},
+	})
+	c.Check(result, jc.DeepEquals, map[string]*tools.Tools{
+		"foo/0": agentTools,
+		"foo/1": nil,
+	})
+}
+
+func (s *applicationSuite) TestUnitsAgentToolsNoUnits(c *gc.C) {
+	c.Check(unitsAgentTools(nil), gc.HasLen, 0)
+}
+
+func (s *applicationSuite) TestUnitAgentToolsDocUnprovisioned(c *gc.C) {
+	// A unit document without agent binaries decodes to nil tools, so the
+	// unit maps to nil rather than failing the whole lookup.
+	data, err := bson.Marshal(bson.D{{"name", "foo/1"}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var doc unitAgentToolsDoc
+	err = bson.Unmarshal(data, &doc)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(doc, jc.DeepEquals, unitAgentToolsDoc{Name: "foo/1"})
+}
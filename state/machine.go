@@ -1161,6 +1161,29 @@ func (m *Machine) PublicAddress() (network.SpaceAddress, error) {
 	return publicAddress, err
 }
 
+// PublicAddressInSpace returns a public address for the machine in the
+// space with the input ID. The preferred public address is used if it is in
+// the space, otherwise the machine address in the space that best matches
+// public scope is returned. If the machine has no address in the space, an
+// error satisfying errors.IsNotFound is returned.
+func (m *Machine) PublicAddressInSpace(spaceID string) (network.SpaceAddress, error) {
+	if publicAddress, err := m.PublicAddress(); err == nil && publicAddress.SpaceID == spaceID {
+		return publicAddress, nil
+	}
+
+	var inSpace network.SpaceAddresses
+	for _, addr := range m.Addresses() {
+		if addr.SpaceID == spaceID {
+			inSpace = append(inSpace, addr)
+		}
+	}
+	addr, ok := inSpace.OneMatchingScope(network.ScopeMatchPublic)
+	if !ok {
+		return network.SpaceAddress{}, errors.NotFoundf("address for machine %q in space %q", m.Id(), spaceID)
+	}
+	return addr, nil
+}
+
 // maybeGetNewAddress determines if the current address is the most appropriate
 // match, and if not it selects the best from the slice of all available
 // addresses. It returns the new address and a bool indicating if a different
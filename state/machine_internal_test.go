@@ -0,0 +1,87 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+)
+
+type machineSuite struct{}
+
+var _ = gc.Suite(&machineSuite{})
+
+func spaceAddress(value string, scope network.Scope, spaceID string) network.SpaceAddress {
+	addr := network.NewSpaceAddress(value, network.WithScope(scope))
+	addr.SpaceID = spaceID
+	return addr
+}
+
+func (s *machineSuite) machine(preferred network.SpaceAddress, provider, machine network.SpaceAddresses) *Machine {
+	return &Machine{doc: machineDoc{
+		Id:                     "0",
+		PreferredPublicAddress: fromNetworkAddress(preferred, network.OriginProvider),
+		Addresses:              fromNetworkAddresses(provider, network.OriginProvider),
+		MachineAddresses:       fromNetworkAddresses(machine, network.OriginMachine),
+	}}
+}
+
+func (s *machineSuite) TestPublicAddressInSpacePreferred(c *gc.C) {
+	preferred := spaceAddress("203.0.113.1", network.ScopePublic, "space-1")
+	m := s.machine(preferred, network.SpaceAddresses{
+		spaceAddress("203.0.113.2", network.ScopePublic, "space-1"),
+		preferred,
+	}, nil)
+
+	addr, err := m.PublicAddressInSpace("space-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addr.Value, gc.Equals, "203.0.113.1")
+}
+
+func (s *machineSuite) TestPublicAddressInSpaceOtherSpace(c *gc.C) {
+	// The preferred public address is in another space, so the best
+	// address in the requested space is picked instead, favouring public
+	// scope over cloud-local.
+	m := s.machine(
+		spaceAddress("203.0.113.1", network.ScopePublic, "space-1"),
+		network.SpaceAddresses{
+			spaceAddress("203.0.113.1", network.ScopePublic, "space-1"),
+			spaceAddress("10.0.0.1", network.ScopeCloudLocal, "space-2"),
+		},
+		network.SpaceAddresses{
+			spaceAddress("198.51.100.1", network.ScopePublic, "space-2"),
+		},
+	)
+
+	addr, err := m.PublicAddressInSpace("space-2")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addr.Value, gc.Equals, "198.51.100.1")
+}
+
+func (s *machineSuite) TestPublicAddressInSpaceCloudLocal(c *gc.C) {
+	m := s.machine(network.SpaceAddress{}, nil, network.SpaceAddresses{
+		spaceAddress("10.0.0.1", network.ScopeCloudLocal, "space-2"),
+	})
+
+	addr, err := m.PublicAddressInSpace("space-2")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addr.Value, gc.Equals, "10.0.0.1")
+}
+
+func (s *machineSuite) TestPublicAddressInSpaceNotFound(c *gc.C) {
+	m := s.machine(
+		spaceAddress("203.0.113.1", network.ScopePublic, "space-1"),
+		network.SpaceAddresses{
+			spaceAddress("203.0.113.1", network.ScopePublic, "space-1"),
+		},
+		nil,
+	)
+
+	_, err := m.PublicAddressInSpace("space-2")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+	c.Check(err, gc.ErrorMatches, `address for machine "0" in space "space-2" not found`)
+}
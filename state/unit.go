@@ -382,9 +382,7 @@ func (u *Unit) DestroyWithForce(store objectstore.ObjectStore, force bool, maxWa
 			u.doc.Life = Dying
 		}
 	}()
-	op := u.DestroyOperation(store)
-	op.Force = force
-	op.MaxWait = maxWait
+	op := u.DestroyOperationWithForce(store, force, maxWait)
 	err = u.st.ApplyOperation(op)
 	return op.Removed, op.Errors, err
 }
@@ -397,6 +395,17 @@ func (u *Unit) DestroyOperation(store objectstore.ObjectStore) *DestroyUnitOpera
 	}
 }
 
+// DestroyOperationWithForce returns a model operation that will destroy the
+// unit. If force is true, operational errors such as failing hooks won't
+// prevent the unit from being destroyed, and each step of the destruction
+// will wait at most maxWait before forcing the next step.
+func (u *Unit) DestroyOperationWithForce(store objectstore.ObjectStore, force bool, maxWait time.Duration) *DestroyUnitOperation {
+	op := u.DestroyOperation(store)
+	op.Force = force
+	op.MaxWait = maxWait
+	return op
+}
+
 // DestroyUnitOperation is a model operation for destroying a unit.
 type DestroyUnitOperation struct {
 	// ForcedOperation stores needed information to force this operation.
@@ -455,6 +464,8 @@ func (op *DestroyUnitOperation) Done(err error) error {
 			return errors.Annotatef(err, "cannot destroy unit %q", op.unit)
 		}
 		op.AddError(errors.Errorf("force destroy unit %q proceeded despite encountering ERROR %v", op.unit, err))
+	} else if op.Force {
+		logger.Infof(context.TODO(), "unit %q destroyed with force (max wait %v)", op.unit, op.MaxWait)
 	}
 	// Reimplement in dqlite.
 	//if err := op.deleteSecrets(); err != nil {
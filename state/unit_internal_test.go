@@ -0,0 +1,72 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo/v2"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type unitSuite struct {
+	logs  *loggo.TestWriter
+	level loggo.Level
+}
+
+var _ = gc.Suite(&unitSuite{})
+
+func (s *unitSuite) SetUpTest(c *gc.C) {
+	s.logs = &loggo.TestWriter{}
+	c.Assert(loggo.RegisterWriter("unit-tester", s.logs), jc.ErrorIsNil)
+	s.level = loggo.GetLogger("juju.state").LogLevel()
+	loggo.GetLogger("juju.state").SetLogLevel(loggo.INFO)
+}
+
+func (s *unitSuite) TearDownTest(c *gc.C) {
+	loggo.GetLogger("juju.state").SetLogLevel(s.level)
+	_, err := loggo.RemoveWriter("unit-tester")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *unitSuite) destroyOperation(force bool) *DestroyUnitOperation {
+	u := &Unit{doc: unitDoc{Name: "foo/0"}}
+	return u.DestroyOperationWithForce(nil, force, time.Minute)
+}
+
+func (s *unitSuite) forceLogged() bool {
+	for _, entry := range s.logs.Log() {
+		if entry.Message == `unit "foo/0" destroyed with force (max wait 1m0s)` {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *unitSuite) TestDestroyOperationWithForce(c *gc.C) {
+	op := s.destroyOperation(true)
+	c.Check(op.Force, jc.IsTrue)
+	c.Check(op.MaxWait, gc.Equals, time.Minute)
+}
+
+func (s *unitSuite) TestDestroyOperationDoneLogsForce(c *gc.C) {
+	op := s.destroyOperation(true)
+	c.Assert(op.Done(nil), jc.ErrorIsNil)
+	c.Check(s.forceLogged(), jc.IsTrue)
+}
+
+func (s *unitSuite) TestDestroyOperationDoneErrorDoesNotLogForce(c *gc.C) {
+	op := s.destroyOperation(true)
+	c.Assert(op.Done(errors.New("boom")), jc.ErrorIsNil)
+	c.Check(op.Errors, gc.HasLen, 1)
+	c.Check(s.forceLogged(), jc.IsFalse)
+}
+
+func (s *unitSuite) TestDestroyOperationDoneWithoutForceDoesNotLog(c *gc.C) {
+	op := s.destroyOperation(false)
+	c.Assert(op.Done(nil), jc.ErrorIsNil)
+	c.Check(s.forceLogged(), jc.IsFalse)
+}
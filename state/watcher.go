@@ -343,6 +343,59 @@ func (sb *storageBackend) WatchMachineFilesystemAttachments(m names.MachineTag)
 	return sb.watchHostStorageAttachments(m, filesystemAttachmentsC)
 }
 
+// WatchFilesystemAttachmentChanges returns a StringsWatcher that notifies
+// of any change to the filesystem attachments of the specified hosts, or
+// of all hosts if none are specified. Unlike the lifecycle watchers, it
+// reports the tags of the attached filesystems rather than the attachment
+// IDs, and reports changes to the attachment info as well as to its life.
+func (sb *storageBackend) WatchFilesystemAttachmentChanges(hosts ...names.Tag) StringsWatcher {
+	mb := sb.mb
+	return newCollectionWatcher(mb, colWCfg{
+		col:    filesystemAttachmentsC,
+		filter: filesystemAttachmentHostsFilter(mb.docID, hosts...),
+		idconv: filesystemAttachmentFilesystemTag,
+		// Removed documents have a revno of -1, and we want to report
+		// detachments along with new and updated attachments.
+		revnoThreshold: -2,
+	})
+}
+
+// filesystemAttachmentHostsFilter returns a collection watcher filter that
+// only accepts the filesystem attachment documents of the specified hosts,
+// or nil to accept all of them if no hosts are specified.
+func filesystemAttachmentHostsFilter(docID func(string) string, hosts ...names.Tag) func(interface{}) bool {
+	if len(hosts) == 0 {
+		return nil
+	}
+	prefixes := make([]string, len(hosts))
+	for i, host := range hosts {
+		prefixes[i] = docID(filesystemAttachmentId(host.Id(), ""))
+	}
+	return func(id interface{}) bool {
+		k, ok := id.(string)
+		if !ok {
+			return false
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(k, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// filesystemAttachmentFilesystemTag converts a filesystem attachment ID into
+// the tag of the attached filesystem. IDs that can't be parsed are returned
+// unchanged.
+func filesystemAttachmentFilesystemTag(id string) string {
+	_, filesystemTag, err := ParseFilesystemAttachmentId(id)
+	if err != nil {
+		return id
+	}
+	return filesystemTag.String()
+}
+
 // WatchUnitVolumeAttachments returns a StringsWatcher that notifies of
 // changes to the lifecycles of all volume attachments related to the specified
 // application's units, for volumes scoped to the application's units.
@@ -0,0 +1,44 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/names/v6"
+	gc "gopkg.in/check.v1"
+)
+
+type watcherSuite struct{}
+
+var _ = gc.Suite(&watcherSuite{})
+
+func docIDForModel(id string) string {
+	return "model-uuid:" + id
+}
+
+func (s *watcherSuite) TestFilesystemAttachmentHostsFilterAllHosts(c *gc.C) {
+	c.Check(filesystemAttachmentHostsFilter(docIDForModel), gc.IsNil)
+}
+
+func (s *watcherSuite) TestFilesystemAttachmentHostsFilter(c *gc.C) {
+	filter := filesystemAttachmentHostsFilter(docIDForModel,
+		names.NewMachineTag("1"),
+		names.NewUnitTag("foo/0"),
+	)
+	c.Assert(filter, gc.NotNil)
+
+	c.Check(filter("model-uuid:1:1/0"), gc.Equals, true)
+	c.Check(filter("model-uuid:foo/0:0"), gc.Equals, true)
+
+	// Machine 10 must not be mistaken for machine 1.
+	c.Check(filter("model-uuid:10:10/0"), gc.Equals, false)
+	c.Check(filter("model-uuid:2:2/0"), gc.Equals, false)
+	c.Check(filter("other-uuid:1:1/0"), gc.Equals, false)
+	c.Check(filter(42), gc.Equals, false)
+}
+
+func (s *watcherSuite) TestFilesystemAttachmentFilesystemTag(c *gc.C) {
+	c.Check(filesystemAttachmentFilesystemTag("0:0/1"), gc.Equals, "filesystem-0-1")
+	c.Check(filesystemAttachmentFilesystemTag("foo/0:2"), gc.Equals, "filesystem-2")
+	c.Check(filesystemAttachmentFilesystemTag("not-valid"), gc.Equals, "not-valid")
+}